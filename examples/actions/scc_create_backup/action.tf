@@ -1,5 +1,8 @@
-action "scc_generate_csr" "system_cert" {
+action "scc_create_backup" "backup" {
   config {
-    password = "Terraform"
+    password         = "Terraform"
+    output_directory = "backups"
+    file_mode        = "0600"
+    keep_last_n      = 5
   }
 }
\ No newline at end of file
@@ -0,0 +1,6 @@
+action "scc_restore_backup" "restore" {
+  config {
+    backup_file = "scc_backup_20250101_120000.zip"
+    password    = "Terraform"
+  }
+}
\ No newline at end of file
@@ -0,0 +1,3 @@
+action "scc_switch_to_ldap_authentication" "switch" {
+  config {}
+}
@@ -0,0 +1,6 @@
+action "scc_sync_subaccount_trust_configuration" "sync" {
+  config {
+    region_host = "cf.eu12.hana.ondemand.com"
+    subaccount  = "12345678-90ab-cdef-1234-567890abcdef"
+  }
+}
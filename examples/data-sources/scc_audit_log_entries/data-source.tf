@@ -0,0 +1,9 @@
+# Read all audit log entries
+data "scc_audit_log_entries" "all" {}
+
+# Read the audit log entries of a user within a time range
+data "scc_audit_log_entries" "admin_january" {
+  from = "2025-01-01T00:00:00Z"
+  to   = "2025-01-31T23:59:59Z"
+  user = "Administrator"
+}
\ No newline at end of file
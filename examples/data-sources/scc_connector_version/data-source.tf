@@ -0,0 +1,10 @@
+# Read the version of the Cloud Connector
+data "scc_connector_version" "this" {}
+
+# Assert that the Cloud Connector supports managed subaccounts
+check "connector_version" {
+  assert {
+    condition     = data.scc_connector_version.this.major > 2 || data.scc_connector_version.this.minor >= 19
+    error_message = "Managed subaccounts require Cloud Connector 2.19 or later, found ${data.scc_connector_version.this.version}."
+  }
+}
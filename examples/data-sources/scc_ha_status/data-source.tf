@@ -0,0 +1 @@
+data "scc_ha_status" "status" {}
\ No newline at end of file
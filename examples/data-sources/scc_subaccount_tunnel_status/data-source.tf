@@ -0,0 +1,12 @@
+data "scc_subaccount_tunnel_status" "tunnel" {
+  region_host = "cf.eu12.hana.ondemand.com"
+  subaccount  = "12345678-90ab-cdef-1234-567890abcdef"
+}
+
+# Assert that the tunnel is connected
+check "tunnel_connected" {
+  assert {
+    condition     = data.scc_subaccount_tunnel_status.tunnel.connected
+    error_message = "The tunnel to the subaccount is ${data.scc_subaccount_tunnel_status.tunnel.state}."
+  }
+}
\ No newline at end of file
@@ -0,0 +1,45 @@
+# Preview the subject issued for a user with the configured subject pattern rules
+data "scc_subject_pattern_preview" "jdoe" {
+  principal = {
+    name  = "jdoe"
+    email = "john.doe@example.com"
+  }
+}
+
+output "jdoe_subject" {
+  value = data.scc_subject_pattern_preview.jdoe.subject
+}
+
+# Preview the subject issued for a technical user with rules that are not applied yet
+data "scc_subject_pattern_preview" "batch" {
+  principal = {
+    name      = "batch"
+    user_type = "Technical"
+    attributes = {
+      display_name = "Batch Processing"
+    }
+  }
+
+  rules = [
+    {
+      condition = {
+        variable = "user_type"
+        operator = "is"
+        value    = "Technical"
+      }
+      subject_pattern = {
+        cn = "$${display_name}"
+        ou = "Technical Users"
+        o  = "ACME"
+      }
+    },
+    {
+      condition = {
+        operator = "always_true"
+      }
+      subject_pattern = {
+        cn = "$${name}"
+      }
+    },
+  ]
+}
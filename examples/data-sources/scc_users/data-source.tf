@@ -0,0 +1,7 @@
+# Read all local users
+data "scc_users" "all" {}
+
+# Read the local users with the Administrator role, e.g. for access reviews
+data "scc_users" "administrators" {
+  role = "administrator"
+}
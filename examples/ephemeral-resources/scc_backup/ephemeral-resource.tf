@@ -0,0 +1,8 @@
+ephemeral "vault_kv_secret_v2" "backup_password" {
+  mount = "secret"
+  name  = "scc/backup"
+}
+
+ephemeral "scc_backup" "backup" {
+  password = ephemeral.vault_kv_secret_v2.backup_password.data["password"]
+}
\ No newline at end of file
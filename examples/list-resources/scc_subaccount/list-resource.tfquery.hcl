@@ -19,3 +19,23 @@ list "scc_subaccount" "by_region" {
     region_host = "cf.us10.hana.ondemand.com"
   }
 }
+
+# List block to discover SCC subaccounts together with their tunnel state
+# and per-application connection counts
+list "scc_subaccount" "with_tunnel" {
+  provider         = scc
+  include_resource = true
+
+  config {
+    include_tunnel = true
+  }
+}
+
+# List block to discover SCC subaccounts whose tunnel is not connected
+list "scc_subaccount" "disconnected" {
+  provider = scc
+
+  config {
+    tunnel_state = "Disconnected"
+  }
+}
\ No newline at end of file
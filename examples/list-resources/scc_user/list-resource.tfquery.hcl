@@ -0,0 +1,22 @@
+# This feature requires Terraform v1.14.0 or later
+list "scc_user" "<label_name>" {
+  # (Required) Provider instance to use
+  provider = provider_name
+}
+
+# List block to discover all local SCC users
+list "scc_user" "all" {
+  provider         = scc
+  include_resource = true
+}
+
+# List block to discover the local SCC users with a role
+list "scc_user" "administrators" {
+  provider = scc
+
+  # (Optional) Filter configuration
+  # If role is omitted, all users are returned
+  config {
+    role = "administrator"
+  }
+}
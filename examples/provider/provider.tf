@@ -11,7 +11,7 @@ provider "scc" {
   # Cloud Connector base URL, e.g., "https://localhost:8443"
   instance_url = "https://your-cloud-connector-instance:8443"
 
-  # ❗ Authentication: Use **either** Basic Auth or Client Certificate Auth — not both
+  # ❗ Authentication: Use exactly one of the options below
 
   # Option 1: Basic Authentication (set username and password)
   username = var.scc_username # or set via SCC_USERNAME
@@ -21,6 +21,16 @@ provider "scc" {
   # client_certificate = file("${path.module}/certs/client.crt")   # or SCC_CLIENT_CERTIFICATE
   # client_key         = file("${path.module}/certs/client.key")   # or SCC_CLIENT_KEY
 
+  # Option 3: Bearer token, e.g. an OAuth access token
+  # token = var.scc_token # or set via SCC_TOKEN (Sensitive)
+
+  # Option 4: Credential helper that prints {"token": "..."} or {"username": "...", "password": "..."}
+  # credential_helper = ["scc-login", "--format", "json"] # or SCC_CREDENTIAL_HELPER
+
+  # Option 5: PKCS#12 client certificate bundle
+  # client_certificate_pkcs12          = filebase64("${path.module}/certs/client.p12") # or SCC_CLIENT_CERTIFICATE_PKCS12
+  # client_certificate_pkcs12_password = var.scc_pkcs12_password                         # or SCC_CLIENT_CERTIFICATE_PKCS12_PASSWORD
+
   # TLS Server Verification
   ca_certificate = file("${path.module}/certs/ca.pem") # or SCC_CA_CERTIFICATE
 }
@@ -0,0 +1,19 @@
+# terraform import scc_audit_log_settings.<resource_name> audit-log-settings
+
+terraform import scc_audit_log_settings.audit audit-log-settings
+
+# terraform import using id attribute in import block
+import {
+  to = scc_audit_log_settings.<resource_name>
+  id = "audit-log-settings"
+}
+
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+
+import {
+  to = scc_audit_log_settings.<resource_name>
+  identity = {
+    id = "audit-log-settings"
+  }
+}
\ No newline at end of file
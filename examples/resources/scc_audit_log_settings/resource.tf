@@ -0,0 +1,3 @@
+resource "scc_audit_log_settings" "audit" {
+  level = "all"
+}
\ No newline at end of file
@@ -0,0 +1,19 @@
+# terraform import scc_ha_master_settings.<resource_name> ha-master-settings
+
+terraform import scc_ha_master_settings.master ha-master-settings
+
+# terraform import using id attribute in import block
+import {
+  to = scc_ha_master_settings.<resource_name>
+  id = "ha-master-settings"
+}
+
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+
+import {
+  to = scc_ha_master_settings.<resource_name>
+  identity = {
+    id = "ha-master-settings"
+  }
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "scc_ha_master_settings" "master" {
+  ha_enabled          = true
+  allowed_shadow_host = "shadow.company.com"
+}
\ No newline at end of file
@@ -0,0 +1,19 @@
+# terraform import scc_ha_shadow_connection.<resource_name> ha-shadow-connection
+
+terraform import scc_ha_shadow_connection.shadow ha-shadow-connection
+
+# terraform import using id attribute in import block
+import {
+  to = scc_ha_shadow_connection.<resource_name>
+  id = "ha-shadow-connection"
+}
+
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+
+import {
+  to = scc_ha_shadow_connection.<resource_name>
+  identity = {
+    id = "ha-shadow-connection"
+  }
+}
\ No newline at end of file
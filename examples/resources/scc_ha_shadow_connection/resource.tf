@@ -0,0 +1,8 @@
+# The provider must be configured with the shadow instance.
+resource "scc_ha_shadow_connection" "shadow" {
+  master_host    = "master.company.com"
+  master_port    = 8443
+  takeover_delay = 30
+  username       = "Administrator"
+  password       = var.master_password
+}
\ No newline at end of file
@@ -0,0 +1,19 @@
+# terraform import scc_kerberos_settings.<resource_name> kerberos-settings
+
+terraform import scc_kerberos_settings.kerberos kerberos-settings
+
+# terraform import using id attribute in import block
+import {
+  to = scc_kerberos_settings.<resource_name>
+  id = "kerberos-settings"
+}
+
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+
+import {
+  to = scc_kerberos_settings.<resource_name>
+  identity = {
+    id = "kerberos-settings"
+  }
+}
\ No newline at end of file
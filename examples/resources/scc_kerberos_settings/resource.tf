@@ -0,0 +1,8 @@
+resource "scc_kerberos_settings" "kerberos" {
+  realm        = "EXAMPLE.COM"
+  kdc_hosts    = ["kdc1.example.com", "kdc2.example.com:750"]
+  service_user = "scc-service"
+
+  keytab_wo         = filebase64("scc-service.keytab")
+  keytab_wo_version = 1
+}
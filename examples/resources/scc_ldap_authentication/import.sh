@@ -0,0 +1,19 @@
+# terraform import scc_ldap_authentication.<resource_name> ldap-authentication
+
+terraform import scc_ldap_authentication.ldap ldap-authentication
+
+# terraform import using id attribute in import block
+import {
+  to = scc_ldap_authentication.<resource_name>
+  id = "ldap-authentication"
+}
+
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+
+import {
+  to = scc_ldap_authentication.<resource_name>
+  identity = {
+    id = "ldap-authentication"
+  }
+}
\ No newline at end of file
@@ -0,0 +1,33 @@
+resource "scc_ldap_authentication" "ldap" {
+  hosts = [
+    "ldaps://ldap1.example.com",
+    "ldaps://ldap2.example.com:1636",
+  ]
+  secure = true
+
+  user     = "cn=scc-service,ou=services,dc=example,dc=com"
+  password = var.ldap_password
+
+  user_search_base     = "ou=users,dc=example,dc=com"
+  user_search_filter   = "(uid={0})"
+  group_search_base    = "ou=groups,dc=example,dc=com"
+  group_search_filter  = "(uniqueMember={0})"
+  group_name_attribute = "cn"
+
+  role_mapping = {
+    administrator = "scc-administrators"
+    display       = "scc-viewers"
+    monitoring    = "scc-monitoring"
+  }
+}
+
+resource "terraform_data" "switch_to_ldap" {
+  input = scc_ldap_authentication.ldap.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.scc_switch_to_ldap_authentication.switch]
+    }
+  }
+}
@@ -0,0 +1,19 @@
+# terraform import scc_snc_settings.<resource_name> snc-settings
+
+terraform import scc_snc_settings.snc snc-settings
+
+# terraform import using id attribute in import block
+import {
+  to = scc_snc_settings.<resource_name>
+  id = "snc-settings"
+}
+
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+
+import {
+  to = scc_snc_settings.<resource_name>
+  identity = {
+    id = "snc-settings"
+  }
+}
\ No newline at end of file
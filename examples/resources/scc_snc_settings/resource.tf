@@ -0,0 +1,5 @@
+resource "scc_snc_settings" "snc" {
+  library_path          = "/opt/sap/scc/sapcrypto/libsapcrypto.so"
+  own_name              = "p:CN=SCC, O=Example, C=DE"
+  quality_of_protection = 3
+}
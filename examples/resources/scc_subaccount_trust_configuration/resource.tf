@@ -0,0 +1,21 @@
+action "scc_sync_subaccount_trust_configuration" "sync" {
+  config {
+    region_host = "cf.eu12.hana.ondemand.com"
+    subaccount  = "12345678-90ab-cdef-1234-567890abcdef"
+  }
+}
+
+resource "scc_subaccount_trust_configuration" "trust" {
+  region_host = "cf.eu12.hana.ondemand.com"
+  subaccount  = "12345678-90ab-cdef-1234-567890abcdef"
+
+  trusted_identity_providers = ["corporate-idp"]
+  trusted_applications       = ["hr-app", "sales-app"]
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.scc_sync_subaccount_trust_configuration.sync]
+    }
+  }
+}
@@ -0,0 +1,19 @@
+# terraform import scc_subject_pattern_rules.<resource_name> subject-pattern-rules
+
+terraform import scc_subject_pattern_rules.rules subject-pattern-rules
+
+# terraform import using id attribute in import block
+import {
+  to = scc_subject_pattern_rules.<resource_name>
+  id = "subject-pattern-rules"
+}
+
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+
+import {
+  to = scc_subject_pattern_rules.<resource_name>
+  identity = {
+    id = "subject-pattern-rules"
+  }
+}
\ No newline at end of file
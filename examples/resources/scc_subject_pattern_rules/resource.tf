@@ -0,0 +1,31 @@
+# Manage all subject pattern rules in their order of evaluation
+resource "scc_subject_pattern_rules" "rules" {
+  rules = [
+    {
+      description = "Technical users"
+      condition = {
+        variable = "user_type"
+        operator = "is"
+        value    = "Technical"
+      }
+      subject_pattern = {
+        cn = "$${name}"
+        ou = "Technical Users"
+        o  = "ACME Corp"
+      }
+    },
+    {
+      description = "Users with email attribute"
+      condition = {
+        variable = "email"
+        operator = "exist"
+      }
+      subject_pattern = {
+        cn    = "$${name}"
+        email = "$${email}"
+        o     = "ACME Corp"
+        c     = "DE"
+      }
+    },
+  ]
+}
@@ -0,0 +1,19 @@
+# terraform import scc_trace_settings.<resource_name> trace-settings
+
+terraform import scc_trace_settings.troubleshooting trace-settings
+
+# terraform import using id attribute in import block
+import {
+  to = scc_trace_settings.<resource_name>
+  id = "trace-settings"
+}
+
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+
+import {
+  to = scc_trace_settings.<resource_name>
+  identity = {
+    id = "trace-settings"
+  }
+}
\ No newline at end of file
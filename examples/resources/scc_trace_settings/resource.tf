@@ -0,0 +1,7 @@
+resource "scc_trace_settings" "troubleshooting" {
+  cloud_connector_log_level = "DEBUG"
+  other_log_level           = "INFO"
+  cpic_trace_level          = 3
+  payload_trace_enabled     = true
+  four_eyes_principle       = true
+}
\ No newline at end of file
@@ -0,0 +1,18 @@
+# terraform import scc_user.<resource_name> '<name>'
+
+terraform import scc_user.jdoe 'jdoe'
+
+# terraform import using id attribute in import block
+import {
+  to = scc_user.<resource_name>
+  id = "<name>"
+}
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+# Import an existing SCC user into Terraform state
+import {
+  to = scc_user.<resource_name>
+  identity = {
+    name = "<name>"
+  }
+}
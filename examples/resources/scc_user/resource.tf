@@ -0,0 +1,9 @@
+resource "scc_user" "jdoe" {
+  name        = "jdoe"
+  description = "John Doe, operations"
+
+  password_wo         = var.jdoe_password
+  password_wo_version = 1
+
+  roles = ["display", "support"]
+}
@@ -0,0 +1,7 @@
+# Rotate the password of the Administrator the provider authenticates with.
+# Increase password_wo_version to change the password again.
+resource "scc_user_password" "administrator" {
+  user                = "Administrator"
+  password_wo         = var.scc_new_password
+  password_wo_version = 1
+}
@@ -0,0 +1,19 @@
+# terraform import scc_user_role_assignment.<resource_name> '<user>,<role>'
+
+terraform import scc_user_role_assignment.operator_display 'operator,display'
+
+# terraform import using id attribute in import block
+import {
+  to = scc_user_role_assignment.<resource_name>
+  id = "<user>,<role>"
+}
+
+# this resource supports import using identity attribute from Terraform version 1.12 or higher
+# Import an existing SCC user role assignment into Terraform state
+import {
+  to = scc_user_role_assignment.<resource_name>
+  identity = {
+    user = "<user>"
+    role = "<role>"
+  }
+}
@@ -0,0 +1,14 @@
+resource "scc_user" "operator" {
+  name        = "operator"
+  password_wo = var.operator_password
+}
+
+resource "scc_user_role_assignment" "operator_display" {
+  user = scc_user.operator.name
+  role = "display"
+}
+
+resource "scc_user_role_assignment" "operator_monitoring" {
+  user = scc_user.operator.name
+  role = "monitoring"
+}
@@ -0,0 +1,7 @@
+// Package apiobjects contains the request and response bodies of the Cloud
+// Connector REST API.
+//
+// Request bodies declare their fields in the alphabetical order of the JSON
+// keys, so that the encoded requests match the request bodies recorded in the
+// test fixtures byte for byte.
+package apiobjects
@@ -0,0 +1,16 @@
+package apiobjects
+
+type AuditLogLevel struct {
+	Level string `json:"level"`
+}
+
+type AuditLogEntry struct {
+	Timestamp int64  `json:"timestamp"`
+	User      string `json:"user"`
+	Category  string `json:"category"`
+	Message   string `json:"message"`
+}
+
+type AuditLogEntries struct {
+	Entries []AuditLogEntry `json:"entries"`
+}
@@ -0,0 +1,69 @@
+package apiobjects
+
+// PasswordChange changes the password of a local Cloud Connector user.
+type PasswordChange struct {
+	User        string `json:"user"`
+	Password    string `json:"password"`
+	NewPassword string `json:"newPassword"`
+}
+
+// User stores the Cloud Connector authenticates administrators against.
+const (
+	AuthenticationTypeBasic = "basic"
+	AuthenticationTypeLDAP  = "ldap"
+)
+
+type Authentication struct {
+	Type string `json:"type"`
+}
+
+type LDAPHost struct {
+	Host     string `json:"host"`
+	Port     int64  `json:"port"`
+	IsSecure bool   `json:"isSecure"`
+}
+
+type LDAPAuthentication struct {
+	Hosts      []LDAPHost `json:"hosts"`
+	User       string     `json:"user,omitempty"`
+	Password   string     `json:"password,omitempty"`
+	UserBase   string     `json:"userBase"`
+	UserSearch string     `json:"userSearch,omitempty"`
+	RoleBase   string     `json:"roleBase"`
+	RoleSearch string     `json:"roleSearch,omitempty"`
+	RoleName   string     `json:"roleName,omitempty"`
+	// LDAP groups mapped to the roles of the Cloud Connector.
+	CustomAdminRole           string `json:"customAdminRole,omitempty"`
+	CustomSubaccountAdminRole string `json:"customSubaccountAdminRole,omitempty"`
+	CustomDisplayRole         string `json:"customDisplayRole,omitempty"`
+	CustomSupportRole         string `json:"customSupportRole,omitempty"`
+	CustomMonitoringRole      string `json:"customMonitoringRole,omitempty"`
+}
+
+// Roles of the Cloud Connector, as reported for local users.
+const (
+	RoleAdministrator           = "sccadmin"
+	RoleSubaccountAdministrator = "sccsubadmin"
+	RoleDisplay                 = "sccdisplay"
+	RoleSupport                 = "sccsupport"
+	RoleMonitoring              = "sccmonitoring"
+)
+
+// User is a local user of the Cloud Connector.
+type User struct {
+	User        string   `json:"user"`
+	Description string   `json:"description,omitempty"`
+	Roles       []string `json:"roles"`
+}
+
+// UserRequest creates a local user or changes its description and password.
+// An empty password keeps the current password of the user.
+type UserRequest struct {
+	User        string `json:"user"`
+	Password    string `json:"password,omitempty"`
+	Description string `json:"description"`
+}
+
+type UserRoles struct {
+	Roles []string `json:"roles"`
+}
@@ -11,3 +11,7 @@ type TrustedBackends struct {
 	Issuer    string `json:"issuer"`
 	ValidTo   int64  `json:"notAfterTimeStamp"`
 }
+
+type BackendTrustStoreRequest struct {
+	TrustAllBackends bool `json:"trustAllBackends"`
+}
@@ -0,0 +1,7 @@
+package apiobjects
+
+// BackupRequest is the request body to create a backup of the Cloud Connector
+// configuration.
+type BackupRequest struct {
+	Password string `json:"password"`
+}
@@ -13,3 +13,12 @@ type SubjectAltNames struct {
 	Type  string `json:"type"`
 	Value string `json:"value"`
 }
+
+// CertificateRequest is the request body to create a self-signed certificate or
+// a certificate signing request, depending on Type.
+type CertificateRequest struct {
+	Type            string            `json:"type"`
+	KeySize         int64             `json:"keySize"`
+	SubjectDN       string            `json:"subjectDN"`
+	SubjectAltNames []SubjectAltNames `json:"subjectAltNames,omitempty"`
+}
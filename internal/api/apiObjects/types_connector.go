@@ -0,0 +1,7 @@
+package apiobjects
+
+type ConnectorVersion struct {
+	Version     string `json:"version"`
+	Build       string `json:"build,omitempty"`
+	JavaVersion string `json:"javaVersion,omitempty"`
+}
@@ -8,3 +8,8 @@ type DomainMapping struct {
 type DomainMappings struct {
 	DomainMappings []DomainMapping `json:"domain_mappings"`
 }
+
+type DomainMappingRequest struct {
+	InternalDomain string `json:"internalDomain"`
+	VirtualDomain  string `json:"virtualDomain"`
+}
@@ -0,0 +1,38 @@
+package apiobjects
+
+type HAMasterConfiguration struct {
+	HAEnabled         bool   `json:"haEnabled"`
+	AllowedShadowHost string `json:"allowedShadowHost"`
+}
+
+type HAMasterState struct {
+	State string `json:"state"`
+}
+
+type HAShadowConfiguration struct {
+	MasterHost             string `json:"masterHost"`
+	MasterPort             int64  `json:"masterPort"`
+	OwnHost                string `json:"ownHost,omitempty"`
+	CheckIntervalInSeconds int64  `json:"checkIntervalInSeconds,omitempty"`
+	TakeoverDelayInSeconds int64  `json:"takeoverDelayInSeconds,omitempty"`
+	ConnectTimeoutInMillis int64  `json:"connectTimeoutInMillis,omitempty"`
+	RequestTimeoutInMillis int64  `json:"requestTimeoutInMillis,omitempty"`
+}
+
+type HAShadowState struct {
+	State        string   `json:"state"`
+	StateMessage string   `json:"stateMessage"`
+	OwnHosts     []string `json:"ownHosts"`
+}
+
+type HARole struct {
+	Role string `json:"role"`
+}
+
+// HAShadowOperation is the request body to connect a shadow instance to its
+// master instance or to disconnect it.
+type HAShadowOperation struct {
+	Op       string `json:"op"`
+	User     string `json:"user,omitempty"`
+	Password string `json:"password,omitempty"`
+}
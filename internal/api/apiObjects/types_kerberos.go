@@ -0,0 +1,17 @@
+package apiobjects
+
+type KerberosKDC struct {
+	Host string `json:"host"`
+	Port int64  `json:"port"`
+}
+
+// KerberosSettings configures the key distribution centers (KDC) and the
+// service user the Cloud Connector uses for Kerberos principal propagation.
+type KerberosSettings struct {
+	Realm       string        `json:"realm"`
+	KDCs        []KerberosKDC `json:"kdcs"`
+	ServiceUser string        `json:"serviceUser"`
+	// Keytab is the Base64-encoded keytab of the service user. The Cloud
+	// Connector never returns it and keeps the stored keytab if it is empty.
+	Keytab string `json:"keytab,omitempty"`
+}
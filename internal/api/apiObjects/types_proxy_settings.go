@@ -6,3 +6,12 @@ type ProxySettings struct {
 	User     string `json:"user,omitempty"`
 	Password string `json:"password,omitempty"`
 }
+
+// ProxySettingsRequest is the request body to change the proxy settings.
+// Unlike ProxySettings it takes the port as a number.
+type ProxySettingsRequest struct {
+	Host     string `json:"host"`
+	Password string `json:"password,omitempty"`
+	Port     int64  `json:"port"`
+	User     string `json:"user,omitempty"`
+}
@@ -0,0 +1,9 @@
+package apiobjects
+
+// SNCSettings configures Secure Network Communication (SNC) for RFC
+// connections to backend systems.
+type SNCSettings struct {
+	LibraryPath string `json:"libraryPath"`
+	MyName      string `json:"myName"`
+	QoP         int64  `json:"qop"`
+}
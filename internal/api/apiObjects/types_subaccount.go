@@ -11,18 +11,12 @@ type Subaccount struct {
 	Tunnel                 SubaccountTunnel `json:"tunnel"`
 }
 
-type Subaccounts struct {
-	RegionHost string `tfsdk:"regionHost"`
-	Subaccount string `tfsdk:"subaccount"`
-	LocationID string `tfsdk:"locationID"`
-}
-
 type SubaccountsDataSource struct {
-	Subaccounts []Subaccounts `json:"subaccounts"`
+	Subaccounts []Subaccount `json:"subaccounts"`
 }
 
 type SubaccountsListResource struct {
-	Subaccounts []Subaccounts `json:"subaccounts"`
+	Subaccounts []Subaccount `json:"subaccounts"`
 }
 
 type SubaccountCertificate struct {
@@ -56,28 +50,62 @@ type SubaccountServiceChannels struct {
 	Comment string `json:"comment"`
 }
 
-type SubaccountResource struct {
-	RegionHost             string           `json:"regionHost"`
-	Subaccount             string           `json:"subaccount"`
-	CloudUser              string           `json:"cloudUser"`
-	CloudPassword          string           `json:"cloudPassword"`
-	LocationID             string           `json:"locationID,omitempty"`
-	DisplayName            string           `json:"displayName,omitempty"`
-	Description            string           `json:"description,omitempty"`
-	IsManaged              *bool            `json:"isManaged,omitempty"`
-	AutoCertificateRenewal *bool            `json:"autoCertRenewal,omitempty"`
-	Tunnel                 SubaccountTunnel `json:"tunnel"`
-	AutoTrustSync          *bool            `json:"autoSyncTrustEnabled,omitempty"`
+type SubaccountCreateRequest struct {
+	AutoCertificateRenewal *bool  `json:"autoCertRenewal,omitempty"`
+	CloudPassword          string `json:"cloudPassword"`
+	CloudUser              string `json:"cloudUser"`
+	Description            string `json:"description"`
+	DisplayName            string `json:"displayName"`
+	IsManaged              *bool  `json:"isManaged,omitempty"`
+	LocationID             string `json:"locationID"`
+	RegionHost             string `json:"regionHost"`
+	Subaccount             string `json:"subaccount"`
 }
 
-type SubaccountUsingAuthResource struct {
-	RegionHost             string           `json:"regionHost"`
-	Subaccount             string           `json:"subaccount"`
-	AuthenticationData     string           `json:"authenticationData"`
-	LocationID             string           `json:"locationID,omitempty"`
-	DisplayName            string           `json:"displayName,omitempty"`
-	Description            string           `json:"description,omitempty"`
-	IsManaged              *bool            `json:"isManaged,omitempty"`
-	AutoCertificateRenewal *bool            `json:"autoCertRenewal,omitempty"`
-	Tunnel                 SubaccountTunnel `json:"tunnel"`
+type SubaccountCreateWithAuthenticationDataRequest struct {
+	AuthenticationData     string `json:"authenticationData"`
+	AutoCertificateRenewal *bool  `json:"autoCertRenewal,omitempty"`
+	Description            string `json:"description"`
+	DisplayName            string `json:"displayName"`
+	IsManaged              *bool  `json:"isManaged,omitempty"`
+	LocationID             string `json:"locationID"`
+}
+
+type SubaccountUpdateRequest struct {
+	AutoCertificateRenewal *bool  `json:"autoCertRenewal,omitempty"`
+	Description            string `json:"description"`
+	DisplayName            string `json:"displayName"`
+	LocationID             string `json:"locationID"`
+}
+
+type SubaccountTunnelStateRequest struct {
+	Connected bool `json:"connected"`
+}
+
+type SubaccountTrustRequest struct {
+	AutoSyncTrustEnabled bool `json:"autoSyncTrustEnabled"`
+}
+
+// SubaccountTrustConfiguration is the trust configuration synchronized from
+// SAP BTP. Only trusted identity providers and applications may propagate
+// principals to backend systems.
+type SubaccountTrustConfiguration struct {
+	AutoSyncTrustEnabled bool                `json:"autoSyncTrustEnabled"`
+	IdentityProviders    []SubaccountTrusted `json:"identityProviders"`
+	Applications         []SubaccountTrusted `json:"applications"`
+}
+
+type SubaccountTrusted struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Trusted     bool   `json:"trusted"`
+}
+
+type SubaccountCertificateRenewalRequest struct {
+	Password string `json:"password"`
+	User     string `json:"user"`
+}
+
+type SubaccountServiceChannelStateRequest struct {
+	Enabled bool `json:"enabled,string"`
 }
@@ -21,3 +21,10 @@ type SubaccountABAPServiceChannelState struct {
 type SubaccountABAPServiceChannels struct {
 	SubaccountABAPServiceChannels []SubaccountABAPServiceChannel `json:"service_channels_abap"`
 }
+
+type SubaccountABAPServiceChannelRequest struct {
+	ABAPCloudTenantHost string `json:"abapCloudTenantHost"`
+	Comment             string `json:"comment"`
+	Connections         int64  `json:"connections,string"`
+	InstanceNumber      int64  `json:"instanceNumber,string"`
+}
@@ -21,3 +21,11 @@ type SubaccountK8SServiceChannelState struct {
 type SubaccountK8SServiceChannels struct {
 	SubaccountK8SServiceChannels []SubaccountK8SServiceChannel `json:"service_channels_k8s"`
 }
+
+type SubaccountK8SServiceChannelRequest struct {
+	Description    string `json:"comment"`
+	Connections    int64  `json:"connections,string"`
+	K8SClusterHost string `json:"k8sCluster"`
+	K8SServiceID   string `json:"k8sService"`
+	LocalPort      int64  `json:"port,string"`
+}
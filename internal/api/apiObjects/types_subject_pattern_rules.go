@@ -17,3 +17,17 @@ type SubjectPattern struct {
 	State            string `json:"ST,omitempty"`
 	Country          string `json:"C,omitempty"`
 }
+
+// SubjectPatternRuleRequest is the request body to create or update a subject
+// pattern rule. Unlike SubjectPatternRule it takes the condition as an object.
+type SubjectPatternRuleRequest struct {
+	Condition      SubjectPatternCondition `json:"condition"`
+	Description    string                  `json:"description"`
+	SubjectPattern SubjectPattern          `json:"subjectPattern"`
+}
+
+type SubjectPatternCondition struct {
+	Operator string `json:"operator"`
+	Value    string `json:"value,omitempty"`
+	Variable string `json:"variable"`
+}
@@ -28,3 +28,20 @@ type BlacklistedUsers struct {
 	Client string `json:"client"`
 	User   string `json:"user"`
 }
+
+type SystemMappingRequest struct {
+	AllowedClients     *[]string           `json:"allowedClients,omitempty"`
+	AuthenticationMode string              `json:"authenticationMode"`
+	BackendType        string              `json:"backendType"`
+	BlacklistedUsers   *[]BlacklistedUsers `json:"blacklistedUsers,omitempty"`
+	Description        *string             `json:"description,omitempty"`
+	HostInHeader       *string             `json:"hostInHeader,omitempty"`
+	InternalHost       string              `json:"localHost"`
+	InternalPort       string              `json:"localPort"`
+	Protocol           string              `json:"protocol"`
+	SAPRouter          *string             `json:"sapRouter,omitempty"`
+	Sid                *string             `json:"sid,omitempty"`
+	SNCPartnerName     *string             `json:"sncPartnerName,omitempty"`
+	VirtualHost        string              `json:"virtualHost"`
+	VirtualPort        string              `json:"virtualPort"`
+}
@@ -29,3 +29,11 @@ type SystemMappingResources struct {
 	VirtualPort            string                  `json:"virtualPort"`
 	SystemMappingResources []SystemMappingResource `json:"systemMappingResources"`
 }
+
+type SystemMappingResourceRequest struct {
+	Description             string `json:"description"`
+	Enabled                 bool   `json:"enabled,string"`
+	PathOnly                bool   `json:"exactMatchOnly,string"`
+	URLPath                 string `json:"id,omitempty"`
+	WebsocketUpgradeAllowed bool   `json:"websocketUpgradeAllowed,string"`
+}
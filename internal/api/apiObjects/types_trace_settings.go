@@ -0,0 +1,9 @@
+package apiobjects
+
+type TraceSettings struct {
+	CloudConnectorLogLevel string `json:"cloudConnectorLogLevel"`
+	OtherLogLevel          string `json:"otherLogLevel"`
+	CPICTraceLevel         int64  `json:"cpicTraceLevel"`
+	PayloadTraceEnabled    bool   `json:"payloadTraceEnabled"`
+	FourEyesPrinciple      bool   `json:"fourEyesPrinciple"`
+}
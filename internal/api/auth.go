@@ -0,0 +1,200 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	pkcs12 "software.sslmate.com/src/go-pkcs12"
+)
+
+// Authenticator authenticates the requests of a client, as an alternative to
+// basic authentication and client certificates in PEM format.
+type Authenticator interface {
+	// Authenticate adds the credentials to req.
+	Authenticate(ctx context.Context, req *http.Request) error
+}
+
+// TLSAuthenticator is an Authenticator that authenticates the client with a
+// certificate during the TLS handshake.
+type TLSAuthenticator interface {
+	Authenticator
+	// Certificate returns the client certificate presented to the Cloud Connector.
+	Certificate() tls.Certificate
+}
+
+// basicAuth returns the username and password the client authenticates with.
+func (c *RestApiClient) basicAuth() (string, string) {
+	c.passwordMu.RLock()
+	defer c.passwordMu.RUnlock()
+	return c.Username, c.Password
+}
+
+// BasicAuthPassword returns the password the client selected by ctx sends for
+// user with basic authentication. It returns false if the client does not
+// authenticate as user with basic authentication.
+func (c *RestApiClient) BasicAuthPassword(ctx context.Context, user string) (string, bool) {
+	client, err := c.resolve(ctx)
+	if err != nil || client.Authenticator != nil {
+		return "", false
+	}
+
+	username, password := client.basicAuth()
+	if password == "" || !strings.EqualFold(username, user) {
+		return "", false
+	}
+	return password, true
+}
+
+// switchPassword makes the client send password from now on, if it
+// authenticates as user with basic authentication.
+func (c *RestApiClient) switchPassword(user, password string) {
+	c.passwordMu.Lock()
+	defer c.passwordMu.Unlock()
+	if c.Authenticator == nil && c.Password != "" && strings.EqualFold(c.Username, user) {
+		c.Password = password
+	}
+}
+
+// BearerTokenAuthenticator sends a static bearer token.
+type BearerTokenAuthenticator struct {
+	Token string
+}
+
+func (a *BearerTokenAuthenticator) Authenticate(_ context.Context, req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+a.Token)
+	return nil
+}
+
+// credentialRefreshMargin is the time before its expiry a credential of a
+// credential helper is refreshed.
+const credentialRefreshMargin = 30 * time.Second
+
+// CredentialHelperAuthenticator runs an external command that prints a JSON
+// credential to its standard output, either
+//
+//	{"token": "...", "expires_at": "2026-01-01T00:00:00Z"}
+//
+// or
+//
+//	{"username": "...", "password": "..."}
+//
+// The credential is cached until shortly before expires_at, or for the rest of
+// the run if it has no expiry.
+type CredentialHelperAuthenticator struct {
+	// Command is the executable of the credential helper followed by its arguments.
+	Command []string
+
+	mu         sync.Mutex
+	credential *helperCredential
+}
+
+type helperCredential struct {
+	Token     string     `json:"token"`
+	Username  string     `json:"username"`
+	Password  string     `json:"password"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+func (a *CredentialHelperAuthenticator) Authenticate(ctx context.Context, req *http.Request) error {
+	credential, err := a.get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if credential.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+credential.Token)
+	} else {
+		req.SetBasicAuth(credential.Username, credential.Password)
+	}
+	return nil
+}
+
+func (a *CredentialHelperAuthenticator) get(ctx context.Context) (*helperCredential, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.credential != nil && (a.credential.ExpiresAt == nil || time.Until(*a.credential.ExpiresAt) > credentialRefreshMargin) {
+		return a.credential, nil
+	}
+
+	credential, err := a.run(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a.credential = credential
+	return credential, nil
+}
+
+func (a *CredentialHelperAuthenticator) run(ctx context.Context) (*helperCredential, error) {
+	if len(a.Command) == 0 {
+		return nil, &Error{Summary: "Credential Helper Failed", Detail: "No credential helper command is configured."}
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, a.Command[0], a.Command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		detail := fmt.Sprintf("The credential helper %q failed: %v", a.Command[0], err)
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			detail += ": " + msg
+		}
+		return nil, &Error{Summary: "Credential Helper Failed", Detail: detail}
+	}
+
+	var credential helperCredential
+	if err := json.Unmarshal(stdout.Bytes(), &credential); err != nil {
+		return nil, &Error{Summary: "Credential Helper Failed", Detail: fmt.Sprintf("The credential helper %q did not print a JSON credential: %v", a.Command[0], err)}
+	}
+
+	if credential.Token == "" && (credential.Username == "" || credential.Password == "") {
+		return nil, &Error{Summary: "Credential Helper Failed", Detail: fmt.Sprintf("The credential of the credential helper %q contains neither a token nor a username and password.", a.Command[0])}
+	}
+
+	return &credential, nil
+}
+
+// PKCS12Authenticator authenticates with a client certificate read from a
+// PKCS#12 bundle.
+type PKCS12Authenticator struct {
+	certificate tls.Certificate
+}
+
+// NewPKCS12Authenticator decodes the client certificate, its private key and
+// the CA certificates of its chain from a PKCS#12 bundle.
+func NewPKCS12Authenticator(data []byte, password string) (*PKCS12Authenticator, error) {
+	key, leaf, caCertificates, err := pkcs12.DecodeChain(data, password)
+	if err != nil {
+		return nil, &Error{Summary: "Invalid PKCS#12 Client Certificate", Detail: fmt.Sprintf("Failed to decode the PKCS#12 bundle: %v", err)}
+	}
+
+	certificate := tls.Certificate{
+		Certificate: [][]byte{leaf.Raw},
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}
+	for _, caCertificate := range caCertificates {
+		certificate.Certificate = append(certificate.Certificate, caCertificate.Raw)
+	}
+
+	return &PKCS12Authenticator{certificate: certificate}, nil
+}
+
+// Authenticate does not modify req, as the certificate is presented during the
+// TLS handshake.
+func (a *PKCS12Authenticator) Authenticate(_ context.Context, _ *http.Request) error {
+	return nil
+}
+
+func (a *PKCS12Authenticator) Certificate() tls.Certificate {
+	return a.certificate
+}
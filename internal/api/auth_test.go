@@ -0,0 +1,159 @@
+package api
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pkcs12 "software.sslmate.com/src/go-pkcs12"
+)
+
+func newAuthenticatorTestServer(t *testing.T, authenticator Authenticator) (*RestApiClient, *[]string) {
+	t.Helper()
+
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	baseURL, _ := url.Parse(server.URL)
+	client, diags := NewRestApiClient(nil, baseURL, "", "", nil, nil, nil, false, authenticator)
+	require.False(t, diags.HasError(), "failed to create client: %v", diags)
+	client.Retry.MaxRetries = 0
+
+	return client, &authorizations
+}
+
+// writeCredentialHelper writes a credential helper script that prints output
+// and counts its invocations in the returned file.
+func writeCredentialHelper(t *testing.T, output string) ([]string, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	counter := filepath.Join(dir, "count")
+	script := filepath.Join(dir, "helper.sh")
+	content := fmt.Sprintf("#!/bin/sh\necho x >> %q\ncat <<'EOF'\n%s\nEOF\n", counter, output)
+	require.NoError(t, os.WriteFile(script, []byte(content), 0o700))
+
+	return []string{"/bin/sh", script}, counter
+}
+
+func invocations(t *testing.T, counter string) int {
+	t.Helper()
+
+	content, err := os.ReadFile(counter)
+	if os.IsNotExist(err) {
+		return 0
+	}
+	require.NoError(t, err)
+	return len(content) / 2
+}
+
+func TestBearerTokenAuthenticator(t *testing.T) {
+	client, authorizations := newAuthenticatorTestServer(t, &BearerTokenAuthenticator{Token: "secret"})
+
+	_, diags := client.GetRequest(context.Background(), "/api/v1/connector/version")
+	require.False(t, diags.HasError(), "unexpected error: %v", diags)
+	assert.Equal(t, []string{"Bearer secret"}, *authorizations)
+}
+
+func TestCredentialHelperAuthenticator(t *testing.T) {
+	t.Run("token is cached for the run", func(t *testing.T) {
+		command, counter := writeCredentialHelper(t, `{"token":"from-helper"}`)
+		client, authorizations := newAuthenticatorTestServer(t, &CredentialHelperAuthenticator{Command: command})
+
+		for range 2 {
+			_, diags := client.GetRequest(context.Background(), "/api/v1/connector/version")
+			require.False(t, diags.HasError(), "unexpected error: %v", diags)
+		}
+		assert.Equal(t, []string{"Bearer from-helper", "Bearer from-helper"}, *authorizations)
+		assert.Equal(t, 1, invocations(t, counter))
+	})
+
+	t.Run("expiring credential is refreshed", func(t *testing.T) {
+		expiresAt := time.Now().Add(10 * time.Second).UTC().Format(time.RFC3339)
+		command, counter := writeCredentialHelper(t, fmt.Sprintf(`{"token":"short-lived","expires_at":%q}`, expiresAt))
+		authenticator := &CredentialHelperAuthenticator{Command: command}
+
+		for range 2 {
+			req, _ := http.NewRequest(http.MethodGet, "https://scc.example.com", nil)
+			require.NoError(t, authenticator.Authenticate(context.Background(), req))
+		}
+		assert.Equal(t, 2, invocations(t, counter))
+	})
+
+	t.Run("username and password use basic authentication", func(t *testing.T) {
+		command, _ := writeCredentialHelper(t, `{"username":"admin","password":"pass"}`)
+		authenticator := &CredentialHelperAuthenticator{Command: command}
+
+		req, _ := http.NewRequest(http.MethodGet, "https://scc.example.com", nil)
+		require.NoError(t, authenticator.Authenticate(context.Background(), req))
+
+		username, password, ok := req.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "admin", username)
+		assert.Equal(t, "pass", password)
+	})
+
+	t.Run("invalid output fails the request", func(t *testing.T) {
+		command, _ := writeCredentialHelper(t, `not json`)
+		client, authorizations := newAuthenticatorTestServer(t, &CredentialHelperAuthenticator{Command: command})
+
+		_, diags := client.GetRequest(context.Background(), "/api/v1/connector/version")
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Credential Helper Failed", diags[0].Summary())
+		assert.Empty(t, *authorizations)
+	})
+
+	t.Run("failing command", func(t *testing.T) {
+		authenticator := &CredentialHelperAuthenticator{Command: []string{"/bin/sh", "-c", "echo denied >&2; exit 1"}}
+
+		req, _ := http.NewRequest(http.MethodGet, "https://scc.example.com", nil)
+		err := authenticator.Authenticate(context.Background(), req)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "denied")
+	})
+}
+
+func TestNewPKCS12Authenticator(t *testing.T) {
+	_, keyPEM, cert, diags := generateSelfSignedCert()
+	require.False(t, diags.HasError())
+	block, _ := pem.Decode(keyPEM)
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	require.NoError(t, err)
+
+	// Bundles of current OpenSSL versions are encrypted with AES.
+	data, err := pkcs12.Modern.Encode(key, cert, nil, "password")
+	require.NoError(t, err)
+
+	authenticator, err := NewPKCS12Authenticator(data, "password")
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{cert.Raw}, authenticator.Certificate().Certificate)
+
+	_, err = NewPKCS12Authenticator(data, "wrong")
+	assert.ErrorContains(t, err, "Invalid PKCS#12 Client Certificate")
+}
+
+func TestNewPKCS12Authenticator_InvalidBundle(t *testing.T) {
+	_, err := NewPKCS12Authenticator([]byte("not a bundle"), "password")
+	assert.ErrorContains(t, err, "Invalid PKCS#12 Client Certificate")
+}
+
+func TestRestApiClient_ConflictingAuthenticators(t *testing.T) {
+	baseURL, _ := url.Parse("https://scc.example.com")
+
+	_, diags := NewRestApiClient(nil, baseURL, "user", "pass", nil, nil, nil, false, &BearerTokenAuthenticator{Token: "secret"})
+	assert.True(t, diags.HasError())
+}
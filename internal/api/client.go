@@ -2,15 +2,19 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
@@ -20,6 +24,17 @@ type RestApiClient struct {
 	BaseURL  *url.URL
 	Username string
 	Password string
+	Retry    RetryConfig
+	// Authenticator, if set, authenticates requests instead of Username and Password.
+	Authenticator Authenticator
+	// Instances, if set, holds the named instances requests can be routed to with WithInstance.
+	Instances *Instances
+
+	connectionCheck *deferredCheck
+	versions        *versionCache
+	// passwordMu guards Password, which is replaced when the password of the
+	// user the client authenticates as is changed during a run.
+	passwordMu sync.RWMutex
 }
 
 type ErrorResponse struct {
@@ -27,18 +42,30 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
-func NewRestApiClient(client *http.Client, baseURL *url.URL, username, password string, caCertBytes []byte, clientCertBytes []byte, clientCertKey []byte, skipSSLValidation bool) (*RestApiClient, diag.Diagnostics) {
+// NewRestApiClient creates a client that authenticates with either basic
+// authentication, a client certificate and key in PEM format, or one of
+// authenticators.
+func NewRestApiClient(client *http.Client, baseURL *url.URL, username, password string, caCertBytes []byte, clientCertBytes []byte, clientCertKey []byte, skipSSLValidation bool, authenticators ...Authenticator) (*RestApiClient, diag.Diagnostics) {
 	useBasicAuth, useCertAuth := isBasicAuthProvided(username, password), isCertAuthProvided(clientCertBytes, clientCertKey)
 
-	diags := validateAuthMode(useBasicAuth, useCertAuth)
+	diags := validateAuthMode(useBasicAuth, useCertAuth, len(authenticators))
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	tlsConfig, diags := buildTLSConfig(caCertBytes, clientCertBytes, clientCertKey, useCertAuth, skipSSLValidation)
+	var authenticator Authenticator
+	if len(authenticators) == 1 {
+		authenticator = authenticators[0]
+	}
+	tlsAuthenticator, useTLSAuth := authenticator.(TLSAuthenticator)
+
+	tlsConfig, diags := buildTLSConfig(caCertBytes, clientCertBytes, clientCertKey, useCertAuth, useTLSAuth, skipSSLValidation)
 	if diags.HasError() {
 		return nil, diags
 	}
+	if useTLSAuth {
+		tlsConfig.Certificates = []tls.Certificate{tlsAuthenticator.Certificate()}
+	}
 
 	if tlsConfig != nil {
 		client = withTLSClient(client, tlsConfig)
@@ -47,10 +74,13 @@ func NewRestApiClient(client *http.Client, baseURL *url.URL, username, password
 	}
 
 	return &RestApiClient{
-		BaseURL:  baseURL,
-		Client:   client,
-		Username: username,
-		Password: password,
+		BaseURL:       baseURL,
+		Client:        client,
+		Username:      username,
+		Password:      password,
+		Authenticator: authenticator,
+		Retry:         DefaultRetryConfig(),
+		versions:      &versionCache{},
 	}, diags
 }
 
@@ -62,9 +92,14 @@ func isCertAuthProvided(cert, key []byte) bool {
 	return len(cert) > 0 && len(key) > 0
 }
 
-func validateAuthMode(useBasicAuth, useCertAuth bool) diag.Diagnostics {
+func validateAuthMode(useBasicAuth, useCertAuth bool, authenticators int) diag.Diagnostics {
 	var diags diag.Diagnostics
 	switch {
+	case authenticators > 1 || (authenticators == 1 && (useBasicAuth || useCertAuth)):
+		diags.AddError("Authentication Conflict", "Cannot combine several authentication methods. Please choose one method.")
+		return diags
+	case authenticators == 1:
+		return diags
 	case useBasicAuth && useCertAuth:
 		// If both authentication methods are provided, return an error
 		diags.AddError("Authentication Conflict", "Cannot use both certificate-based and basic authentication simultaneously. Please choose one method.")
@@ -78,10 +113,10 @@ func validateAuthMode(useBasicAuth, useCertAuth bool) diag.Diagnostics {
 	}
 }
 
-func buildTLSConfig(caCert, clientCert, clientKey []byte, useCertAuth bool, skipSSLValidation bool) (*tls.Config, diag.Diagnostics) {
+func buildTLSConfig(caCert, clientCert, clientKey []byte, useCertAuth, useTLSAuth bool, skipSSLValidation bool) (*tls.Config, diag.Diagnostics) {
 	// if Certificate based authentication without SSL skip, it is mandatory to provide CA Certificate
 	var diags diag.Diagnostics
-	if len(caCert) == 0 && useCertAuth && !skipSSLValidation {
+	if len(caCert) == 0 && (useCertAuth || useTLSAuth) && !skipSSLValidation {
 		diags.AddError("Missing CA Certificate", "When using certificate-based authentication, a CA certificate must be provided.")
 		return nil, diags
 	}
@@ -155,12 +190,30 @@ func withTLSClient(client *http.Client, tlsConfig *tls.Config) *http.Client {
 	return client
 }
 
-func (c *RestApiClient) DoRequest(method string, endpoint string, body []byte, acceptType string, contentType string) (*http.Response, diag.Diagnostics) {
-	var diags diag.Diagnostics
+// DoRequest sends a request like Do and reports failures as diagnostics.
+func (c *RestApiClient) DoRequest(ctx context.Context, method string, endpoint string, body []byte, acceptType string, contentType string) (*http.Response, diag.Diagnostics) {
+	resp, err := c.Do(ctx, method, endpoint, body, acceptType, contentType)
+	return resp, ErrorDiagnostics(err)
+}
+
+// Do sends a request to endpoint, relative to the base URL of the client, and
+// retries it according to the retry configuration. A response with a status
+// code other than 200, 201 or 204 is returned as *APIError, all other failures
+// as *Error. Requests are sent to the instance selected with WithInstance, after
+// a connection check deferred with DeferConnectionCheck has passed. The caller
+// must close the body of the returned response.
+func (c *RestApiClient) Do(ctx context.Context, method string, endpoint string, body []byte, acceptType string, contentType string) (*http.Response, error) {
+	client, err := c.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if client != c {
+		return client.Do(WithInstance(ctx, ""), method, endpoint, body, acceptType, contentType)
+	}
+
 	endpointURL, err := url.Parse(endpoint)
 	if err != nil {
-		diags.AddError("Invalid Endpoint URL", fmt.Sprintf("Error parsing endpoint URL %s: %v", endpoint, err))
-		return nil, diags
+		return nil, &Error{Summary: "Invalid Endpoint URL", Detail: fmt.Sprintf("Error parsing endpoint URL %s: %v", endpoint, err)}
 	}
 
 	// Path joining preserves any base path on instance_url so that proxy deployments
@@ -171,10 +224,68 @@ func (c *RestApiClient) DoRequest(method string, endpoint string, body []byte, a
 	baseURL.Path = strings.TrimRight(baseURL.Path, "/") + "/" + strings.TrimLeft(endpointURL.Path, "/")
 	baseURL.RawQuery = endpointURL.RawQuery
 
-	req, err := http.NewRequest(method, baseURL.String(), bytes.NewBuffer(body))
+	ctx = withLogSubsystem(ctx)
+
+	cancelled := func() error {
+		return &Error{Summary: "Request Cancelled", Detail: fmt.Sprintf("The %s request to %s was cancelled: %v", method, baseURL.String(), ctx.Err())}
+	}
+
+	maxRetries := c.Retry.MaxRetries
+	if retriesDisabled(ctx) {
+		maxRetries = 0
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := c.send(ctx, method, baseURL.String(), body, acceptType, contentType, attempt)
+		var clientErr *Error
+		if errors.As(err, &clientErr) {
+			// Failures of the authenticator are not retried.
+			return nil, err
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, cancelled()
+			}
+			if attempt < maxRetries && isIdempotent(method) {
+				wait := c.Retry.backoff(attempt, nil)
+				logRetry(ctx, method, baseURL.Path, attempt, wait, err.Error())
+				if !sleepWithContext(ctx, wait) {
+					return nil, cancelled()
+				}
+				continue
+			}
+			return nil, &Error{Summary: "Request Failed", Detail: fmt.Sprintf("Error sending %s request to %s: %v", method, baseURL.String(), err)}
+		}
+
+		if attempt < maxRetries && shouldRetryStatus(method, resp.StatusCode) {
+			wait := c.Retry.backoff(attempt, resp)
+			logRetry(ctx, method, baseURL.Path, attempt, wait, resp.Status)
+			discardBody(resp)
+			if !sleepWithContext(ctx, wait) {
+				return nil, cancelled()
+			}
+			continue
+		}
+
+		if err := validateResponse(resp); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+}
+
+// send performs a single attempt of a request. When a request timeout is configured,
+// the timeout stays active until the caller closes the response body.
+func (c *RestApiClient) send(ctx context.Context, method, requestURL string, body []byte, acceptType, contentType string, attempt int) (*http.Response, error) {
+	cancel := context.CancelFunc(func() {})
+	if c.Retry.RequestTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.Retry.RequestTimeout)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, bytes.NewReader(body))
 	if err != nil {
-		diags.AddError("Failed to Create Request", fmt.Sprintf("Error creating request for %s %s: %v", method, baseURL.String(), err))
-		return nil, diags
+		cancel()
+		return nil, err
 	}
 
 	if acceptType != "" {
@@ -191,77 +302,75 @@ func (c *RestApiClient) DoRequest(method string, endpoint string, body []byte, a
 		}
 	}
 
-	if c.Username != "" && c.Password != "" {
-		req.SetBasicAuth(c.Username, c.Password)
+	if c.Authenticator != nil {
+		if err := c.Authenticator.Authenticate(ctx, req); err != nil {
+			cancel()
+			return nil, err
+		}
+	} else if username, password := c.basicAuth(); username != "" && password != "" {
+		req.SetBasicAuth(username, password)
 	}
 
+	logRequest(ctx, req, body, attempt)
+	start := time.Now()
+
 	resp, err := c.Client.Do(req)
 	if err != nil {
-		diags.AddError("Request Failed", fmt.Sprintf("Error sending %s request to %s: %v", method, baseURL.String(), err))
-		return nil, diags
+		logRequestError(ctx, method, req.URL.Path, err, time.Since(start))
+		cancel()
+		return nil, err
 	}
-	validateDiags := validateResponse(resp)
-	diags = append(diags, validateDiags...)
-	if diags.HasError() {
-		return nil, diags
-	}
-	return resp, diags
 
+	logResponse(ctx, req, resp, time.Since(start))
+
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
 }
 
-func validateResponse(response *http.Response) diag.Diagnostics {
-	var diags diag.Diagnostics
+// validateResponse returns an *APIError for unsuccessful responses and closes their body.
+func validateResponse(response *http.Response) error {
 	if response.StatusCode == http.StatusOK ||
 		response.StatusCode == http.StatusCreated ||
 		response.StatusCode == http.StatusNoContent {
-		return diags
+		return nil
 	}
 
 	bodyBytes, _ := io.ReadAll(response.Body)
-	if err := response.Body.Close(); err != nil {
-		diags.AddWarning("Response Body Close Failed", fmt.Sprintf("Failed to close response body: %v", err))
-	}
-	response.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
+	_ = response.Body.Close()
 
-	// Handle 401 Unauthorized explicitly
-	if response.StatusCode == http.StatusUnauthorized {
-		diags.AddError("Authentication Failed",
-			fmt.Sprintf("Authentication rejected: HTTP %d for %s %s. Response: %s",
-				response.StatusCode, response.Request.Method, response.Request.URL, string(bodyBytes)))
-		return diags
+	apiErr := &APIError{
+		StatusCode: response.StatusCode,
+		Method:     response.Request.Method,
+		URL:        response.Request.URL.String(),
+		Body:       string(bodyBytes),
 	}
 
 	// Attempt to decode a structured error message
 	var errorResp ErrorResponse
-	if err := json.Unmarshal(bodyBytes, &errorResp); err == nil && errorResp.Message != "" {
-		diags.AddError("API Error", fmt.Sprintf("HTTP %s %s failed with status %d: %s",
-			response.Request.Method, response.Request.URL, response.StatusCode, errorResp.Message))
-		return diags
+	if err := json.Unmarshal(bodyBytes, &errorResp); err == nil {
+		apiErr.Type = errorResp.Type
+		apiErr.Message = errorResp.Message
 	}
 
-	// Fallback to raw body
-	diags.AddError("API Error", fmt.Sprintf("HTTP %s %s failed with status %d. Raw response: %s",
-		response.Request.Method, response.Request.URL, response.StatusCode, string(bodyBytes)))
-
-	return diags
+	return apiErr
 }
 
-func (c *RestApiClient) GetRequest(endpoint string) (*http.Response, diag.Diagnostics) {
-	return c.DoRequest(http.MethodGet, endpoint, nil, "", "")
+func (c *RestApiClient) GetRequest(ctx context.Context, endpoint string) (*http.Response, diag.Diagnostics) {
+	return c.DoRequest(ctx, http.MethodGet, endpoint, nil, "", "")
 }
 
-func (c *RestApiClient) PostRequest(endpoint string, body []byte) (*http.Response, diag.Diagnostics) {
-	return c.DoRequest(http.MethodPost, endpoint, body, "", "")
+func (c *RestApiClient) PostRequest(ctx context.Context, endpoint string, body []byte) (*http.Response, diag.Diagnostics) {
+	return c.DoRequest(ctx, http.MethodPost, endpoint, body, "", "")
 }
 
-func (c *RestApiClient) PutRequest(endpoint string, body []byte) (*http.Response, diag.Diagnostics) {
-	return c.DoRequest(http.MethodPut, endpoint, body, "", "")
+func (c *RestApiClient) PutRequest(ctx context.Context, endpoint string, body []byte) (*http.Response, diag.Diagnostics) {
+	return c.DoRequest(ctx, http.MethodPut, endpoint, body, "", "")
 }
 
-func (c *RestApiClient) DeleteRequest(endpoint string) (*http.Response, diag.Diagnostics) {
-	return c.DoRequest(http.MethodDelete, endpoint, nil, "", "")
+func (c *RestApiClient) DeleteRequest(ctx context.Context, endpoint string) (*http.Response, diag.Diagnostics) {
+	return c.DoRequest(ctx, http.MethodDelete, endpoint, nil, "", "")
 }
 
-func (c *RestApiClient) PatchRequest(endpoint string, body []byte) (*http.Response, diag.Diagnostics) {
-	return c.DoRequest(http.MethodPatch, endpoint, body, "", "")
+func (c *RestApiClient) PatchRequest(ctx context.Context, endpoint string, body []byte) (*http.Response, diag.Diagnostics) {
+	return c.DoRequest(ctx, http.MethodPatch, endpoint, body, "", "")
 }
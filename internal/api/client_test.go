@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/tls"
@@ -45,7 +46,7 @@ func TestRestApiClient_BasicAuth(t *testing.T) {
 	}
 
 	t.Run("GET /success", func(t *testing.T) {
-		resp, diags := client.GetRequest("/success")
+		resp, diags := client.GetRequest(context.Background(), "/success")
 		if diags.HasError() {
 			t.Fatalf("unexpected error: %v", diags)
 		}
@@ -98,7 +99,7 @@ func TestRestApiClient_CertificateAuth(t *testing.T) {
 	}
 
 	t.Run("GET /secure", func(t *testing.T) {
-		resp, diags := client.GetRequest("/secure")
+		resp, diags := client.GetRequest(context.Background(), "/secure")
 		if diags.HasError() {
 			t.Fatalf("unexpected error: %v", diags)
 		}
@@ -222,9 +223,8 @@ func TestValidateResponse_SuccessCodes(t *testing.T) {
 			Body:       io.NopCloser(bytes.NewBuffer(nil)),
 		}
 
-		diags := validateResponse(resp)
-		if diags != nil {
-			t.Errorf("expected no error for status %d, got %v", code, diags)
+		if err := validateResponse(resp); err != nil {
+			t.Errorf("expected no error for status %d, got %v", code, err)
 		}
 	}
 }
@@ -239,7 +239,20 @@ func TestValidateResponse_ErrorWithValidJSON(t *testing.T) {
 		Body:       io.NopCloser(bytes.NewBufferString(body)),
 	}
 
-	diags := validateResponse(resp)
+	err := validateResponse(resp)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, &APIError{
+		StatusCode: http.StatusBadRequest,
+		Type:       "BadRequest",
+		Message:    "Invalid input",
+		Method:     http.MethodPost,
+		URL:        "http://example.com/api",
+		Body:       body,
+	}, apiErr)
+
+	diags := ErrorDiagnostics(err)
 	if !diags.HasError() {
 		t.Fatalf("expected error diagnostics, got none")
 	}
@@ -262,7 +275,7 @@ func TestValidateResponse_ErrorWithInvalidJSON(t *testing.T) {
 		Body:       io.NopCloser(bytes.NewBufferString(body)),
 	}
 
-	diags := validateResponse(resp)
+	diags := ErrorDiagnostics(validateResponse(resp))
 	if !diags.HasError() {
 		t.Fatalf("expected error diagnostics, got none")
 	}
@@ -314,7 +327,7 @@ func TestRestApiClient_DoRequest_BinaryResponse(t *testing.T) {
 	client, diags := createBasicAuthClient(server.URL)
 	require.False(t, diags.HasError())
 
-	resp, diags := client.DoRequest(http.MethodGet, "/cert", nil, "application/pkix-cert", "")
+	resp, diags := client.DoRequest(context.Background(), http.MethodGet, "/cert", nil, "application/pkix-cert", "")
 
 	require.False(t, diags.HasError())
 	body, _ := io.ReadAll(resp.Body)
@@ -383,7 +396,7 @@ func TestDoRequest_APIMPathPreserved(t *testing.T) {
 		Client:  server.Client(),
 	}
 
-	_, diags := client.GetRequest("/api/v1/connector/version")
+	_, diags := client.GetRequest(context.Background(), "/api/v1/connector/version")
 	if diags.HasError() {
 		t.Fatalf("unexpected error: %v", diags)
 	}
@@ -411,7 +424,7 @@ func TestDoRequest_NoBasePath(t *testing.T) {
 		Client:  server.Client(),
 	}
 
-	_, diags := client.GetRequest("/api/v1/connector/version")
+	_, diags := client.GetRequest(context.Background(), "/api/v1/connector/version")
 	if diags.HasError() {
 		t.Fatalf("unexpected error: %v", diags)
 	}
@@ -441,7 +454,7 @@ func TestDoRequest_QueryParamsPreserved(t *testing.T) {
 		Client:  server.Client(),
 	}
 
-	_, diags := client.GetRequest("/api/v1/test?foo=bar&x=1")
+	_, diags := client.GetRequest(context.Background(), "/api/v1/test?foo=bar&x=1")
 	require.False(t, diags.HasError())
 
 	assert.Equal(t, "/xp264-scc/api/v1/test", receivedPath)
@@ -464,7 +477,7 @@ func TestDoRequest_EmptyEndpoint(t *testing.T) {
 		Client:  server.Client(),
 	}
 
-	_, diags := client.GetRequest("")
+	_, diags := client.GetRequest(context.Background(), "")
 	require.False(t, diags.HasError())
 
 	assert.Equal(t, "/xp264-scc/", receivedPath)
@@ -486,7 +499,7 @@ func TestDoRequest_NoLeadingSlash(t *testing.T) {
 		Client:  server.Client(),
 	}
 
-	_, diags := client.GetRequest("api/v1/test")
+	_, diags := client.GetRequest(context.Background(), "api/v1/test")
 	require.False(t, diags.HasError())
 
 	assert.Equal(t, "/xp264-scc/api/v1/test", receivedPath)
@@ -508,7 +521,7 @@ func TestDoRequest_BaseQueryPreserved(t *testing.T) {
 		Client:  server.Client(),
 	}
 
-	_, diags := client.GetRequest("/api/v1/test?foo=bar")
+	_, diags := client.GetRequest(context.Background(), "/api/v1/test?foo=bar")
 	require.False(t, diags.HasError())
 
 	// Decide behavior: override OR merge
@@ -529,7 +542,7 @@ func TestRestApiClient_PostRequest(t *testing.T) {
 	client, diags := createBasicAuthClient(server.URL)
 	require.False(t, diags.HasError())
 
-	resp, diags := client.PostRequest("/resource", []byte(`{"key":"value"}`))
+	resp, diags := client.PostRequest(context.Background(), "/resource", []byte(`{"key":"value"}`))
 
 	require.False(t, diags.HasError())
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
@@ -550,7 +563,7 @@ func TestRestApiClient_PutRequest(t *testing.T) {
 	client, diags := createBasicAuthClient(server.URL)
 	require.False(t, diags.HasError())
 
-	resp, diags := client.PutRequest("/resource", []byte(`{"key":"value"}`))
+	resp, diags := client.PutRequest(context.Background(), "/resource", []byte(`{"key":"value"}`))
 
 	require.False(t, diags.HasError())
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
@@ -571,7 +584,7 @@ func TestRestApiClient_DeleteRequest(t *testing.T) {
 	client, diags := createBasicAuthClient(server.URL)
 	require.False(t, diags.HasError())
 
-	resp, diags := client.DeleteRequest("/resource")
+	resp, diags := client.DeleteRequest(context.Background(), "/resource")
 
 	require.False(t, diags.HasError())
 	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
@@ -592,7 +605,7 @@ func TestRestApiClient_PatchRequest(t *testing.T) {
 	client, diags := createBasicAuthClient(server.URL)
 	require.False(t, diags.HasError())
 
-	resp, diags := client.PatchRequest("/resource", []byte(`{"key":"value"}`))
+	resp, diags := client.PatchRequest(context.Background(), "/resource", []byte(`{"key":"value"}`))
 
 	require.False(t, diags.HasError())
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
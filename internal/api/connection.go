@@ -0,0 +1,63 @@
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ConnectionCheck tests the connection of a client to its Cloud Connector instance.
+type ConnectionCheck func(ctx context.Context, client *RestApiClient) diag.Diagnostics
+
+// deferredCheck runs a connection check once and caches its result.
+type deferredCheck struct {
+	once  sync.Once
+	check ConnectionCheck
+	err   error
+}
+
+// DeferConnectionCheck makes the client run check before it sends its first
+// request, instead of the caller running it upfront. The result of the check
+// is cached, so a failed check fails all later requests with the same error.
+func (c *RestApiClient) DeferConnectionCheck(check ConnectionCheck) {
+	c.connectionCheck = &deferredCheck{check: check}
+}
+
+// checkConnection runs the deferred connection check of the client, if any.
+func (c *RestApiClient) checkConnection(ctx context.Context) error {
+	if c.connectionCheck == nil {
+		return nil
+	}
+
+	c.connectionCheck.once.Do(func() {
+		// The check sends requests itself, so it gets a copy of the client
+		// without the deferred check.
+		username, password := c.basicAuth()
+		unchecked := RestApiClient{
+			Client:        c.Client,
+			BaseURL:       c.BaseURL,
+			Username:      username,
+			Password:      password,
+			Retry:         c.Retry,
+			Authenticator: c.Authenticator,
+			Instances:     c.Instances,
+			versions:      c.versions,
+		}
+
+		// The result is shared by all later requests, so it must not depend on
+		// the cancellation of the request that happens to run the check.
+		diags := c.connectionCheck.check(context.WithoutCancel(ctx), &unchecked)
+		if !diags.HasError() {
+			return
+		}
+		if err := DiagnosticsError(diags); err != nil {
+			c.connectionCheck.err = err
+			return
+		}
+		first := diags.Errors()[0]
+		c.connectionCheck.err = &Error{Summary: first.Summary(), Detail: first.Detail()}
+	})
+
+	return c.connectionCheck.err
+}
@@ -0,0 +1,15 @@
+package endpoints
+
+import "net/url"
+
+func GetAuditLogLevelEndpoint() string {
+	return "/api/v1/configuration/connector/auditLog/level"
+}
+
+func GetAuditLogEntriesEndpoint(query url.Values) string {
+	endpoint := "/api/v1/connector/auditLog/entries"
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	return endpoint
+}
@@ -0,0 +1,27 @@
+package endpoints
+
+import "fmt"
+
+func GetBasicAuthenticationEndpoint() string {
+	return "/api/v1/configuration/connector/authentication/basic"
+}
+
+func GetAuthenticationEndpoint() string {
+	return "/api/v1/configuration/connector/authentication"
+}
+
+func GetLDAPAuthenticationEndpoint() string {
+	return "/api/v1/configuration/connector/authentication/ldap"
+}
+
+func GetUsersBaseEndpoint() string {
+	return GetBasicAuthenticationEndpoint() + "/users"
+}
+
+func GetUserEndpoint(user string) string {
+	return fmt.Sprintf(GetUsersBaseEndpoint()+"/%s", user)
+}
+
+func GetUserRolesEndpoint(user string) string {
+	return GetUserEndpoint(user) + "/roles"
+}
@@ -1,9 +1,16 @@
 package endpoints
 
-func GetBackendTrustStoreBaseEndpoint() string {
-	return "/api/v1/configuration/connector/onPremise/truststore" // The path component onPremises of the URI is available as of version 2.18.0. Older versions must use onPremise. The latter is currently accepted by all versions, but we recommend that you use onPremises as onPremise may be discontinued at some point.
+// GetBackendTrustStoreBaseEndpoint returns the trust store endpoint. The path
+// component onPremises is available as of version 2.18.0, older versions must
+// use onPremise. The latter is currently accepted by all versions, but
+// onPremises is recommended as onPremise may be discontinued at some point.
+func GetBackendTrustStoreBaseEndpoint(onPremises bool) string {
+	if onPremises {
+		return "/api/v1/configuration/connector/onPremises/truststore"
+	}
+	return "/api/v1/configuration/connector/onPremise/truststore"
 }
 
-func GetBackendTrustStoreCertificateEndpoint() string {
-	return GetBackendTrustStoreBaseEndpoint() + "/certificates"
+func GetBackendTrustStoreCertificateEndpoint(onPremises bool) string {
+	return GetBackendTrustStoreBaseEndpoint(onPremises) + "/certificates"
 }
@@ -0,0 +1,5 @@
+package endpoints
+
+func GetConnectorVersionEndpoint() string {
+	return "/api/v1/connector/version"
+}
@@ -0,0 +1,10 @@
+package endpoints
+
+// GetKerberosEndpoint returns the Kerberos endpoint below onPremises, or below
+// onPremise for versions before 2.18.0 (see GetBackendTrustStoreBaseEndpoint).
+func GetKerberosEndpoint(onPremises bool) string {
+	if onPremises {
+		return "/api/v1/configuration/connector/onPremises/kerberos"
+	}
+	return "/api/v1/configuration/connector/onPremise/kerberos"
+}
@@ -3,3 +3,15 @@ package endpoints
 func GetMasterInstanceBaseEndpoint() string {
 	return "/api/v1/configuration/connector/ha/master"
 }
+
+func GetMasterInstanceConfigEndpoint() string {
+	return GetMasterInstanceBaseEndpoint() + "/config"
+}
+
+func GetMasterInstanceStateEndpoint() string {
+	return GetMasterInstanceBaseEndpoint() + "/state"
+}
+
+func GetHARoleEndpoint() string {
+	return "/api/v1/connector/ha/role"
+}
@@ -0,0 +1,13 @@
+package endpoints
+
+func GetShadowInstanceBaseEndpoint() string {
+	return "/api/v1/configuration/connector/ha/shadow"
+}
+
+func GetShadowInstanceConfigEndpoint() string {
+	return GetShadowInstanceBaseEndpoint() + "/config"
+}
+
+func GetShadowInstanceStateEndpoint() string {
+	return GetShadowInstanceBaseEndpoint() + "/state"
+}
@@ -0,0 +1,10 @@
+package endpoints
+
+// GetSNCEndpoint returns the SNC endpoint below onPremises, or below onPremise
+// for versions before 2.18.0 (see GetBackendTrustStoreBaseEndpoint).
+func GetSNCEndpoint(onPremises bool) string {
+	if onPremises {
+		return "/api/v1/configuration/connector/onPremises/snc"
+	}
+	return "/api/v1/configuration/connector/onPremise/snc"
+}
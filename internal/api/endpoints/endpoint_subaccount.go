@@ -9,3 +9,23 @@ func GetSubaccountEndpoint(regionHost, subaccount string) string {
 func GetSubaccountBaseEndpoint() string {
 	return "/api/v1/configuration/subaccounts"
 }
+
+func GetSubaccountStateEndpoint(regionHost, subaccount string) string {
+	return GetSubaccountEndpoint(regionHost, subaccount) + "/state"
+}
+
+func GetSubaccountTrustEndpoint(regionHost, subaccount string) string {
+	return GetSubaccountEndpoint(regionHost, subaccount) + "/trust"
+}
+
+func GetSubaccountTrustedIdentityProvidersEndpoint(regionHost, subaccount string) string {
+	return GetSubaccountTrustEndpoint(regionHost, subaccount) + "/identityProviders"
+}
+
+func GetSubaccountTrustedApplicationsEndpoint(regionHost, subaccount string) string {
+	return GetSubaccountTrustEndpoint(regionHost, subaccount) + "/applications"
+}
+
+func GetSubaccountValidityEndpoint(regionHost, subaccount string) string {
+	return GetSubaccountEndpoint(regionHost, subaccount) + "/validity"
+}
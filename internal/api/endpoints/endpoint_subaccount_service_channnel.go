@@ -9,3 +9,7 @@ func GetSubaccountServiceChannelBaseEndpoint(regionHost, subaccount, serviceChan
 func GetSubaccountServiceChannelEndpoint(regionHost, subaccount, serviceChannelType string, id int64) string {
 	return fmt.Sprintf(GetSubaccountServiceChannelBaseEndpoint(regionHost, subaccount, serviceChannelType)+"/%d", id)
 }
+
+func GetSubaccountServiceChannelStateEndpoint(regionHost, subaccount, serviceChannelType string, id int64) string {
+	return GetSubaccountServiceChannelEndpoint(regionHost, subaccount, serviceChannelType, id) + "/state"
+}
@@ -0,0 +1,5 @@
+package endpoints
+
+func GetTraceSettingsEndpoint() string {
+	return "/api/v1/configuration/connector/trace"
+}
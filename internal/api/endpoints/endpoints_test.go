@@ -1,6 +1,7 @@
 package endpoints
 
 import (
+	"net/url"
 	"strings"
 	"testing"
 
@@ -69,20 +70,74 @@ func TestGetMasterInstanceBaseEndpoint(t *testing.T) {
 	assert.True(t, strings.HasPrefix(ep, "/"))
 }
 
+func TestGetMasterInstanceConfigAndStateEndpoints(t *testing.T) {
+	base := GetMasterInstanceBaseEndpoint()
+	assert.Equal(t, base+"/config", GetMasterInstanceConfigEndpoint())
+	assert.Equal(t, base+"/state", GetMasterInstanceStateEndpoint())
+}
+
+func TestGetHARoleEndpoint(t *testing.T) {
+	ep := GetHARoleEndpoint()
+	assert.NotEmpty(t, ep)
+	assert.True(t, strings.HasPrefix(ep, "/"))
+}
+
 // ---------------------------------------------------------------------------
-// Backend trust store endpoints
+// Shadow instance endpoints
 // ---------------------------------------------------------------------------
 
-func TestGetBackendTrustStoreBaseEndpoint(t *testing.T) {
-	ep := GetBackendTrustStoreBaseEndpoint()
+func TestGetShadowInstanceEndpoints(t *testing.T) {
+	base := GetShadowInstanceBaseEndpoint()
+	assert.True(t, strings.HasPrefix(base, "/"))
+	assert.NotEqual(t, GetMasterInstanceBaseEndpoint(), base)
+	assert.Equal(t, base+"/config", GetShadowInstanceConfigEndpoint())
+	assert.Equal(t, base+"/state", GetShadowInstanceStateEndpoint())
+}
+
+// ---------------------------------------------------------------------------
+// Audit log endpoints
+// ---------------------------------------------------------------------------
+
+func TestGetAuditLogLevelEndpoint(t *testing.T) {
+	ep := GetAuditLogLevelEndpoint()
 	assert.NotEmpty(t, ep)
 	assert.True(t, strings.HasPrefix(ep, "/"))
 }
 
+func TestGetAuditLogEntriesEndpoint(t *testing.T) {
+	base := GetAuditLogEntriesEndpoint(nil)
+	assert.True(t, strings.HasPrefix(base, "/"))
+	assert.NotContains(t, base, "?")
+
+	ep := GetAuditLogEntriesEndpoint(url.Values{"user": []string{"admin"}})
+	assert.Equal(t, base+"?user=admin", ep)
+}
+
+// ---------------------------------------------------------------------------
+// Trace settings endpoint
+// ---------------------------------------------------------------------------
+
+func TestGetTraceSettingsEndpoint(t *testing.T) {
+	ep := GetTraceSettingsEndpoint()
+	assert.NotEmpty(t, ep)
+	assert.True(t, strings.HasPrefix(ep, "/"))
+}
+
+// ---------------------------------------------------------------------------
+// Backend trust store endpoints
+// ---------------------------------------------------------------------------
+
+func TestGetBackendTrustStoreBaseEndpoint(t *testing.T) {
+	assert.Equal(t, "/api/v1/configuration/connector/onPremises/truststore", GetBackendTrustStoreBaseEndpoint(true))
+	assert.Equal(t, "/api/v1/configuration/connector/onPremise/truststore", GetBackendTrustStoreBaseEndpoint(false))
+}
+
 func TestGetBackendTrustStoreCertificateEndpoint(t *testing.T) {
-	ep := GetBackendTrustStoreCertificateEndpoint()
-	base := GetBackendTrustStoreBaseEndpoint()
-	assert.True(t, strings.HasPrefix(ep, base), "certificate endpoint should extend base")
+	for _, onPremises := range []bool{true, false} {
+		ep := GetBackendTrustStoreCertificateEndpoint(onPremises)
+		base := GetBackendTrustStoreBaseEndpoint(onPremises)
+		assert.True(t, strings.HasPrefix(ep, base), "certificate endpoint should extend base")
+	}
 }
 
 // ---------------------------------------------------------------------------
@@ -123,6 +178,13 @@ func TestGetSubaccountEndpoint(t *testing.T) {
 	assert.Contains(t, ep, "my-subaccount")
 }
 
+func TestGetSubaccountTrustEndpoints(t *testing.T) {
+	base := GetSubaccountTrustEndpoint("eu12.hana.ondemand.com", "my-subaccount")
+	assert.Equal(t, GetSubaccountEndpoint("eu12.hana.ondemand.com", "my-subaccount")+"/trust", base)
+	assert.Equal(t, base+"/identityProviders", GetSubaccountTrustedIdentityProvidersEndpoint("eu12.hana.ondemand.com", "my-subaccount"))
+	assert.Equal(t, base+"/applications", GetSubaccountTrustedApplicationsEndpoint("eu12.hana.ondemand.com", "my-subaccount"))
+}
+
 // ---------------------------------------------------------------------------
 // Domain mapping endpoints
 // ---------------------------------------------------------------------------
@@ -194,3 +256,36 @@ func TestGetSystemMappingResourceEndpoint(t *testing.T) {
 	assert.True(t, strings.HasPrefix(ep, base))
 	assert.Contains(t, ep, "res-1")
 }
+
+// ---------------------------------------------------------------------------
+// User endpoints
+// ---------------------------------------------------------------------------
+
+func TestGetUsersBaseEndpoint(t *testing.T) {
+	assert.Equal(t, "/api/v1/configuration/connector/authentication/basic/users", GetUsersBaseEndpoint())
+}
+
+func TestGetUserEndpoints(t *testing.T) {
+	ep := GetUserEndpoint("jdoe")
+	assert.True(t, strings.HasPrefix(ep, GetUsersBaseEndpoint()))
+	assert.True(t, strings.HasSuffix(ep, "/jdoe"))
+	assert.Equal(t, ep+"/roles", GetUserRolesEndpoint("jdoe"))
+}
+
+// ---------------------------------------------------------------------------
+// SNC endpoints
+// ---------------------------------------------------------------------------
+
+func TestGetSNCEndpoint(t *testing.T) {
+	assert.Equal(t, "/api/v1/configuration/connector/onPremises/snc", GetSNCEndpoint(true))
+	assert.Equal(t, "/api/v1/configuration/connector/onPremise/snc", GetSNCEndpoint(false))
+}
+
+// ---------------------------------------------------------------------------
+// Kerberos endpoints
+// ---------------------------------------------------------------------------
+
+func TestGetKerberosEndpoint(t *testing.T) {
+	assert.Equal(t, "/api/v1/configuration/connector/onPremises/kerberos", GetKerberosEndpoint(true))
+	assert.Equal(t, "/api/v1/configuration/connector/onPremise/kerberos", GetKerberosEndpoint(false))
+}
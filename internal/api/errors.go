@@ -0,0 +1,111 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Error is returned by the client when a request could not be sent or its
+// response could not be processed.
+type Error struct {
+	Summary string
+	Detail  string
+}
+
+func (e *Error) Error() string {
+	return e.Summary + ": " + e.Detail
+}
+
+// APIError is returned by the client when the Cloud Connector answers a request
+// with a status code other than 200, 201 or 204. Type and Message hold the
+// error reported by the Cloud Connector, if the response body could be decoded;
+// Body holds the raw response body.
+type APIError struct {
+	StatusCode int
+	Type       string
+	Message    string
+	Method     string
+	URL        string
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return e.summary() + ": " + e.detail()
+}
+
+func (e *APIError) summary() string {
+	if e.StatusCode == http.StatusUnauthorized {
+		return "Authentication Failed"
+	}
+	return "API Error"
+}
+
+func (e *APIError) detail() string {
+	if e.StatusCode == http.StatusUnauthorized {
+		return fmt.Sprintf("Authentication rejected: HTTP %d for %s %s. Response: %s", e.StatusCode, e.Method, e.URL, e.Body)
+	}
+	if e.Message != "" {
+		return fmt.Sprintf("HTTP %s %s failed with status %d: %s", e.Method, e.URL, e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("HTTP %s %s failed with status %d. Raw response: %s", e.Method, e.URL, e.StatusCode, e.Body)
+}
+
+// IsNotFound reports whether err is an APIError with status 404, i.e. the
+// requested object does not exist (anymore) on the Cloud Connector.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an APIError with status 409.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+func hasStatus(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// errorDiagnostic is an error diagnostic that keeps the error it was created
+// from, so that callers of the diagnostics based helpers can still inspect it.
+type errorDiagnostic struct {
+	diag.ErrorDiagnostic
+	err error
+}
+
+// ErrorDiagnostics converts an error returned by the client into diagnostics.
+func ErrorDiagnostics(err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if err == nil {
+		return diags
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		diags.Append(errorDiagnostic{diag.NewErrorDiagnostic(apiErr.summary(), apiErr.detail()), err})
+		return diags
+	}
+
+	var clientErr *Error
+	if errors.As(err, &clientErr) {
+		diags.AddError(clientErr.Summary, clientErr.Detail)
+		return diags
+	}
+
+	diags.AddError("API Error", err.Error())
+	return diags
+}
+
+// DiagnosticsError returns the error the first error diagnostic was created
+// from by ErrorDiagnostics, or nil.
+func DiagnosticsError(diags diag.Diagnostics) error {
+	for _, d := range diags.Errors() {
+		if errDiag, ok := d.(errorDiagnostic); ok {
+			return errDiag.err
+		}
+	}
+	return nil
+}
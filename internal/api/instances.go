@@ -0,0 +1,175 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// InstanceConfig holds the connection settings of a named Cloud Connector instance.
+type InstanceConfig struct {
+	BaseURL           *url.URL
+	Username          string
+	Password          string
+	CACertificate     []byte
+	ClientCertificate []byte
+	ClientKey         []byte
+	SkipSSLValidation bool
+	Authenticator     Authenticator
+}
+
+// Instances creates and caches the clients of named Cloud Connector instances.
+// A client is only created, and its connection checked, when the first request
+// is sent to its instance.
+type Instances struct {
+	httpClient *http.Client
+	retry      RetryConfig
+	check      ConnectionCheck
+	configs    map[string]InstanceConfig
+
+	mu      sync.Mutex
+	entries map[string]*instanceEntry
+}
+
+type instanceEntry struct {
+	once   sync.Once
+	client *RestApiClient
+	err    error
+}
+
+// NewInstances returns the clients for configs. check, if not nil, is run once
+// per instance before its first request; its result is cached.
+func NewInstances(httpClient *http.Client, retry RetryConfig, configs map[string]InstanceConfig, check ConnectionCheck) *Instances {
+	return &Instances{
+		httpClient: httpClient,
+		retry:      retry,
+		check:      check,
+		configs:    configs,
+		entries:    map[string]*instanceEntry{},
+	}
+}
+
+// Names returns the sorted names of the configured instances.
+func (i *Instances) Names() []string {
+	names := make([]string, 0, len(i.configs))
+	for name := range i.configs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Has reports whether an instance with the given name is configured.
+func (i *Instances) Has(name string) bool {
+	_, ok := i.configs[name]
+	return ok
+}
+
+// Client returns the client of the named instance.
+func (i *Instances) Client(ctx context.Context, name string) (*RestApiClient, error) {
+	config, ok := i.configs[name]
+	if !ok {
+		return nil, unknownInstanceError(name, i.Names())
+	}
+
+	i.mu.Lock()
+	entry, ok := i.entries[name]
+	if !ok {
+		entry = &instanceEntry{}
+		i.entries[name] = entry
+	}
+	i.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.client, entry.err = i.newClient(ctx, name, config)
+	})
+
+	return entry.client, entry.err
+}
+
+func (i *Instances) newClient(ctx context.Context, name string, config InstanceConfig) (*RestApiClient, error) {
+	// Each instance gets its own copy of the HTTP client, as its TLS settings
+	// are applied to the transport of the client.
+	var httpClient *http.Client
+	if i.httpClient != nil {
+		c := *i.httpClient
+		httpClient = &c
+	}
+
+	client, diags := NewRestApiClient(httpClient, config.BaseURL, config.Username, config.Password, config.CACertificate, config.ClientCertificate, config.ClientKey, config.SkipSSLValidation, authenticators(config.Authenticator)...)
+	if diags.HasError() {
+		return nil, &Error{Summary: "Client Creation Failed", Detail: fmt.Sprintf("Failed to create the client of Cloud Connector instance %q: %s", name, diags.Errors()[0].Detail())}
+	}
+	client.Retry = i.retry
+
+	// The client, or its failure, is cached for all later requests, so the check
+	// must not depend on the cancellation of the request that happens to run it.
+	if i.check != nil {
+		if diags := i.check(WithInstance(context.WithoutCancel(ctx), ""), client); diags.HasError() {
+			return nil, &Error{Summary: diags.Errors()[0].Summary(), Detail: fmt.Sprintf("Cloud Connector instance %q: %s", name, diags.Errors()[0].Detail())}
+		}
+	}
+
+	return client, nil
+}
+
+// authenticators returns the variadic authenticators argument of NewRestApiClient.
+func authenticators(authenticator Authenticator) []Authenticator {
+	if authenticator == nil {
+		return nil
+	}
+	return []Authenticator{authenticator}
+}
+
+func unknownInstanceError(name string, names []string) error {
+	configured := "No instances are configured"
+	if len(names) > 0 {
+		configured = "Configured instances: " + strings.Join(names, ", ")
+	}
+	return &Error{
+		Summary: "Unknown Cloud Connector Instance",
+		Detail:  fmt.Sprintf("The instance %q is not configured in the instances attribute of the provider. %s.", name, configured),
+	}
+}
+
+type instanceContextKey struct{}
+
+// WithInstance returns a context that routes the requests of a client, which
+// has Instances set, to the named instance. An empty name selects the instance
+// the client itself is configured for.
+func WithInstance(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, instanceContextKey{}, name)
+}
+
+// InstanceFromContext returns the instance name set with WithInstance.
+func InstanceFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(instanceContextKey{}).(string)
+	return name
+}
+
+// resolve returns the client requests sent with ctx are routed to.
+func (c *RestApiClient) resolve(ctx context.Context) (*RestApiClient, error) {
+	if name := InstanceFromContext(ctx); name != "" {
+		if c.Instances == nil {
+			return nil, unknownInstanceError(name, nil)
+		}
+		return c.Instances.Client(ctx, name)
+	}
+
+	if err := c.checkConnection(ctx); err != nil {
+		return nil, err
+	}
+
+	if c.BaseURL == nil {
+		return nil, &Error{
+			Summary: "Missing Cloud Connector Instance",
+			Detail:  "The provider has no instance_url configured, so the instance attribute must name one of the instances configured in the provider.",
+		}
+	}
+
+	return c, nil
+}
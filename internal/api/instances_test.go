@@ -0,0 +1,140 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstances_Client(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	configs := map[string]InstanceConfig{
+		"dc1": {BaseURL: serverURL, Username: "admin", Password: "pass"},
+		"dc2": {BaseURL: serverURL, Username: "admin", Password: "wrong"},
+	}
+
+	var checked []string
+	check := func(ctx context.Context, client *RestApiClient) diag.Diagnostics {
+		checked = append(checked, client.Password)
+		assert.Empty(t, InstanceFromContext(ctx))
+
+		var diags diag.Diagnostics
+		if client.Password == "wrong" {
+			diags.AddError("Authentication Failed", "rejected")
+		}
+		return diags
+	}
+
+	instances := NewInstances(server.Client(), DefaultRetryConfig(), configs, check)
+	assert.Equal(t, []string{"dc1", "dc2"}, instances.Names())
+	assert.Empty(t, checked)
+
+	t.Run("client is created and checked once", func(t *testing.T) {
+		first, err := instances.Client(context.Background(), "dc1")
+		require.NoError(t, err)
+		second, err := instances.Client(context.Background(), "dc1")
+		require.NoError(t, err)
+
+		assert.Same(t, first, second)
+		assert.Equal(t, serverURL, first.BaseURL)
+		assert.Equal(t, []string{"pass"}, checked)
+	})
+
+	t.Run("failed check is cached", func(t *testing.T) {
+		for range 2 {
+			_, err := instances.Client(context.Background(), "dc2")
+
+			var clientErr *Error
+			require.ErrorAs(t, err, &clientErr)
+			assert.Equal(t, "Authentication Failed", clientErr.Summary)
+			assert.Equal(t, `Cloud Connector instance "dc2": rejected`, clientErr.Detail)
+		}
+		assert.Equal(t, []string{"pass", "wrong"}, checked)
+	})
+
+	t.Run("unknown instance", func(t *testing.T) {
+		_, err := instances.Client(context.Background(), "dc3")
+		assert.EqualError(t, err, `Unknown Cloud Connector Instance: The instance "dc3" is not configured in the instances attribute of the provider. Configured instances: dc1, dc2.`)
+	})
+}
+
+func TestInstances_Client_CancelledFirstCaller(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	// The check sends a request, like the version check of the provider.
+	check := func(ctx context.Context, client *RestApiClient) diag.Diagnostics {
+		response, err := client.Do(ctx, http.MethodGet, "/api/v1/connector/version", nil, "", "")
+		if err != nil {
+			return ErrorDiagnostics(err)
+		}
+		_ = response.Body.Close()
+		return nil
+	}
+
+	instances := NewInstances(server.Client(), DefaultRetryConfig(), map[string]InstanceConfig{
+		"dc1": {BaseURL: serverURL, Username: "admin", Password: "pass"},
+	}, check)
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The request of the first caller is cancelled, its connection check is not.
+	first, err := instances.Client(cancelled, "dc1")
+	require.NoError(t, err)
+
+	second, err := instances.Client(context.Background(), "dc1")
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+}
+
+func TestRestApiClient_WithInstance(t *testing.T) {
+	var hits []string
+	newServer := func(name string) *url.URL {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hits = append(hits, name)
+			w.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(server.Close)
+
+		serverURL, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		return serverURL
+	}
+
+	client, diags := NewRestApiClient(nil, newServer("default"), "admin", "pass", nil, nil, nil, false)
+	require.False(t, diags.HasError())
+	client.Instances = NewInstances(nil, DefaultRetryConfig(), map[string]InstanceConfig{
+		"dc2": {BaseURL: newServer("dc2"), Username: "admin", Password: "pass"},
+	}, nil)
+
+	for _, ctx := range []context.Context{
+		context.Background(),
+		WithInstance(context.Background(), "dc2"),
+		WithInstance(context.Background(), ""),
+	} {
+		resp, err := client.Do(ctx, http.MethodGet, "/api/v1/connector/version", nil, "", "")
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+
+	assert.Equal(t, []string{"default", "dc2", "default"}, hits)
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the tflog subsystem all Cloud Connector API traffic is logged to.
+// Its level can be raised independently with TF_LOG_PROVIDER_SCC_API.
+const LogSubsystem = "scc.api"
+
+// maxLoggedBodySize limits how much of a request or response body ends up in the log.
+const maxLoggedBodySize = 8 * 1024
+
+const redactedValue = "REDACTED"
+
+var (
+	// Matches JSON string fields carrying credentials, e.g. "password", "cloudPassword",
+	// "keyPassword", "authenticationData" or the Kerberos "keytab", independent of the
+	// casing used by the API.
+	sensitiveFieldPattern = regexp.MustCompile(`(?i)"([a-z0-9_]*(?:password|authenticationdata|privatekey|pkcs12|keystore|keytab|secret|token)[a-z0-9_]*)"\s*:\s*"(?:[^"\\]|\\.)*"`)
+
+	// Matches PEM encoded private keys, also when embedded in a JSON string with escaped newlines.
+	privateKeyPattern = regexp.MustCompile(`-----BEGIN ([A-Z ]*)PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)
+)
+
+func withLogSubsystem(ctx context.Context) context.Context {
+	return tflog.NewSubsystem(ctx, LogSubsystem)
+}
+
+func logRequest(ctx context.Context, req *http.Request, body []byte, attempt int) {
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Sending request to Cloud Connector", map[string]any{
+		"method":  req.Method,
+		"path":    req.URL.Path,
+		"attempt": attempt + 1,
+	})
+
+	if len(body) > 0 {
+		tflog.SubsystemTrace(ctx, LogSubsystem, "Request body", map[string]any{
+			"method": req.Method,
+			"path":   req.URL.Path,
+			"body":   redactBody(req.Header.Get("Content-Type"), body),
+		})
+	}
+}
+
+// logResponse logs the outcome of a single attempt. Textual response bodies are read,
+// redacted for the log and put back, so callers still see the unmodified response.
+func logResponse(ctx context.Context, req *http.Request, resp *http.Response, latency time.Duration) {
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Received response from Cloud Connector", map[string]any{
+		"method":     req.Method,
+		"path":       req.URL.Path,
+		"status":     resp.StatusCode,
+		"latency_ms": latency.Milliseconds(),
+	})
+
+	contentType := resp.Header.Get("Content-Type")
+	if !isTextContent(contentType) {
+		return
+	}
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+	if err != nil || len(bodyBytes) == 0 {
+		return
+	}
+
+	tflog.SubsystemTrace(ctx, LogSubsystem, "Response body", map[string]any{
+		"method": req.Method,
+		"path":   req.URL.Path,
+		"status": resp.StatusCode,
+		"body":   redactBody(contentType, bodyBytes),
+	})
+}
+
+func logRequestError(ctx context.Context, method, path string, err error, latency time.Duration) {
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Request to Cloud Connector failed", map[string]any{
+		"method":     method,
+		"path":       path,
+		"error":      err.Error(),
+		"latency_ms": latency.Milliseconds(),
+	})
+}
+
+func logRetry(ctx context.Context, method, path string, attempt int, wait time.Duration, reason string) {
+	tflog.SubsystemWarn(ctx, LogSubsystem, "Retrying request to Cloud Connector", map[string]any{
+		"method":  method,
+		"path":    path,
+		"attempt": attempt + 1,
+		"wait_ms": wait.Milliseconds(),
+		"reason":  reason,
+	})
+}
+
+// redactBody returns a loggable representation of a body. Binary payloads such as
+// PKCS#12 keystores, multipart uploads, certificates or backup archives are never
+// logged, only their size.
+func redactBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	if contentType != "" && !isTextContent(contentType) {
+		return fmt.Sprintf("[%d bytes of %s content omitted]", len(body), contentType)
+	}
+
+	redacted := sensitiveFieldPattern.ReplaceAllString(string(body), `"$1":"`+redactedValue+`"`)
+	redacted = privateKeyPattern.ReplaceAllString(redacted, "-----BEGIN ${1}PRIVATE KEY-----"+redactedValue+"-----END ${1}PRIVATE KEY-----")
+
+	if len(redacted) > maxLoggedBodySize {
+		return redacted[:maxLoggedBodySize] + fmt.Sprintf("... [%d bytes truncated]", len(redacted)-maxLoggedBodySize)
+	}
+
+	return redacted
+}
+
+func isTextContent(contentType string) bool {
+	contentType = strings.ToLower(contentType)
+	return strings.Contains(contentType, "json") ||
+		strings.HasPrefix(contentType, "text/")
+}
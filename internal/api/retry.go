@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultMaxRetries     = 3
+	DefaultRetryWaitMin   = 1 * time.Second
+	DefaultRetryWaitMax   = 30 * time.Second
+	DefaultRequestTimeout = 0 // no per-request timeout, the Terraform context still applies
+)
+
+// RetryConfig controls how the client retries requests that failed for transient reasons,
+// e.g. a Cloud Connector restart or a load balancer in front of it returning 502/503/504.
+type RetryConfig struct {
+	MaxRetries     int
+	RetryWaitMin   time.Duration
+	RetryWaitMax   time.Duration
+	RequestTimeout time.Duration
+}
+
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries:     DefaultMaxRetries,
+		RetryWaitMin:   DefaultRetryWaitMin,
+		RetryWaitMax:   DefaultRetryWaitMax,
+		RequestTimeout: DefaultRequestTimeout,
+	}
+}
+
+// backoff returns the wait time before the next attempt. The wait grows exponentially
+// from RetryWaitMin and is capped at RetryWaitMax. A Retry-After header sent along with
+// the response takes precedence if it asks for a longer wait, but never exceeds the cap.
+func (r RetryConfig) backoff(attempt int, resp *http.Response) time.Duration {
+	wait := r.RetryWaitMin
+	for i := 0; i < attempt && (r.RetryWaitMax <= 0 || wait < r.RetryWaitMax); i++ {
+		wait *= 2
+	}
+
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+			if retryAfter := time.Duration(seconds) * time.Second; retryAfter > wait {
+				wait = retryAfter
+			}
+		}
+	}
+
+	if r.RetryWaitMax > 0 && wait > r.RetryWaitMax {
+		wait = r.RetryWaitMax
+	}
+
+	return wait
+}
+
+type noRetryContextKey struct{}
+
+// withoutRetries returns a context whose requests are sent only once. It is
+// meant for requests that cannot be repeated after they reached the Cloud
+// Connector, even if the method is idempotent, e.g. a password change, whose
+// repetition authenticates with the password that was just replaced.
+func withoutRetries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryContextKey{}, true)
+}
+
+func retriesDisabled(ctx context.Context) bool {
+	noRetry, _ := ctx.Value(noRetryContextKey{}).(bool)
+	return noRetry
+}
+
+// isIdempotent reports whether a request can be sent again without risking a duplicate
+// side effect on the Cloud Connector if the first attempt did reach the server.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// shouldRetryStatus decides whether a response status is transient. 429 and 503 tell us
+// the request was not processed, so they are retried for every method. 502 and 504 come
+// from a gateway that may have forwarded the request, so they are only retried if the
+// request is idempotent.
+func shouldRetryStatus(method string, statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	default:
+		return false
+	}
+}
+
+func sleepWithContext(ctx context.Context, wait time.Duration) bool {
+	if wait <= 0 {
+		return ctx.Err() == nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func discardBody(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
+
+// cancelOnCloseBody releases the per-request timeout context once the caller is done
+// reading the response body.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,187 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRetryTestClient(t *testing.T, serverURL string, retry RetryConfig) *RestApiClient {
+	t.Helper()
+
+	client, diags := createBasicAuthClient(serverURL)
+	require.False(t, diags.HasError())
+
+	client.Retry = retry
+	return client
+}
+
+func fastRetryConfig(maxRetries int) RetryConfig {
+	return RetryConfig{
+		MaxRetries:   maxRetries,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: 5 * time.Millisecond,
+	}
+}
+
+func TestDoRequest_RetriesServiceUnavailable(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := newRetryTestClient(t, srv.URL, fastRetryConfig(3))
+
+	resp, diags := client.GetRequest(context.Background(), "/")
+	require.False(t, diags.HasError())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestDoRequest_RetriesExhausted(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	client := newRetryTestClient(t, srv.URL, fastRetryConfig(2))
+
+	_, diags := client.GetRequest(context.Background(), "/")
+	assert.True(t, diags.HasError())
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestDoRequest_NoRetryOfPostOnBadGateway(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	client := newRetryTestClient(t, srv.URL, fastRetryConfig(3))
+
+	_, diags := client.PostRequest(context.Background(), "/", []byte(`{}`))
+	assert.True(t, diags.HasError())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestDoRequest_RetryResendsBody(t *testing.T) {
+	var calls int32
+	var lastBody string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf := make([]byte, 64)
+		n, _ := r.Body.Read(buf)
+		lastBody = string(buf[:n])
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := newRetryTestClient(t, srv.URL, fastRetryConfig(3))
+
+	_, diags := client.PostRequest(context.Background(), "/", []byte(`{"a":1}`))
+	require.False(t, diags.HasError())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	assert.Equal(t, `{"a":1}`, lastBody)
+}
+
+func TestDoRequest_NoRetryOnClientError(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	client := newRetryTestClient(t, srv.URL, fastRetryConfig(3))
+
+	_, diags := client.GetRequest(context.Background(), "/")
+	assert.True(t, diags.HasError())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestDoRequest_ContextCancelledDuringBackoff(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	client := newRetryTestClient(t, srv.URL, RetryConfig{
+		MaxRetries:   5,
+		RetryWaitMin: time.Minute,
+		RetryWaitMax: time.Minute,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, diags := client.GetRequest(ctx, "/")
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Request Cancelled", diags[0].Summary())
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestDoRequest_RequestTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+	}))
+	defer srv.Close()
+
+	client := newRetryTestClient(t, srv.URL, RetryConfig{RequestTimeout: 20 * time.Millisecond})
+
+	_, diags := client.GetRequest(context.Background(), "/")
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Request Failed", diags[0].Summary())
+}
+
+func TestRetryConfig_Backoff(t *testing.T) {
+	r := RetryConfig{RetryWaitMin: time.Second, RetryWaitMax: 5 * time.Second}
+
+	assert.Equal(t, time.Second, r.backoff(0, nil))
+	assert.Equal(t, 2*time.Second, r.backoff(1, nil))
+	assert.Equal(t, 4*time.Second, r.backoff(2, nil))
+	assert.Equal(t, 5*time.Second, r.backoff(3, nil))
+	assert.Equal(t, 5*time.Second, r.backoff(30, nil))
+}
+
+func TestRetryConfig_BackoffHonoursRetryAfter(t *testing.T) {
+	r := RetryConfig{RetryWaitMin: time.Second, RetryWaitMax: 10 * time.Second}
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "3")
+	assert.Equal(t, 3*time.Second, r.backoff(0, resp))
+
+	resp.Header.Set("Retry-After", "120")
+	assert.Equal(t, 10*time.Second, r.backoff(0, resp))
+}
+
+func TestShouldRetryStatus(t *testing.T) {
+	assert.True(t, shouldRetryStatus(http.MethodPost, http.StatusTooManyRequests))
+	assert.True(t, shouldRetryStatus(http.MethodPost, http.StatusServiceUnavailable))
+	assert.True(t, shouldRetryStatus(http.MethodGet, http.StatusBadGateway))
+	assert.True(t, shouldRetryStatus(http.MethodDelete, http.StatusGatewayTimeout))
+	assert.False(t, shouldRetryStatus(http.MethodPost, http.StatusBadGateway))
+	assert.False(t, shouldRetryStatus(http.MethodPatch, http.StatusGatewayTimeout))
+	assert.False(t, shouldRetryStatus(http.MethodGet, http.StatusInternalServerError))
+}
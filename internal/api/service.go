@@ -0,0 +1,140 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+// service is embedded by all typed Cloud Connector API services.
+type service struct {
+	client *RestApiClient
+}
+
+// get sends a GET request to endpoint and decodes the JSON response into a value of type T.
+func get[T any](ctx context.Context, s service, endpoint string) (T, error) {
+	var out T
+	err := s.do(ctx, http.MethodGet, endpoint, nil, &out)
+	return out, err
+}
+
+// do sends body, if not nil, as JSON to endpoint and decodes the JSON response into out,
+// if not nil. Responses of requests without out are discarded.
+func (s service) do(ctx context.Context, method, endpoint string, body any, out any) error {
+	responseBody, err := s.send(ctx, method, endpoint, body, out != nil)
+	if err != nil || out == nil {
+		return err
+	}
+
+	if err := json.Unmarshal(responseBody, out); err != nil {
+		return &Error{Summary: "Failed to Unmarshal Response Body", Detail: fmt.Sprintf("failed to unmarshal API response body: %v", err)}
+	}
+
+	return nil
+}
+
+// send sends body, if not nil, as JSON to endpoint and returns the raw response body
+// if read is set.
+func (s service) send(ctx context.Context, method, endpoint string, body any, read bool) ([]byte, error) {
+	requestBody, err := marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Do(ctx, method, endpoint, requestBody, "", "")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	if !read {
+		return nil, nil
+	}
+
+	return readBody(response)
+}
+
+// download sends body, if not nil, as JSON to endpoint and returns the raw response
+// body in the media type accept, or any media type if accept is empty, along with
+// the reported content type.
+func (s service) download(ctx context.Context, method, endpoint string, body any, accept string) ([]byte, string, error) {
+	requestBody, err := marshal(body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	response, err := s.client.Do(ctx, method, endpoint, requestBody, accept, "")
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	responseBody, err := readBody(response)
+	return responseBody, response.Header.Get("Content-Type"), err
+}
+
+// formField is a field of a multipart form. Fields with a file name are sent
+// as file.
+type formField struct {
+	name     string
+	value    []byte
+	fileName string
+}
+
+// upload sends fields as multipart form to endpoint and discards the response.
+func (s service) upload(ctx context.Context, method, endpoint string, fields ...formField) error {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	for _, field := range fields {
+		var part io.Writer
+		var err error
+		if field.fileName != "" {
+			part, err = writer.CreateFormFile(field.name, field.fileName)
+		} else {
+			part, err = writer.CreateFormField(field.name)
+		}
+		if err == nil {
+			_, err = part.Write(field.value)
+		}
+		if err != nil {
+			return &Error{Summary: "Failed to Create Multipart Form", Detail: fmt.Sprintf("failed to write %s to multipart form: %v", field.name, err)}
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return &Error{Summary: "Failed to Finalize Multipart Form", Detail: fmt.Sprintf("failed to close multipart writer: %v", err)}
+	}
+
+	response, err := s.client.Do(ctx, method, endpoint, body.Bytes(), "", writer.FormDataContentType())
+	if err != nil {
+		return err
+	}
+	_ = response.Body.Close()
+
+	return nil
+}
+
+func marshal(body any) ([]byte, error) {
+	if body == nil {
+		return nil, nil
+	}
+
+	requestBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, &Error{Summary: "Failed to Marshal Request Body", Detail: fmt.Sprintf("failed to marshal API request body: %v", err)}
+	}
+	return requestBody, nil
+}
+
+func readBody(response *http.Response) ([]byte, error) {
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, &Error{Summary: "Failed to Read Response Body", Detail: fmt.Sprintf("failed to read API response body: %v", err)}
+	}
+	return responseBody, nil
+}
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+)
+
+// AuditLogService manages the audit log of the Cloud Connector.
+type AuditLogService struct {
+	service
+}
+
+func (c *RestApiClient) AuditLog() *AuditLogService {
+	return &AuditLogService{service{client: c}}
+}
+
+func (s *AuditLogService) Level(ctx context.Context) (apiobjects.AuditLogLevel, error) {
+	return get[apiobjects.AuditLogLevel](ctx, s.service, endpoints.GetAuditLogLevelEndpoint())
+}
+
+func (s *AuditLogService) UpdateLevel(ctx context.Context, level apiobjects.AuditLogLevel) error {
+	return s.do(ctx, http.MethodPut, endpoints.GetAuditLogLevelEndpoint(), level, nil)
+}
+
+// Entries reads the audit log entries matching query, which may filter by
+// "from", "to" and "user".
+func (s *AuditLogService) Entries(ctx context.Context, query url.Values) ([]apiobjects.AuditLogEntry, error) {
+	return get[[]apiobjects.AuditLogEntry](ctx, s.service, endpoints.GetAuditLogEntriesEndpoint(query))
+}
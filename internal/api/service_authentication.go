@@ -0,0 +1,66 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+)
+
+// AuthenticationService manages how users log on to the Cloud Connector.
+type AuthenticationService struct {
+	service
+}
+
+func (c *RestApiClient) Authentication() *AuthenticationService {
+	return &AuthenticationService{service{client: c}}
+}
+
+// ChangePassword changes the password of the local user from password to
+// newPassword. If the client authenticates as user with basic authentication,
+// it sends its later requests with newPassword. The request is not retried, as
+// a retry after a lost response would present the replaced password.
+func (s *AuthenticationService) ChangePassword(ctx context.Context, user, password, newPassword string) error {
+	body := apiobjects.PasswordChange{User: user, Password: password, NewPassword: newPassword}
+	if err := s.do(withoutRetries(ctx), http.MethodPut, endpoints.GetBasicAuthenticationEndpoint(), body, nil); err != nil {
+		return err
+	}
+
+	client, err := s.client.resolve(ctx)
+	if err != nil {
+		return err
+	}
+	client.switchPassword(user, newPassword)
+	return nil
+}
+
+// Type reads the user store the Cloud Connector authenticates administrators
+// against, apiobjects.AuthenticationTypeBasic or apiobjects.AuthenticationTypeLDAP.
+func (s *AuthenticationService) Type(ctx context.Context) (string, error) {
+	authentication, err := get[apiobjects.Authentication](ctx, s.service, endpoints.GetAuthenticationEndpoint())
+	return authentication.Type, err
+}
+
+// LDAP reads the LDAP configuration of the Cloud Connector.
+func (s *AuthenticationService) LDAP(ctx context.Context) (apiobjects.LDAPAuthentication, error) {
+	return get[apiobjects.LDAPAuthentication](ctx, s.service, endpoints.GetLDAPAuthenticationEndpoint())
+}
+
+// UpdateLDAP replaces the LDAP configuration of the Cloud Connector. It does not
+// switch the Cloud Connector to LDAP authentication.
+func (s *AuthenticationService) UpdateLDAP(ctx context.Context, ldap apiobjects.LDAPAuthentication) error {
+	return s.do(ctx, http.MethodPut, endpoints.GetLDAPAuthenticationEndpoint(), ldap, nil)
+}
+
+// DeleteLDAP removes the LDAP configuration of the Cloud Connector.
+func (s *AuthenticationService) DeleteLDAP(ctx context.Context) error {
+	return s.do(ctx, http.MethodDelete, endpoints.GetLDAPAuthenticationEndpoint(), nil, nil)
+}
+
+// SwitchToLDAP makes the Cloud Connector authenticate administrators against
+// its LDAP configuration instead of the local user store.
+func (s *AuthenticationService) SwitchToLDAP(ctx context.Context) error {
+	body := apiobjects.Authentication{Type: apiobjects.AuthenticationTypeLDAP}
+	return s.do(ctx, http.MethodPut, endpoints.GetAuthenticationEndpoint(), body, nil)
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+)
+
+// BackendTrustStoreService manages the CA certificates the Cloud Connector
+// trusts for TLS connections to on-premise backend systems.
+type BackendTrustStoreService struct {
+	service
+}
+
+func (c *RestApiClient) BackendTrustStore() *BackendTrustStoreService {
+	return &BackendTrustStoreService{service{client: c}}
+}
+
+func (s *BackendTrustStoreService) Get(ctx context.Context) (apiobjects.BackendTrustStoreConfiguration, error) {
+	return get[apiobjects.BackendTrustStoreConfiguration](ctx, s.service, s.endpoint(ctx))
+}
+
+// SetTrustAllBackends switches between trusting all backend certificates and
+// trusting only the certificates of the trust store.
+func (s *BackendTrustStoreService) SetTrustAllBackends(ctx context.Context, trustAllBackends bool) error {
+	body := apiobjects.BackendTrustStoreRequest{TrustAllBackends: trustAllBackends}
+	return s.do(ctx, http.MethodPatch, s.endpoint(ctx), body, nil)
+}
+
+// UploadCertificate adds the PEM-encoded certificate to the trust store. The
+// Cloud Connector derives the alias from the certificate.
+func (s *BackendTrustStoreService) UploadCertificate(ctx context.Context, certificate string) error {
+	return s.upload(ctx, http.MethodPost, s.certificatesEndpoint(ctx),
+		formField{name: "certificate", value: []byte(certificate), fileName: "certificate.pem"},
+	)
+}
+
+// DownloadCertificate returns the DER-encoded certificate with alias.
+func (s *BackendTrustStoreService) DownloadCertificate(ctx context.Context, alias string) ([]byte, error) {
+	certificate, _, err := s.download(ctx, http.MethodGet, s.certificatesEndpoint(ctx)+"/"+alias, nil, certificateMediaType)
+	return certificate, err
+}
+
+func (s *BackendTrustStoreService) DeleteCertificate(ctx context.Context, alias string) error {
+	return s.do(ctx, http.MethodDelete, s.certificatesEndpoint(ctx)+"/"+alias, nil, nil)
+}
+
+func (s *BackendTrustStoreService) endpoint(ctx context.Context) string {
+	return endpoints.GetBackendTrustStoreBaseEndpoint(s.client.Supports(ctx, VersionOnPremisesPath))
+}
+
+func (s *BackendTrustStoreService) certificatesEndpoint(ctx context.Context) string {
+	return endpoints.GetBackendTrustStoreCertificateEndpoint(s.client.Supports(ctx, VersionOnPremisesPath))
+}
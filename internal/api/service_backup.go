@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+)
+
+// BackupService creates backups of the Cloud Connector configuration and
+// restores them.
+type BackupService struct {
+	service
+}
+
+func (c *RestApiClient) Backup() *BackupService {
+	return &BackupService{service{client: c}}
+}
+
+// Create returns a backup of the configuration as ZIP archive, protected with
+// password.
+func (s *BackupService) Create(ctx context.Context, password string) ([]byte, error) {
+	body := apiobjects.BackupRequest{Password: password}
+	archive, contentType, err := s.download(ctx, http.MethodPost, endpoints.GetBackupEndpoint(), body, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType != "application/zip" {
+		return nil, &Error{
+			Summary: "Unexpected Content-Type",
+			Detail:  fmt.Sprintf("Expected 'application/zip', but got '%s'. The backup response may be invalid.", contentType),
+		}
+	}
+
+	if len(archive) == 0 {
+		return nil, &Error{Summary: "Empty Backup Response", Detail: "The API response did not contain a valid backup archive."}
+	}
+
+	return archive, nil
+}
+
+// Restore replaces the configuration with the backup archive protected with
+// password. fileName is the name of the archive reported to the Cloud Connector.
+func (s *BackupService) Restore(ctx context.Context, fileName string, archive []byte, password string) error {
+	return s.upload(ctx, http.MethodPut, endpoints.GetBackupEndpoint(),
+		formField{name: "password", value: []byte(password)},
+		formField{name: "backup", value: archive, fileName: filepath.Base(fileName)},
+	)
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+)
+
+// certificateMediaType is the media type of DER-encoded certificates.
+const certificateMediaType = "application/pkix-cert"
+
+// CertificateService manages one of the certificates of the Cloud Connector:
+// the CA certificate for principal propagation, the system certificate or the
+// UI certificate.
+type CertificateService struct {
+	service
+	endpoint string
+}
+
+func (c *RestApiClient) CACertificate() *CertificateService {
+	return &CertificateService{service{client: c}, endpoints.GetCACertificateEndpoint()}
+}
+
+func (c *RestApiClient) SystemCertificate() *CertificateService {
+	return &CertificateService{service{client: c}, endpoints.GetSystemCertificateEndpoint()}
+}
+
+func (c *RestApiClient) UICertificate() *CertificateService {
+	return &CertificateService{service{client: c}, endpoints.GetUICertificateEndpoint()}
+}
+
+// Get returns the metadata of the certificate.
+func (s *CertificateService) Get(ctx context.Context) (apiobjects.Certificate, error) {
+	return get[apiobjects.Certificate](ctx, s.service, s.endpoint)
+}
+
+// Download returns the DER-encoded certificate.
+func (s *CertificateService) Download(ctx context.Context) ([]byte, error) {
+	certificate, _, err := s.download(ctx, http.MethodGet, s.endpoint, nil, certificateMediaType)
+	return certificate, err
+}
+
+// CreateSelfSigned replaces the certificate with a new self-signed certificate.
+func (s *CertificateService) CreateSelfSigned(ctx context.Context, request apiobjects.CertificateRequest) error {
+	request.Type = "selfsigned"
+	return s.do(ctx, http.MethodPost, s.endpoint, request, nil)
+}
+
+// UploadSignedChain replaces the certificate with the PEM-encoded certificate
+// chain signed for the certificate signing request of GenerateCSR.
+func (s *CertificateService) UploadSignedChain(ctx context.Context, chain string) error {
+	return s.upload(ctx, http.MethodPatch, s.endpoint,
+		formField{name: "signedCertificate", value: []byte(chain), fileName: "signed_chain.pem"},
+	)
+}
+
+// UploadPKCS12 replaces the certificate with the certificate and private key of
+// a PKCS#12 archive. The key password is only sent if not empty.
+func (s *CertificateService) UploadPKCS12(ctx context.Context, pkcs12 []byte, password, keyPassword string) error {
+	fields := []formField{{name: "password", value: []byte(password)}}
+	if keyPassword != "" {
+		fields = append(fields, formField{name: "keyPassword", value: []byte(keyPassword)})
+	}
+	fields = append(fields, formField{name: "pkcs12", value: pkcs12, fileName: "certificate.p12"})
+
+	return s.upload(ctx, http.MethodPut, s.endpoint, fields...)
+}
+
+func (s *CertificateService) Delete(ctx context.Context) error {
+	return s.do(ctx, http.MethodDelete, s.endpoint, nil, nil)
+}
+
+// GenerateCSR creates a new key pair for the certificate and returns the
+// PEM-encoded certificate signing request for it. The certificate itself is
+// replaced once the signed certificate chain is uploaded.
+func (s *CertificateService) GenerateCSR(ctx context.Context, request apiobjects.CertificateRequest) (string, error) {
+	request.Type = "csr"
+	csr, err := s.send(ctx, http.MethodPost, s.endpoint, request, true)
+	return string(csr), err
+}
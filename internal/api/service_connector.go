@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+)
+
+// ConnectorService reads information about the Cloud Connector itself.
+type ConnectorService struct {
+	service
+}
+
+func (c *RestApiClient) Connector() *ConnectorService {
+	return &ConnectorService{service{client: c}}
+}
+
+// Version reads the version of the Cloud Connector. Unlike RestApiClient.Version,
+// it always sends a request.
+func (s *ConnectorService) Version(ctx context.Context) (apiobjects.ConnectorVersion, error) {
+	return get[apiobjects.ConnectorVersion](ctx, s.service, endpoints.GetConnectorVersionEndpoint())
+}
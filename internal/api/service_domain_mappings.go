@@ -0,0 +1,34 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+)
+
+// DomainMappingsService manages the mappings of virtual to internal domains of a subaccount.
+type DomainMappingsService struct {
+	service
+}
+
+func (c *RestApiClient) DomainMappings() *DomainMappingsService {
+	return &DomainMappingsService{service{client: c}}
+}
+
+func (s *DomainMappingsService) List(ctx context.Context, regionHost, subaccount string) ([]apiobjects.DomainMapping, error) {
+	return get[[]apiobjects.DomainMapping](ctx, s.service, endpoints.GetDomainMappingBaseEndpoint(regionHost, subaccount))
+}
+
+func (s *DomainMappingsService) Create(ctx context.Context, regionHost, subaccount string, body apiobjects.DomainMappingRequest) error {
+	return s.do(ctx, http.MethodPost, endpoints.GetDomainMappingBaseEndpoint(regionHost, subaccount), body, nil)
+}
+
+func (s *DomainMappingsService) Update(ctx context.Context, regionHost, subaccount, internalDomain string, body apiobjects.DomainMappingRequest) error {
+	return s.do(ctx, http.MethodPut, endpoints.GetDomainMappingEndpoint(regionHost, subaccount, internalDomain), body, nil)
+}
+
+func (s *DomainMappingsService) Delete(ctx context.Context, regionHost, subaccount, internalDomain string) error {
+	return s.do(ctx, http.MethodDelete, endpoints.GetDomainMappingEndpoint(regionHost, subaccount, internalDomain), nil, nil)
+}
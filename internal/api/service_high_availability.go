@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+)
+
+// HighAvailabilityService manages the high availability setup of a master
+// instance and its shadow instance. The master operations must be sent to the
+// master instance and the shadow operations to the shadow instance.
+type HighAvailabilityService struct {
+	service
+}
+
+func (c *RestApiClient) HighAvailability() *HighAvailabilityService {
+	return &HighAvailabilityService{service{client: c}}
+}
+
+// Role reads whether the Cloud Connector is a master or a shadow instance.
+func (s *HighAvailabilityService) Role(ctx context.Context) (apiobjects.HARole, error) {
+	return get[apiobjects.HARole](ctx, s.service, endpoints.GetHARoleEndpoint())
+}
+
+func (s *HighAvailabilityService) MasterConfiguration(ctx context.Context) (apiobjects.HAMasterConfiguration, error) {
+	return get[apiobjects.HAMasterConfiguration](ctx, s.service, endpoints.GetMasterInstanceConfigEndpoint())
+}
+
+func (s *HighAvailabilityService) UpdateMasterConfiguration(ctx context.Context, configuration apiobjects.HAMasterConfiguration) error {
+	return s.do(ctx, http.MethodPut, endpoints.GetMasterInstanceConfigEndpoint(), configuration, nil)
+}
+
+func (s *HighAvailabilityService) MasterState(ctx context.Context) (apiobjects.HAMasterState, error) {
+	return get[apiobjects.HAMasterState](ctx, s.service, endpoints.GetMasterInstanceStateEndpoint())
+}
+
+func (s *HighAvailabilityService) ShadowConfiguration(ctx context.Context) (apiobjects.HAShadowConfiguration, error) {
+	return get[apiobjects.HAShadowConfiguration](ctx, s.service, endpoints.GetShadowInstanceConfigEndpoint())
+}
+
+func (s *HighAvailabilityService) UpdateShadowConfiguration(ctx context.Context, configuration apiobjects.HAShadowConfiguration) error {
+	return s.do(ctx, http.MethodPut, endpoints.GetShadowInstanceConfigEndpoint(), configuration, nil)
+}
+
+func (s *HighAvailabilityService) ShadowState(ctx context.Context) (apiobjects.HAShadowState, error) {
+	return get[apiobjects.HAShadowState](ctx, s.service, endpoints.GetShadowInstanceStateEndpoint())
+}
+
+// Connect connects the shadow instance to its master instance with the
+// credentials of an administrator of the master instance.
+func (s *HighAvailabilityService) Connect(ctx context.Context, user, password string) error {
+	body := apiobjects.HAShadowOperation{Op: "CONNECT", User: user, Password: password}
+	return s.do(ctx, http.MethodPost, endpoints.GetShadowInstanceStateEndpoint(), body, nil)
+}
+
+// Disconnect drops the connection of the shadow instance to its master instance.
+func (s *HighAvailabilityService) Disconnect(ctx context.Context) error {
+	body := apiobjects.HAShadowOperation{Op: "DISCONNECT"}
+	return s.do(ctx, http.MethodPost, endpoints.GetShadowInstanceStateEndpoint(), body, nil)
+}
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+)
+
+// KerberosService manages the Kerberos configuration the Cloud Connector uses
+// for principal propagation to HTTP backend systems.
+type KerberosService struct {
+	service
+}
+
+func (c *RestApiClient) Kerberos() *KerberosService {
+	return &KerberosService{service{client: c}}
+}
+
+func (s *KerberosService) Get(ctx context.Context) (apiobjects.KerberosSettings, error) {
+	return get[apiobjects.KerberosSettings](ctx, s.service, s.endpoint(ctx))
+}
+
+func (s *KerberosService) Update(ctx context.Context, settings apiobjects.KerberosSettings) error {
+	return s.do(ctx, http.MethodPut, s.endpoint(ctx), settings, nil)
+}
+
+// Delete removes the Kerberos configuration, which disables Kerberos
+// principal propagation.
+func (s *KerberosService) Delete(ctx context.Context) error {
+	return s.do(ctx, http.MethodDelete, s.endpoint(ctx), nil, nil)
+}
+
+func (s *KerberosService) endpoint(ctx context.Context) string {
+	return endpoints.GetKerberosEndpoint(s.client.Supports(ctx, VersionOnPremisesPath))
+}
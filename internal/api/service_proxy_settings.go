@@ -0,0 +1,33 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+)
+
+// ProxySettingsService manages the HTTPS proxy the Cloud Connector uses to
+// connect to SAP BTP.
+type ProxySettingsService struct {
+	service
+}
+
+func (c *RestApiClient) ProxySettings() *ProxySettingsService {
+	return &ProxySettingsService{service{client: c}}
+}
+
+func (s *ProxySettingsService) Get(ctx context.Context) (apiobjects.ProxySettings, error) {
+	return get[apiobjects.ProxySettings](ctx, s.service, endpoints.GetProxySettingsEndpoint())
+}
+
+func (s *ProxySettingsService) Update(ctx context.Context, body apiobjects.ProxySettingsRequest) error {
+	return s.do(ctx, http.MethodPut, endpoints.GetProxySettingsEndpoint(), body, nil)
+}
+
+// Delete removes the proxy settings, so the Cloud Connector connects to SAP
+// BTP directly.
+func (s *ProxySettingsService) Delete(ctx context.Context) error {
+	return s.do(ctx, http.MethodDelete, endpoints.GetProxySettingsEndpoint(), nil, nil)
+}
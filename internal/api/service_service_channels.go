@@ -0,0 +1,89 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+)
+
+// Service channel types as used in the endpoints of the Cloud Connector API.
+const (
+	ServiceChannelTypeABAPCloud    = "ABAPCloud"
+	ServiceChannelTypeABAPCloudSNC = "ABAPCloudSNC"
+	ServiceChannelTypeK8S          = "K8S"
+)
+
+// ABAPServiceChannelsService manages the service channels of a subaccount to ABAP
+// Cloud tenants. channelType is either ServiceChannelTypeABAPCloud or
+// ServiceChannelTypeABAPCloudSNC.
+type ABAPServiceChannelsService struct {
+	service
+}
+
+func (c *RestApiClient) ABAPServiceChannels() *ABAPServiceChannelsService {
+	return &ABAPServiceChannelsService{service{client: c}}
+}
+
+func (s *ABAPServiceChannelsService) List(ctx context.Context, regionHost, subaccount, channelType string) ([]apiobjects.SubaccountABAPServiceChannel, error) {
+	return get[[]apiobjects.SubaccountABAPServiceChannel](ctx, s.service, endpoints.GetSubaccountServiceChannelBaseEndpoint(regionHost, subaccount, channelType))
+}
+
+func (s *ABAPServiceChannelsService) Get(ctx context.Context, regionHost, subaccount, channelType string, id int64) (apiobjects.SubaccountABAPServiceChannel, error) {
+	return get[apiobjects.SubaccountABAPServiceChannel](ctx, s.service, endpoints.GetSubaccountServiceChannelEndpoint(regionHost, subaccount, channelType, id))
+}
+
+func (s *ABAPServiceChannelsService) Create(ctx context.Context, regionHost, subaccount, channelType string, body apiobjects.SubaccountABAPServiceChannelRequest) error {
+	return s.do(ctx, http.MethodPost, endpoints.GetSubaccountServiceChannelBaseEndpoint(regionHost, subaccount, channelType), body, nil)
+}
+
+func (s *ABAPServiceChannelsService) Update(ctx context.Context, regionHost, subaccount, channelType string, id int64, body apiobjects.SubaccountABAPServiceChannelRequest) error {
+	return s.do(ctx, http.MethodPut, endpoints.GetSubaccountServiceChannelEndpoint(regionHost, subaccount, channelType, id), body, nil)
+}
+
+// SetEnabled opens or closes the service channel.
+func (s *ABAPServiceChannelsService) SetEnabled(ctx context.Context, regionHost, subaccount, channelType string, id int64, enabled bool) error {
+	body := apiobjects.SubaccountServiceChannelStateRequest{Enabled: enabled}
+	return s.do(ctx, http.MethodPut, endpoints.GetSubaccountServiceChannelStateEndpoint(regionHost, subaccount, channelType, id), body, nil)
+}
+
+func (s *ABAPServiceChannelsService) Delete(ctx context.Context, regionHost, subaccount, channelType string, id int64) error {
+	return s.do(ctx, http.MethodDelete, endpoints.GetSubaccountServiceChannelEndpoint(regionHost, subaccount, channelType, id), nil, nil)
+}
+
+// K8SServiceChannelsService manages the service channels of a subaccount to
+// Kubernetes clusters.
+type K8SServiceChannelsService struct {
+	service
+}
+
+func (c *RestApiClient) K8SServiceChannels() *K8SServiceChannelsService {
+	return &K8SServiceChannelsService{service{client: c}}
+}
+
+func (s *K8SServiceChannelsService) List(ctx context.Context, regionHost, subaccount string) ([]apiobjects.SubaccountK8SServiceChannel, error) {
+	return get[[]apiobjects.SubaccountK8SServiceChannel](ctx, s.service, endpoints.GetSubaccountServiceChannelBaseEndpoint(regionHost, subaccount, ServiceChannelTypeK8S))
+}
+
+func (s *K8SServiceChannelsService) Get(ctx context.Context, regionHost, subaccount string, id int64) (apiobjects.SubaccountK8SServiceChannel, error) {
+	return get[apiobjects.SubaccountK8SServiceChannel](ctx, s.service, endpoints.GetSubaccountServiceChannelEndpoint(regionHost, subaccount, ServiceChannelTypeK8S, id))
+}
+
+func (s *K8SServiceChannelsService) Create(ctx context.Context, regionHost, subaccount string, body apiobjects.SubaccountK8SServiceChannelRequest) error {
+	return s.do(ctx, http.MethodPost, endpoints.GetSubaccountServiceChannelBaseEndpoint(regionHost, subaccount, ServiceChannelTypeK8S), body, nil)
+}
+
+func (s *K8SServiceChannelsService) Update(ctx context.Context, regionHost, subaccount string, id int64, body apiobjects.SubaccountK8SServiceChannelRequest) error {
+	return s.do(ctx, http.MethodPut, endpoints.GetSubaccountServiceChannelEndpoint(regionHost, subaccount, ServiceChannelTypeK8S, id), body, nil)
+}
+
+// SetEnabled opens or closes the service channel.
+func (s *K8SServiceChannelsService) SetEnabled(ctx context.Context, regionHost, subaccount string, id int64, enabled bool) error {
+	body := apiobjects.SubaccountServiceChannelStateRequest{Enabled: enabled}
+	return s.do(ctx, http.MethodPut, endpoints.GetSubaccountServiceChannelStateEndpoint(regionHost, subaccount, ServiceChannelTypeK8S, id), body, nil)
+}
+
+func (s *K8SServiceChannelsService) Delete(ctx context.Context, regionHost, subaccount string, id int64) error {
+	return s.do(ctx, http.MethodDelete, endpoints.GetSubaccountServiceChannelEndpoint(regionHost, subaccount, ServiceChannelTypeK8S, id), nil, nil)
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+)
+
+// SNCService manages the Secure Network Communication (SNC) settings of the
+// Cloud Connector.
+type SNCService struct {
+	service
+}
+
+func (c *RestApiClient) SNC() *SNCService {
+	return &SNCService{service{client: c}}
+}
+
+func (s *SNCService) Get(ctx context.Context) (apiobjects.SNCSettings, error) {
+	return get[apiobjects.SNCSettings](ctx, s.service, s.endpoint(ctx))
+}
+
+func (s *SNCService) Update(ctx context.Context, settings apiobjects.SNCSettings) error {
+	return s.do(ctx, http.MethodPut, s.endpoint(ctx), settings, nil)
+}
+
+// Delete removes the SNC settings, which disables SNC for RFC connections.
+func (s *SNCService) Delete(ctx context.Context) error {
+	return s.do(ctx, http.MethodDelete, s.endpoint(ctx), nil, nil)
+}
+
+func (s *SNCService) endpoint(ctx context.Context) string {
+	return endpoints.GetSNCEndpoint(s.client.Supports(ctx, VersionOnPremisesPath))
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+)
+
+// SubaccountsService manages the subaccounts the Cloud Connector is connected to.
+type SubaccountsService struct {
+	service
+}
+
+func (c *RestApiClient) Subaccounts() *SubaccountsService {
+	return &SubaccountsService{service{client: c}}
+}
+
+func (s *SubaccountsService) List(ctx context.Context) ([]apiobjects.Subaccount, error) {
+	return get[[]apiobjects.Subaccount](ctx, s.service, endpoints.GetSubaccountBaseEndpoint())
+}
+
+func (s *SubaccountsService) Get(ctx context.Context, regionHost, subaccount string) (apiobjects.Subaccount, error) {
+	return get[apiobjects.Subaccount](ctx, s.service, endpoints.GetSubaccountEndpoint(regionHost, subaccount))
+}
+
+// Create adds a subaccount using the credentials of a subaccount user.
+func (s *SubaccountsService) Create(ctx context.Context, body apiobjects.SubaccountCreateRequest) (apiobjects.Subaccount, error) {
+	var out apiobjects.Subaccount
+	err := s.do(ctx, http.MethodPost, endpoints.GetSubaccountBaseEndpoint(), body, &out)
+	return out, err
+}
+
+// CreateWithAuthenticationData adds a subaccount using the authentication data
+// downloaded from the SAP BTP cockpit.
+func (s *SubaccountsService) CreateWithAuthenticationData(ctx context.Context, body apiobjects.SubaccountCreateWithAuthenticationDataRequest) (apiobjects.Subaccount, error) {
+	var out apiobjects.Subaccount
+	err := s.do(ctx, http.MethodPost, endpoints.GetSubaccountBaseEndpoint(), body, &out)
+	return out, err
+}
+
+func (s *SubaccountsService) Update(ctx context.Context, regionHost, subaccount string, body apiobjects.SubaccountUpdateRequest) (apiobjects.Subaccount, error) {
+	var out apiobjects.Subaccount
+	err := s.do(ctx, http.MethodPut, endpoints.GetSubaccountEndpoint(regionHost, subaccount), body, &out)
+	return out, err
+}
+
+func (s *SubaccountsService) Delete(ctx context.Context, regionHost, subaccount string) error {
+	return s.do(ctx, http.MethodDelete, endpoints.GetSubaccountEndpoint(regionHost, subaccount), nil, nil)
+}
+
+// SetTunnelState connects or disconnects the tunnel of the subaccount.
+func (s *SubaccountsService) SetTunnelState(ctx context.Context, regionHost, subaccount string, connected bool) error {
+	body := apiobjects.SubaccountTunnelStateRequest{Connected: connected}
+	return s.do(ctx, http.MethodPut, endpoints.GetSubaccountStateEndpoint(regionHost, subaccount), body, nil)
+}
+
+// SyncTrust synchronizes the trust configuration of the subaccount once.
+func (s *SubaccountsService) SyncTrust(ctx context.Context, regionHost, subaccount string) error {
+	return s.do(ctx, http.MethodPost, endpoints.GetSubaccountTrustEndpoint(regionHost, subaccount), nil, nil)
+}
+
+// SetAutoTrustSync enables or disables the automatic synchronization of the trust configuration.
+func (s *SubaccountsService) SetAutoTrustSync(ctx context.Context, regionHost, subaccount string, enabled bool) error {
+	body := apiobjects.SubaccountTrustRequest{AutoSyncTrustEnabled: enabled}
+	return s.do(ctx, http.MethodPut, endpoints.GetSubaccountTrustEndpoint(regionHost, subaccount), body, nil)
+}
+
+// GetTrust returns the trust configuration last synchronized from SAP BTP.
+func (s *SubaccountsService) GetTrust(ctx context.Context, regionHost, subaccount string) (apiobjects.SubaccountTrustConfiguration, error) {
+	return get[apiobjects.SubaccountTrustConfiguration](ctx, s.service, endpoints.GetSubaccountTrustEndpoint(regionHost, subaccount))
+}
+
+// SetTrustedIdentityProviders sets which of the synchronized identity providers are trusted.
+func (s *SubaccountsService) SetTrustedIdentityProviders(ctx context.Context, regionHost, subaccount string, identityProviders []apiobjects.SubaccountTrusted) error {
+	return s.do(ctx, http.MethodPut, endpoints.GetSubaccountTrustedIdentityProvidersEndpoint(regionHost, subaccount), identityProviders, nil)
+}
+
+// SetTrustedApplications sets which of the synchronized applications are trusted.
+func (s *SubaccountsService) SetTrustedApplications(ctx context.Context, regionHost, subaccount string, applications []apiobjects.SubaccountTrusted) error {
+	return s.do(ctx, http.MethodPut, endpoints.GetSubaccountTrustedApplicationsEndpoint(regionHost, subaccount), applications, nil)
+}
+
+// RenewCertificate renews the subaccount certificate using the credentials of a subaccount user.
+func (s *SubaccountsService) RenewCertificate(ctx context.Context, regionHost, subaccount string, body apiobjects.SubaccountCertificateRenewalRequest) (apiobjects.Subaccount, error) {
+	var out apiobjects.Subaccount
+	err := s.do(ctx, http.MethodPost, endpoints.GetSubaccountValidityEndpoint(regionHost, subaccount), body, &out)
+	return out, err
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+)
+
+// SubjectPatternRulesService manages the ordered rules for the subject of the
+// certificates the Cloud Connector issues for principal propagation. The rules
+// are addressed by their index, which shifts when a rule is deleted.
+type SubjectPatternRulesService struct {
+	service
+}
+
+func (c *RestApiClient) SubjectPatternRules() *SubjectPatternRulesService {
+	return &SubjectPatternRulesService{service{client: c}}
+}
+
+func (s *SubjectPatternRulesService) List(ctx context.Context) (apiobjects.SubjectPatternRules, error) {
+	return get[apiobjects.SubjectPatternRules](ctx, s.service, endpoints.GetSubjectPatternRulesBaseEndpoint())
+}
+
+// Create adds a rule after the existing rules, but before the default rule
+// with the condition "always true".
+func (s *SubjectPatternRulesService) Create(ctx context.Context, body apiobjects.SubjectPatternRuleRequest) error {
+	return s.do(ctx, http.MethodPost, endpoints.GetSubjectPatternRulesBaseEndpoint(), body, nil)
+}
+
+func (s *SubjectPatternRulesService) Update(ctx context.Context, index int, body apiobjects.SubjectPatternRuleRequest) error {
+	return s.do(ctx, http.MethodPut, endpoints.GetSubjectPatternRuleByIndexEndpoint(index), body, nil)
+}
+
+func (s *SubjectPatternRulesService) Delete(ctx context.Context, index int) error {
+	return s.do(ctx, http.MethodDelete, endpoints.GetSubjectPatternRuleByIndexEndpoint(index), nil, nil)
+}
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+)
+
+// SystemMappingsService manages the mappings of virtual to internal systems of a subaccount.
+type SystemMappingsService struct {
+	service
+}
+
+func (c *RestApiClient) SystemMappings() *SystemMappingsService {
+	return &SystemMappingsService{service{client: c}}
+}
+
+func (s *SystemMappingsService) List(ctx context.Context, regionHost, subaccount string) ([]apiobjects.SystemMapping, error) {
+	return get[[]apiobjects.SystemMapping](ctx, s.service, endpoints.GetSystemMappingBaseEndpoint(regionHost, subaccount))
+}
+
+func (s *SystemMappingsService) Get(ctx context.Context, regionHost, subaccount, virtualHost, virtualPort string) (apiobjects.SystemMapping, error) {
+	return get[apiobjects.SystemMapping](ctx, s.service, endpoints.GetSystemMappingEndpoint(regionHost, subaccount, virtualHost, virtualPort))
+}
+
+func (s *SystemMappingsService) Create(ctx context.Context, regionHost, subaccount string, body apiobjects.SystemMappingRequest) error {
+	return s.do(ctx, http.MethodPost, endpoints.GetSystemMappingBaseEndpoint(regionHost, subaccount), body, nil)
+}
+
+func (s *SystemMappingsService) Update(ctx context.Context, regionHost, subaccount, virtualHost, virtualPort string, body apiobjects.SystemMappingRequest) error {
+	return s.do(ctx, http.MethodPut, endpoints.GetSystemMappingEndpoint(regionHost, subaccount, virtualHost, virtualPort), body, nil)
+}
+
+func (s *SystemMappingsService) Delete(ctx context.Context, regionHost, subaccount, virtualHost, virtualPort string) error {
+	return s.do(ctx, http.MethodDelete, endpoints.GetSystemMappingEndpoint(regionHost, subaccount, virtualHost, virtualPort), nil, nil)
+}
+
+// SystemMappingResourcesService manages the resources, i.e. URL paths or function
+// names, that are exposed by a system mapping.
+type SystemMappingResourcesService struct {
+	service
+}
+
+func (c *RestApiClient) SystemMappingResources() *SystemMappingResourcesService {
+	return &SystemMappingResourcesService{service{client: c}}
+}
+
+func (s *SystemMappingResourcesService) List(ctx context.Context, regionHost, subaccount, virtualHost, virtualPort string) ([]apiobjects.SystemMappingResource, error) {
+	return get[[]apiobjects.SystemMappingResource](ctx, s.service, endpoints.GetSystemMappingResourceBaseEndpoint(regionHost, subaccount, virtualHost, virtualPort))
+}
+
+// Get reads a single resource. resourceID is the Base64 URL encoded URL path of the resource.
+func (s *SystemMappingResourcesService) Get(ctx context.Context, regionHost, subaccount, virtualHost, virtualPort, resourceID string) (apiobjects.SystemMappingResource, error) {
+	return get[apiobjects.SystemMappingResource](ctx, s.service, endpoints.GetSystemMappingResourceEndpoint(regionHost, subaccount, virtualHost, virtualPort, resourceID))
+}
+
+func (s *SystemMappingResourcesService) Create(ctx context.Context, regionHost, subaccount, virtualHost, virtualPort string, body apiobjects.SystemMappingResourceRequest) error {
+	return s.do(ctx, http.MethodPost, endpoints.GetSystemMappingResourceBaseEndpoint(regionHost, subaccount, virtualHost, virtualPort), body, nil)
+}
+
+func (s *SystemMappingResourcesService) Update(ctx context.Context, regionHost, subaccount, virtualHost, virtualPort, resourceID string, body apiobjects.SystemMappingResourceRequest) error {
+	return s.do(ctx, http.MethodPut, endpoints.GetSystemMappingResourceEndpoint(regionHost, subaccount, virtualHost, virtualPort, resourceID), body, nil)
+}
+
+func (s *SystemMappingResourcesService) Delete(ctx context.Context, regionHost, subaccount, virtualHost, virtualPort, resourceID string) error {
+	return s.do(ctx, http.MethodDelete, endpoints.GetSystemMappingResourceEndpoint(regionHost, subaccount, virtualHost, virtualPort, resourceID), nil, nil)
+}
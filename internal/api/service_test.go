@@ -0,0 +1,578 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordedRequest struct {
+	method string
+	path   string
+	body   string
+}
+
+func newServiceTestClient(t *testing.T, status int, responseBody string) (*RestApiClient, *[]recordedRequest) {
+	t.Helper()
+
+	var requests []recordedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, recordedRequest{method: r.Method, path: r.URL.EscapedPath(), body: string(body)})
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(responseBody))
+	}))
+	t.Cleanup(server.Close)
+
+	client, diags := createBasicAuthClient(server.URL)
+	require.False(t, diags.HasError(), "failed to create client: %v", diags)
+	client.Retry.MaxRetries = 0
+
+	return client, &requests
+}
+
+func TestSubaccountsService(t *testing.T) {
+	t.Run("get decodes the subaccount", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusOK, `{"regionHost":"cf.eu12.hana.ondemand.com","subaccount":"123","tunnel":{"state":"Connected","connections":2}}`)
+
+		subaccount, err := client.Subaccounts().Get(context.Background(), "cf.eu12.hana.ondemand.com", "123")
+		require.NoError(t, err)
+
+		assert.Equal(t, "123", subaccount.Subaccount)
+		assert.Equal(t, "Connected", subaccount.Tunnel.State)
+		assert.Equal(t, int64(2), subaccount.Tunnel.Connections)
+		assert.Equal(t, []recordedRequest{{method: http.MethodGet, path: "/api/v1/configuration/subaccounts/cf.eu12.hana.ondemand.com/123"}}, *requests)
+	})
+
+	t.Run("create omits unset optional fields", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusCreated, `{"subaccount":"123"}`)
+
+		_, err := client.Subaccounts().Create(context.Background(), apiobjects.SubaccountCreateRequest{
+			CloudPassword: "secret",
+			CloudUser:     "user",
+			RegionHost:    "cf.eu12.hana.ondemand.com",
+			Subaccount:    "123",
+		})
+		require.NoError(t, err)
+
+		require.Len(t, *requests, 1)
+		assert.Equal(t, `{"cloudPassword":"secret","cloudUser":"user","description":"","displayName":"","locationID":"","regionHost":"cf.eu12.hana.ondemand.com","subaccount":"123"}`, (*requests)[0].body)
+	})
+
+	t.Run("set tunnel state", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusOK, ``)
+
+		err := client.Subaccounts().SetTunnelState(context.Background(), "cf.eu12.hana.ondemand.com", "123", true)
+		require.NoError(t, err)
+
+		assert.Equal(t, []recordedRequest{{method: http.MethodPut, path: "/api/v1/configuration/subaccounts/cf.eu12.hana.ondemand.com/123/state", body: `{"connected":true}`}}, *requests)
+	})
+}
+
+func TestServiceChannelServices(t *testing.T) {
+	t.Run("ABAP create encodes numbers as strings", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusCreated, ``)
+
+		err := client.ABAPServiceChannels().Create(context.Background(), "cf.eu12.hana.ondemand.com", "123", ServiceChannelTypeABAPCloud, apiobjects.SubaccountABAPServiceChannelRequest{
+			ABAPCloudTenantHost: "tenant.abap.eu12.hana.ondemand.com",
+			Comment:             "channel",
+			Connections:         1,
+			InstanceNumber:      10,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []recordedRequest{{
+			method: http.MethodPost,
+			path:   "/api/v1/configuration/subaccounts/cf.eu12.hana.ondemand.com/123/channels/ABAPCloud",
+			body:   `{"abapCloudTenantHost":"tenant.abap.eu12.hana.ondemand.com","comment":"channel","connections":"1","instanceNumber":"10"}`,
+		}}, *requests)
+	})
+
+	t.Run("K8S set enabled", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusOK, ``)
+
+		err := client.K8SServiceChannels().SetEnabled(context.Background(), "cf.eu12.hana.ondemand.com", "123", 4, false)
+		require.NoError(t, err)
+
+		assert.Equal(t, []recordedRequest{{
+			method: http.MethodPut,
+			path:   "/api/v1/configuration/subaccounts/cf.eu12.hana.ondemand.com/123/channels/K8S/4/state",
+			body:   `{"enabled":"false"}`,
+		}}, *requests)
+	})
+}
+
+func TestSystemMappingResourcesService_Update(t *testing.T) {
+	client, requests := newServiceTestClient(t, http.StatusOK, ``)
+
+	err := client.SystemMappingResources().Update(context.Background(), "cf.eu12.hana.ondemand.com", "123", "virtual", "443", "Lw==", apiobjects.SystemMappingResourceRequest{
+		Description: "root",
+		Enabled:     true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []recordedRequest{{
+		method: http.MethodPut,
+		path:   "/api/v1/configuration/subaccounts/cf.eu12.hana.ondemand.com/123/systemMappings/virtual:443/resources/Lw==",
+		body:   `{"description":"root","enabled":"true","exactMatchOnly":"false","websocketUpgradeAllowed":"false"}`,
+	}}, *requests)
+}
+
+func TestUsersService(t *testing.T) {
+	t.Run("list decodes the users", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusOK, `[{"user":"jdoe","description":"John","roles":["sccadmin","sccdisplay"]}]`)
+
+		users, err := client.Users().List(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, []apiobjects.User{{User: "jdoe", Description: "John", Roles: []string{"sccadmin", "sccdisplay"}}}, users)
+		assert.Equal(t, []recordedRequest{{method: http.MethodGet, path: "/api/v1/configuration/connector/authentication/basic/users"}}, *requests)
+	})
+
+	t.Run("set roles sends an empty list", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusNoContent, ``)
+
+		require.NoError(t, client.Users().SetRoles(context.Background(), "jdoe", nil))
+
+		assert.Equal(t, []recordedRequest{{method: http.MethodPut, path: "/api/v1/configuration/connector/authentication/basic/users/jdoe/roles", body: `{"roles":[]}`}}, *requests)
+	})
+
+	t.Run("update of the own password switches the credentials", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusNoContent, ``)
+
+		err := client.Users().Update(context.Background(), "testuser", apiobjects.UserRequest{User: "testuser", Password: "new"})
+		require.NoError(t, err)
+
+		assert.Equal(t, `{"user":"testuser","password":"new","description":""}`, (*requests)[0].body)
+		password, ok := client.BasicAuthPassword(context.Background(), "testuser")
+		assert.True(t, ok)
+		assert.Equal(t, "new", password)
+	})
+
+	t.Run("update without password keeps the credentials", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusNoContent, ``)
+
+		err := client.Users().Update(context.Background(), "testuser", apiobjects.UserRequest{User: "testuser", Description: "Operator"})
+		require.NoError(t, err)
+
+		assert.Equal(t, `{"user":"testuser","description":"Operator"}`, (*requests)[0].body)
+		password, _ := client.BasicAuthPassword(context.Background(), "testuser")
+		assert.Equal(t, "testpassword", password)
+	})
+}
+
+func TestAuthenticationService_ChangePassword(t *testing.T) {
+	t.Run("switches the credentials", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusNoContent, ``)
+
+		require.NoError(t, client.Authentication().ChangePassword(context.Background(), "testuser", "testpassword", "new"))
+
+		assert.Equal(t, []recordedRequest{{
+			method: http.MethodPut,
+			path:   "/api/v1/configuration/connector/authentication/basic",
+			body:   `{"user":"testuser","password":"testpassword","newPassword":"new"}`,
+		}}, *requests)
+		password, _ := client.BasicAuthPassword(context.Background(), "testuser")
+		assert.Equal(t, "new", password)
+	})
+
+	// The gateway may have forwarded the change, so a retry would present the
+	// replaced password.
+	t.Run("is not retried", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusBadGateway, ``)
+		client.Retry = RetryConfig{MaxRetries: 3}
+
+		require.Error(t, client.Authentication().ChangePassword(context.Background(), "testuser", "testpassword", "new"))
+		require.Error(t, client.Users().Update(context.Background(), "testuser", apiobjects.UserRequest{User: "testuser", Password: "new"}))
+
+		assert.Len(t, *requests, 2)
+		password, _ := client.BasicAuthPassword(context.Background(), "testuser")
+		assert.Equal(t, "testpassword", password)
+	})
+}
+
+func TestSubjectPatternRulesService(t *testing.T) {
+	t.Run("create sends the condition as object", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusCreated, ``)
+
+		err := client.SubjectPatternRules().Create(context.Background(), apiobjects.SubjectPatternRuleRequest{
+			Condition:      apiobjects.SubjectPatternCondition{Variable: "email", Operator: "exist"},
+			SubjectPattern: apiobjects.SubjectPattern{CommonName: "${name}", Email: "${email}"},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []recordedRequest{{
+			method: http.MethodPost,
+			path:   "/api/v1/configuration/connector/onPremises/subjectPatternRules",
+			body:   `{"condition":{"operator":"exist","variable":"email"},"description":"","subjectPattern":{"CN":"${name}","EMAIL":"${email}"}}`,
+		}}, *requests)
+	})
+
+	t.Run("update and delete address the rule by index", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusNoContent, ``)
+
+		require.NoError(t, client.SubjectPatternRules().Update(context.Background(), 2, apiobjects.SubjectPatternRuleRequest{
+			Condition:      apiobjects.SubjectPatternCondition{Variable: "user_type", Operator: "is", Value: "Technical"},
+			SubjectPattern: apiobjects.SubjectPattern{CommonName: "${name}"},
+		}))
+		require.NoError(t, client.SubjectPatternRules().Delete(context.Background(), 0))
+
+		require.Len(t, *requests, 2)
+		assert.Equal(t, http.MethodPut, (*requests)[0].method)
+		assert.Equal(t, "/api/v1/configuration/connector/onPremises/subjectPatternRules/2", (*requests)[0].path)
+		assert.Contains(t, (*requests)[0].body, `"condition":{"operator":"is","value":"Technical","variable":"user_type"}`)
+		assert.Equal(t, recordedRequest{method: http.MethodDelete, path: "/api/v1/configuration/connector/onPremises/subjectPatternRules/0"}, (*requests)[1])
+	})
+}
+
+func TestProxySettingsService(t *testing.T) {
+	t.Run("update sends the port as number", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusNoContent, ``)
+
+		err := client.ProxySettings().Update(context.Background(), apiobjects.ProxySettingsRequest{Host: "proxy", Port: 8080})
+		require.NoError(t, err)
+
+		assert.Equal(t, []recordedRequest{{
+			method: http.MethodPut,
+			path:   "/api/v1/configuration/connector/proxy",
+			body:   `{"host":"proxy","port":8080}`,
+		}}, *requests)
+	})
+
+	t.Run("get decodes the port as string", func(t *testing.T) {
+		client, _ := newServiceTestClient(t, http.StatusOK, `{"host":"proxy","port":"8080","user":"jdoe","password":"***"}`)
+
+		settings, err := client.ProxySettings().Get(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, apiobjects.ProxySettings{Host: "proxy", Port: "8080", User: "jdoe", Password: "***"}, settings)
+	})
+}
+
+func TestAuditLogService_Entries(t *testing.T) {
+	client, requests := newServiceTestClient(t, http.StatusOK, `[{"timestamp":1700000000000,"user":"Administrator","category":"CONFIGURATION","message":"changed"}]`)
+
+	entries, err := client.AuditLog().Entries(context.Background(), url.Values{"user": []string{"Administrator"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []apiobjects.AuditLogEntry{{Timestamp: 1700000000000, User: "Administrator", Category: "CONFIGURATION", Message: "changed"}}, entries)
+	require.Len(t, *requests, 1)
+	assert.Equal(t, http.MethodGet, (*requests)[0].method)
+}
+
+func TestHighAvailabilityService(t *testing.T) {
+	t.Run("connect sends the credentials", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusNoContent, ``)
+
+		require.NoError(t, client.HighAvailability().Connect(context.Background(), "Administrator", "secret"))
+		require.NoError(t, client.HighAvailability().Disconnect(context.Background()))
+
+		assert.Equal(t, []recordedRequest{
+			{method: http.MethodPost, path: "/api/v1/configuration/connector/ha/shadow/state", body: `{"op":"CONNECT","user":"Administrator","password":"secret"}`},
+			{method: http.MethodPost, path: "/api/v1/configuration/connector/ha/shadow/state", body: `{"op":"DISCONNECT"}`},
+		}, *requests)
+	})
+
+	t.Run("shadow configuration omits unset timeouts", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusNoContent, ``)
+
+		err := client.HighAvailability().UpdateShadowConfiguration(context.Background(), apiobjects.HAShadowConfiguration{MasterHost: "master", MasterPort: 8443})
+		require.NoError(t, err)
+
+		assert.Equal(t, `{"masterHost":"master","masterPort":8443}`, (*requests)[0].body)
+	})
+}
+
+func TestBackendTrustStoreService(t *testing.T) {
+	client, requests := newServiceTestClient(t, http.StatusNoContent, ``)
+
+	require.NoError(t, client.BackendTrustStore().SetTrustAllBackends(context.Background(), false))
+	require.NoError(t, client.BackendTrustStore().DeleteCertificate(context.Background(), "backend-ca"))
+
+	// Skip the lookups of the Cloud Connector version, which select the path.
+	var changes []recordedRequest
+	for _, request := range *requests {
+		if request.method != http.MethodGet {
+			changes = append(changes, request)
+		}
+	}
+	require.Len(t, changes, 2)
+	patch, del := changes[0], changes[1]
+	assert.Equal(t, http.MethodPatch, patch.method)
+	assert.Equal(t, `{"trustAllBackends":false}`, patch.body)
+	assert.Equal(t, http.MethodDelete, del.method)
+	assert.Regexp(t, `/truststore/certificates/backend-ca$`, del.path)
+}
+
+func TestBackendTrustStoreService_Certificates(t *testing.T) {
+	t.Run("upload", func(t *testing.T) {
+		client, upload := newUploadTestClient(t, "", "")
+
+		require.NoError(t, client.BackendTrustStore().UploadCertificate(context.Background(), "-----BEGIN CERTIFICATE-----\n"))
+
+		assert.Equal(t, http.MethodPost, upload.method)
+		assert.Regexp(t, `/truststore/certificates$`, upload.path)
+		assert.Equal(t, map[string]string{"certificate": "certificate.pem"}, upload.fileNames)
+		assert.Equal(t, map[string]string{"certificate": "-----BEGIN CERTIFICATE-----\n"}, upload.values)
+	})
+
+	t.Run("download", func(t *testing.T) {
+		client, upload := newUploadTestClient(t, "application/pkix-cert", "\x30\x82")
+
+		certificate, err := client.BackendTrustStore().DownloadCertificate(context.Background(), "backend-ca")
+		require.NoError(t, err)
+
+		assert.Equal(t, []byte("\x30\x82"), certificate)
+		assert.Regexp(t, `/truststore/certificates/backend-ca$`, upload.path)
+		assert.Equal(t, "application/pkix-cert", upload.accept)
+	})
+}
+
+func TestCertificateService_GenerateCSR(t *testing.T) {
+	client, requests := newServiceTestClient(t, http.StatusOK, "-----BEGIN CERTIFICATE REQUEST-----\n")
+
+	csr, err := client.UICertificate().GenerateCSR(context.Background(), apiobjects.CertificateRequest{KeySize: 4096, SubjectDN: "CN=scc"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "-----BEGIN CERTIFICATE REQUEST-----\n", csr)
+	assert.Equal(t, []recordedRequest{{
+		method: http.MethodPost,
+		path:   "/api/v1/configuration/connector/ui/uiCertificate",
+		body:   `{"type":"csr","keySize":4096,"subjectDN":"CN=scc"}`,
+	}}, *requests)
+}
+
+func TestCertificateService(t *testing.T) {
+	t.Run("get decodes the certificate", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusOK, `{"subjectDN":"CN=scc","issuer":"CN=ca","notAfterTimeStamp":1767225600000,"serialNumber":"1f"}`)
+
+		certificate, err := client.SystemCertificate().Get(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, apiobjects.Certificate{SubjectDN: "CN=scc", Issuer: "CN=ca", NotAfterTimeStamp: 1767225600000, SerialNumber: "1f"}, certificate)
+		assert.Equal(t, []recordedRequest{{method: http.MethodGet, path: "/api/v1/configuration/connector/onPremise/systemCertificate"}}, *requests)
+	})
+
+	t.Run("create self-signed sets the type", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusCreated, ``)
+
+		err := client.CACertificate().CreateSelfSigned(context.Background(), apiobjects.CertificateRequest{KeySize: 2048, SubjectDN: "CN=scc"})
+		require.NoError(t, err)
+
+		assert.Equal(t, []recordedRequest{{
+			method: http.MethodPost,
+			path:   "/api/v1/configuration/connector/onPremise/ppCaCertificate",
+			body:   `{"type":"selfsigned","keySize":2048,"subjectDN":"CN=scc"}`,
+		}}, *requests)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusNoContent, ``)
+
+		require.NoError(t, client.UICertificate().Delete(context.Background()))
+		assert.Equal(t, []recordedRequest{{method: http.MethodDelete, path: "/api/v1/configuration/connector/ui/uiCertificate"}}, *requests)
+	})
+
+	t.Run("download accepts DER", func(t *testing.T) {
+		client, upload := newUploadTestClient(t, "application/pkix-cert", "\x30\x82")
+
+		certificate, err := client.SystemCertificate().Download(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, []byte("\x30\x82"), certificate)
+		assert.Equal(t, "application/pkix-cert", upload.accept)
+	})
+
+	t.Run("upload signed chain", func(t *testing.T) {
+		client, upload := newUploadTestClient(t, "", "")
+
+		require.NoError(t, client.UICertificate().UploadSignedChain(context.Background(), "-----BEGIN CERTIFICATE-----\n"))
+
+		assert.Equal(t, http.MethodPatch, upload.method)
+		assert.Equal(t, "/api/v1/configuration/connector/ui/uiCertificate", upload.path)
+		assert.Equal(t, map[string]string{"signedCertificate": "signed_chain.pem"}, upload.fileNames)
+		assert.Equal(t, map[string]string{"signedCertificate": "-----BEGIN CERTIFICATE-----\n"}, upload.values)
+	})
+
+	t.Run("upload PKCS#12 omits an empty key password", func(t *testing.T) {
+		client, upload := newUploadTestClient(t, "", "")
+
+		require.NoError(t, client.SystemCertificate().UploadPKCS12(context.Background(), []byte("p12"), "secret", ""))
+
+		assert.Equal(t, http.MethodPut, upload.method)
+		assert.Equal(t, map[string]string{"pkcs12": "certificate.p12"}, upload.fileNames)
+		assert.Equal(t, map[string]string{"password": "secret", "pkcs12": "p12"}, upload.values)
+	})
+
+	t.Run("upload PKCS#12 with key password", func(t *testing.T) {
+		client, upload := newUploadTestClient(t, "", "")
+
+		require.NoError(t, client.SystemCertificate().UploadPKCS12(context.Background(), []byte("p12"), "secret", "key"))
+
+		assert.Equal(t, map[string]string{"password": "secret", "keyPassword": "key", "pkcs12": "p12"}, upload.values)
+	})
+}
+
+func TestBackupService(t *testing.T) {
+	t.Run("create returns the archive", func(t *testing.T) {
+		client, upload := newUploadTestClient(t, "application/zip", "PK")
+
+		archive, err := client.Backup().Create(context.Background(), "secret")
+		require.NoError(t, err)
+
+		assert.Equal(t, []byte("PK"), archive)
+		assert.Equal(t, http.MethodPost, upload.method)
+		assert.Equal(t, "/api/v1/configuration/backup", upload.path)
+		assert.Equal(t, `{"password":"secret"}`, upload.body)
+	})
+
+	t.Run("create rejects other content types", func(t *testing.T) {
+		client, _ := newUploadTestClient(t, "application/json", "{}")
+
+		_, err := client.Backup().Create(context.Background(), "secret")
+
+		var clientErr *Error
+		require.ErrorAs(t, err, &clientErr)
+		assert.Equal(t, "Unexpected Content-Type", clientErr.Summary)
+	})
+
+	t.Run("create rejects an empty archive", func(t *testing.T) {
+		client, _ := newUploadTestClient(t, "application/zip", "")
+
+		_, err := client.Backup().Create(context.Background(), "secret")
+
+		var clientErr *Error
+		require.ErrorAs(t, err, &clientErr)
+		assert.Equal(t, "Empty Backup Response", clientErr.Summary)
+	})
+
+	t.Run("restore uploads the archive", func(t *testing.T) {
+		client, upload := newUploadTestClient(t, "", "")
+
+		require.NoError(t, client.Backup().Restore(context.Background(), "/backups/scc.zip", []byte("PK"), "secret"))
+
+		assert.Equal(t, http.MethodPut, upload.method)
+		assert.Equal(t, "/api/v1/configuration/backup", upload.path)
+		assert.Equal(t, map[string]string{"backup": "scc.zip"}, upload.fileNames)
+		assert.Equal(t, map[string]string{"password": "secret", "backup": "PK"}, upload.values)
+	})
+}
+
+// recordedUpload is a request received by the server of newUploadTestClient.
+// Multipart forms are decoded into values and the names of uploaded files.
+type recordedUpload struct {
+	method    string
+	path      string
+	accept    string
+	body      string
+	values    map[string]string
+	fileNames map[string]string
+}
+
+// newUploadTestClient returns a client for a server that records the last
+// request and answers with responseBody of the given content type.
+func newUploadTestClient(t *testing.T, contentType string, responseBody string) (*RestApiClient, *recordedUpload) {
+	t.Helper()
+
+	upload := &recordedUpload{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*upload = recordedUpload{method: r.Method, path: r.URL.Path, accept: r.Header.Get("Accept")}
+		if err := r.ParseMultipartForm(1 << 20); err == nil {
+			upload.values, upload.fileNames = map[string]string{}, map[string]string{}
+			for name, values := range r.MultipartForm.Value {
+				upload.values[name] = values[0]
+			}
+			for name, files := range r.MultipartForm.File {
+				upload.fileNames[name] = files[0].Filename
+				file, _ := files[0].Open()
+				content, _ := io.ReadAll(file)
+				upload.values[name] = string(content)
+			}
+		} else {
+			body, _ := io.ReadAll(r.Body)
+			upload.body = string(body)
+		}
+
+		if contentType != "" {
+			w.Header().Set("Content-Type", contentType)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(responseBody))
+	}))
+	t.Cleanup(server.Close)
+
+	client, diags := createBasicAuthClient(server.URL)
+	require.False(t, diags.HasError(), "failed to create client: %v", diags)
+	client.Retry.MaxRetries = 0
+
+	return client, upload
+}
+
+func TestService_Errors(t *testing.T) {
+	t.Run("API error", func(t *testing.T) {
+		client, _ := newServiceTestClient(t, http.StatusConflict, `{"type":"ALREADY_EXISTS","message":"Domain mapping already exists"}`)
+
+		err := client.DomainMappings().Create(context.Background(), "cf.eu12.hana.ondemand.com", "123", apiobjects.DomainMappingRequest{
+			InternalDomain: "internal",
+			VirtualDomain:  "virtual",
+		})
+		require.Error(t, err)
+
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusConflict, apiErr.StatusCode)
+		assert.Equal(t, "ALREADY_EXISTS", apiErr.Type)
+		assert.Equal(t, "Domain mapping already exists", apiErr.Message)
+		assert.Equal(t, http.MethodPost, apiErr.Method)
+		assert.True(t, IsConflict(err))
+		assert.False(t, IsNotFound(err))
+
+		diags := ErrorDiagnostics(err)
+		require.Len(t, diags, 1)
+		assert.Equal(t, "API Error", diags[0].Summary())
+		assert.Contains(t, diags[0].Detail(), "failed with status 409: Domain mapping already exists")
+		assert.Same(t, apiErr, DiagnosticsError(diags))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		client, _ := newServiceTestClient(t, http.StatusNotFound, `{"type":"NOT_FOUND","message":"Subaccount not found"}`)
+
+		_, err := client.Subaccounts().Get(context.Background(), "cf.eu12.hana.ondemand.com", "123")
+
+		assert.True(t, IsNotFound(err))
+		assert.True(t, IsNotFound(DiagnosticsError(ErrorDiagnostics(err))))
+	})
+
+	t.Run("malformed response", func(t *testing.T) {
+		client, _ := newServiceTestClient(t, http.StatusOK, `not json`)
+
+		_, err := client.DomainMappings().List(context.Background(), "cf.eu12.hana.ondemand.com", "123")
+
+		var apiErr *Error
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, "Failed to Unmarshal Response Body", apiErr.Summary)
+	})
+
+	t.Run("upload error", func(t *testing.T) {
+		client, _ := newServiceTestClient(t, http.StatusBadRequest, `INVALID_REQUEST`)
+
+		err := client.UICertificate().UploadSignedChain(context.Background(), "-----BEGIN CERTIFICATE-----\n")
+
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.MethodPatch, apiErr.Method)
+		assert.Contains(t, ErrorDiagnostics(err)[0].Detail(), "INVALID_REQUEST")
+	})
+
+	t.Run("nil error", func(t *testing.T) {
+		assert.False(t, ErrorDiagnostics(nil).HasError())
+	})
+}
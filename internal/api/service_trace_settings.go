@@ -0,0 +1,26 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+)
+
+// TraceSettingsService manages the log and trace levels of the Cloud Connector.
+type TraceSettingsService struct {
+	service
+}
+
+func (c *RestApiClient) TraceSettings() *TraceSettingsService {
+	return &TraceSettingsService{service{client: c}}
+}
+
+func (s *TraceSettingsService) Get(ctx context.Context) (apiobjects.TraceSettings, error) {
+	return get[apiobjects.TraceSettings](ctx, s.service, endpoints.GetTraceSettingsEndpoint())
+}
+
+func (s *TraceSettingsService) Update(ctx context.Context, settings apiobjects.TraceSettings) error {
+	return s.do(ctx, http.MethodPut, endpoints.GetTraceSettingsEndpoint(), settings, nil)
+}
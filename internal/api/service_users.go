@@ -0,0 +1,65 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+)
+
+// UsersService manages the local users of the Cloud Connector and their roles.
+type UsersService struct {
+	service
+}
+
+func (c *RestApiClient) Users() *UsersService {
+	return &UsersService{service{client: c}}
+}
+
+func (s *UsersService) List(ctx context.Context) ([]apiobjects.User, error) {
+	return get[[]apiobjects.User](ctx, s.service, endpoints.GetUsersBaseEndpoint())
+}
+
+func (s *UsersService) Get(ctx context.Context, user string) (apiobjects.User, error) {
+	return get[apiobjects.User](ctx, s.service, endpoints.GetUserEndpoint(user))
+}
+
+// Create creates a local user without roles.
+func (s *UsersService) Create(ctx context.Context, body apiobjects.UserRequest) error {
+	return s.do(ctx, http.MethodPost, endpoints.GetUsersBaseEndpoint(), body, nil)
+}
+
+// Update changes the description and, if set, the password of a local user. If
+// the client authenticates as this user with basic authentication, it sends
+// its later requests with the new password.
+func (s *UsersService) Update(ctx context.Context, user string, body apiobjects.UserRequest) error {
+	if body.Password == "" {
+		return s.do(ctx, http.MethodPut, endpoints.GetUserEndpoint(user), body, nil)
+	}
+
+	// A password change is not retried, as a retry after a lost response would
+	// present the replaced password if the client authenticates as user.
+	if err := s.do(withoutRetries(ctx), http.MethodPut, endpoints.GetUserEndpoint(user), body, nil); err != nil {
+		return err
+	}
+
+	client, err := s.client.resolve(ctx)
+	if err != nil {
+		return err
+	}
+	client.switchPassword(user, body.Password)
+	return nil
+}
+
+// SetRoles replaces the roles of a local user.
+func (s *UsersService) SetRoles(ctx context.Context, user string, roles []string) error {
+	if roles == nil {
+		roles = []string{}
+	}
+	return s.do(ctx, http.MethodPut, endpoints.GetUserRolesEndpoint(user), apiobjects.UserRoles{Roles: roles}, nil)
+}
+
+func (s *UsersService) Delete(ctx context.Context, user string) error {
+	return s.do(ctx, http.MethodDelete, endpoints.GetUserEndpoint(user), nil, nil)
+}
@@ -0,0 +1,158 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Version is the version of a Cloud Connector, e.g. 2.19.0.2.
+type Version struct {
+	Major int
+	Minor int
+	Micro int
+	Build int
+}
+
+// Minimum Cloud Connector versions of features the provider gates on.
+var (
+	// VersionAuthenticationData adds subaccounts with the authentication data
+	// downloaded from the SAP BTP cockpit.
+	VersionAuthenticationData = Version{Major: 2, Minor: 17}
+	// VersionOnPremisesPath serves the configuration of on-premise systems
+	// below onPremises. Older versions only accept onPremise.
+	VersionOnPremisesPath = Version{Major: 2, Minor: 18}
+	// VersionManagedSubaccounts supports managed subaccounts and the automatic
+	// renewal of subaccount certificates.
+	VersionManagedSubaccounts = Version{Major: 2, Minor: 19}
+)
+
+// ParseVersion parses a version of up to four numeric components separated by
+// dots. Missing components are zero.
+func ParseVersion(s string) (Version, error) {
+	parts := strings.Split(strings.TrimSpace(s), ".")
+	if len(parts) < 2 || len(parts) > 4 {
+		return Version{}, fmt.Errorf("invalid Cloud Connector version %q", s)
+	}
+
+	var components [4]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("invalid Cloud Connector version %q", s)
+		}
+		components[i] = n
+	}
+
+	return Version{Major: components[0], Minor: components[1], Micro: components[2], Build: components[3]}, nil
+}
+
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Micro)
+	if v.Build > 0 {
+		s += fmt.Sprintf(".%d", v.Build)
+	}
+	return s
+}
+
+// AtLeast reports whether v is the same as or newer than minimum.
+func (v Version) AtLeast(minimum Version) bool {
+	a := [4]int{v.Major, v.Minor, v.Micro, v.Build}
+	b := [4]int{minimum.Major, minimum.Minor, minimum.Micro, minimum.Build}
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] > b[i]
+		}
+	}
+	return true
+}
+
+// versionCache holds the version of a Cloud Connector once it is known. It is
+// shared by the copies of a client.
+type versionCache struct {
+	mu      sync.Mutex
+	version *Version
+}
+
+func (c *versionCache) get() (Version, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.version == nil {
+		return Version{}, false
+	}
+	return *c.version, true
+}
+
+func (c *versionCache) set(version Version) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.version = &version
+}
+
+// RememberVersion records the version reported by the Cloud Connector, so that
+// Version does not have to request it again. Versions that cannot be parsed are
+// ignored.
+func (c *RestApiClient) RememberVersion(version string) {
+	parsed, err := ParseVersion(version)
+	if err != nil || c.versions == nil {
+		return
+	}
+	c.versions.set(parsed)
+}
+
+// Version returns the version of the Cloud Connector instance selected by ctx.
+// The version is requested once per instance and then cached.
+func (c *RestApiClient) Version(ctx context.Context) (Version, error) {
+	client, err := c.resolve(ctx)
+	if err != nil {
+		return Version{}, err
+	}
+	if client != c {
+		return client.Version(WithInstance(ctx, ""))
+	}
+
+	if c.versions != nil {
+		if version, ok := c.versions.get(); ok {
+			return version, nil
+		}
+	}
+
+	response, err := c.Connector().Version(ctx)
+	if err != nil {
+		return Version{}, err
+	}
+
+	version, err := ParseVersion(response.Version)
+	if err != nil {
+		return Version{}, &Error{Summary: "Unknown Cloud Connector Version", Detail: err.Error()}
+	}
+	if c.versions != nil {
+		c.versions.set(version)
+	}
+	return version, nil
+}
+
+// Supports reports whether the Cloud Connector instance selected by ctx runs at
+// least version minimum. It returns false if the version cannot be determined.
+func (c *RestApiClient) Supports(ctx context.Context, minimum Version) bool {
+	version, err := c.Version(ctx)
+	return err == nil && version.AtLeast(minimum)
+}
+
+// RequireVersion returns an error if the Cloud Connector instance selected by ctx
+// runs a version older than minimum. feature describes what requires the version.
+// If the version cannot be determined, no error is returned and the request for
+// the feature is left to fail on its own.
+func (c *RestApiClient) RequireVersion(ctx context.Context, minimum Version, feature string) error {
+	version, err := c.Version(ctx)
+	if err != nil || version.AtLeast(minimum) {
+		return nil
+	}
+
+	return &Error{
+		Summary: "Unsupported Cloud Connector Version",
+		Detail:  fmt.Sprintf("%s requires Cloud Connector %s or later, but the Cloud Connector instance runs version %s.", feature, minimum, version),
+	}
+}
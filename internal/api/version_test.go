@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Version
+		invalid  bool
+	}{
+		{input: "2.19.0.2", expected: Version{Major: 2, Minor: 19, Micro: 0, Build: 2}},
+		{input: "2.18.1", expected: Version{Major: 2, Minor: 18, Micro: 1}},
+		{input: "2.17", expected: Version{Major: 2, Minor: 17}},
+		{input: "2", invalid: true},
+		{input: "2.19.0.2.1", invalid: true},
+		{input: "2.x.0", invalid: true},
+		{input: "", invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			version, err := ParseVersion(tt.input)
+			if tt.invalid {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, version)
+		})
+	}
+}
+
+func TestVersion_AtLeast(t *testing.T) {
+	v := Version{Major: 2, Minor: 18, Micro: 1}
+
+	assert.True(t, v.AtLeast(VersionOnPremisesPath))
+	assert.True(t, v.AtLeast(v))
+	assert.False(t, v.AtLeast(VersionManagedSubaccounts))
+	assert.False(t, v.AtLeast(Version{Major: 2, Minor: 18, Micro: 1, Build: 1}))
+	assert.Equal(t, "2.18.1", v.String())
+	assert.Equal(t, "2.19.0.2", Version{Major: 2, Minor: 19, Build: 2}.String())
+}
+
+func TestRestApiClient_Version(t *testing.T) {
+	t.Run("version is requested once", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusOK, `{"version":"2.18.1"}`)
+
+		for range 2 {
+			version, err := client.Version(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, Version{Major: 2, Minor: 18, Micro: 1}, version)
+		}
+		assert.Len(t, *requests, 1)
+
+		assert.True(t, client.Supports(context.Background(), VersionOnPremisesPath))
+		assert.False(t, client.Supports(context.Background(), VersionManagedSubaccounts))
+		assert.Len(t, *requests, 1)
+	})
+
+	t.Run("remembered version is not requested", func(t *testing.T) {
+		client, requests := newServiceTestClient(t, http.StatusOK, `{"version":"2.19.0"}`)
+		client.RememberVersion("2.17.0")
+
+		err := client.RequireVersion(context.Background(), VersionManagedSubaccounts, "The attribute is_managed")
+		assert.EqualError(t, err, "Unsupported Cloud Connector Version: The attribute is_managed requires Cloud Connector 2.19.0 or later, but the Cloud Connector instance runs version 2.17.0.")
+		assert.NoError(t, client.RequireVersion(context.Background(), VersionAuthenticationData, "authentication_data"))
+		assert.Empty(t, *requests)
+	})
+
+	t.Run("unknown version does not block features", func(t *testing.T) {
+		client, _ := newServiceTestClient(t, http.StatusNotFound, ``)
+
+		_, err := client.Version(context.Background())
+		assert.True(t, IsNotFound(err))
+		assert.False(t, client.Supports(context.Background(), VersionOnPremisesPath))
+		assert.NoError(t, client.RequireVersion(context.Background(), VersionManagedSubaccounts, "The attribute is_managed"))
+	})
+}
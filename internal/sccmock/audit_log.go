@@ -0,0 +1,39 @@
+package sccmock
+
+import (
+	"net/http"
+	"strings"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+)
+
+const auditLogLevelPath = "/api/v1/configuration/connector/auditLog/level"
+
+func (s *Server) auditLogRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+auditLogLevelPath, s.getAuditLogLevel)
+	mux.HandleFunc("PUT "+auditLogLevelPath, s.updateAuditLogLevel)
+}
+
+func (s *Server) getAuditLogLevel(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, apiobjects.AuditLogLevel{Level: s.state.AuditLogLevel})
+}
+
+func (s *Server) updateAuditLogLevel(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	level, err := body.requiredString("level")
+	if err == nil {
+		err = oneOf("level", strings.ToUpper(level), "SECURITY", "ALL", "OFF")
+	}
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	s.state.AuditLogLevel = strings.ToUpper(level)
+	w.WriteHeader(http.StatusNoContent)
+}
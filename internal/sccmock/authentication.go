@@ -0,0 +1,161 @@
+package sccmock
+
+import (
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+)
+
+const (
+	authenticationPath      = "/api/v1/configuration/connector/authentication"
+	basicAuthenticationPath = authenticationPath + "/basic"
+	ldapAuthenticationPath  = authenticationPath + "/ldap"
+)
+
+func (s *Server) authenticationRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+authenticationPath, s.getAuthentication)
+	mux.HandleFunc("PUT "+authenticationPath, s.updateAuthentication)
+	mux.HandleFunc("PUT "+basicAuthenticationPath, s.changePassword)
+	mux.HandleFunc("GET "+ldapAuthenticationPath, s.getLDAPAuthentication)
+	mux.HandleFunc("PUT "+ldapAuthenticationPath, s.updateLDAPAuthentication)
+	mux.HandleFunc("DELETE "+ldapAuthenticationPath, s.deleteLDAPAuthentication)
+}
+
+func (s *Server) getAuthentication(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, apiobjects.Authentication{Type: s.state.AuthenticationType})
+}
+
+// updateAuthentication switches the user store administrators are
+// authenticated against. The fake server keeps accepting its own credentials.
+func (s *Server) updateAuthentication(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	authenticationType, err := body.requiredString("type")
+	if err == nil {
+		err = oneOf("type", authenticationType, apiobjects.AuthenticationTypeBasic, apiobjects.AuthenticationTypeLDAP)
+	}
+	if err == nil && authenticationType == apiobjects.AuthenticationTypeLDAP && s.state.LDAP == nil {
+		err = badRequest("LDAP authentication is not configured")
+	}
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	s.state.AuthenticationType = authenticationType
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// changePassword changes the password of a local user, which requires its
+// current password.
+func (s *Server) changePassword(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	var user *User
+	newPassword := body.string("newPassword")
+	name, err := body.requiredString("user")
+	if err == nil && newPassword == "" {
+		err = badRequest("Missing mandatory property 'newPassword'")
+	}
+	if err == nil {
+		user, err = s.state.findUser(name)
+	}
+	if err == nil && user.Password != body.string("password") {
+		err = badRequest("The current password of user %s is wrong", name)
+	}
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	user.Password = newPassword
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getLDAPAuthentication(w http.ResponseWriter, r *http.Request) {
+	if s.state.LDAP == nil {
+		writeResult(w, 0, nil, notFound("LDAP authentication is not configured"))
+		return
+	}
+
+	// The password is never returned.
+	ldap := *s.state.LDAP
+	ldap.Password = ""
+	writeJSON(w, http.StatusOK, ldap)
+}
+
+func (s *Server) updateLDAPAuthentication(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	ldap := apiobjects.LDAPAuthentication{
+		User:                      body.string("user"),
+		Password:                  body.string("password"),
+		UserSearch:                body.string("userSearch"),
+		RoleSearch:                body.string("roleSearch"),
+		RoleName:                  body.string("roleName"),
+		CustomAdminRole:           body.string("customAdminRole"),
+		CustomSubaccountAdminRole: body.string("customSubaccountAdminRole"),
+		CustomDisplayRole:         body.string("customDisplayRole"),
+		CustomSupportRole:         body.string("customSupportRole"),
+		CustomMonitoringRole:      body.string("customMonitoringRole"),
+	}
+	if ldap.UserBase, err = body.requiredString("userBase"); err == nil {
+		ldap.RoleBase, err = body.requiredString("roleBase")
+	}
+	if err == nil && ldap.User != "" && ldap.Password == "" {
+		err = badRequest("A user requires a password")
+	}
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	hosts, _ := body["hosts"].([]any)
+	if len(hosts) == 0 {
+		writeResult(w, 0, nil, badRequest("At least one LDAP host is required"))
+		return
+	}
+	for _, h := range hosts {
+		entry, _ := h.(map[string]any)
+		host := apiobjects.LDAPHost{}
+		if host.Host, err = requestBody(entry).requiredString("host"); err == nil {
+			host.Port, err = requestBody(entry).int("port")
+		}
+		if err == nil {
+			host.IsSecure, err = requestBody(entry).bool("isSecure")
+		}
+		if err == nil && (host.Port < 1 || host.Port > 65535) {
+			err = badRequest("Invalid port %d of LDAP host %s, the port must be between 1 and 65535", host.Port, host.Host)
+		}
+		if err != nil {
+			writeResult(w, 0, nil, err)
+			return
+		}
+		ldap.Hosts = append(ldap.Hosts, host)
+	}
+
+	s.state.LDAP = &ldap
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteLDAPAuthentication(w http.ResponseWriter, r *http.Request) {
+	if s.state.AuthenticationType == apiobjects.AuthenticationTypeLDAP {
+		writeResult(w, 0, nil, conflict("LDAP authentication is active and cannot be removed"))
+		return
+	}
+
+	s.state.LDAP = nil
+	w.WriteHeader(http.StatusNoContent)
+}
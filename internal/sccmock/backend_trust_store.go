@@ -0,0 +1,116 @@
+package sccmock
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+)
+
+// The trust store is served below onPremise, and below onPremises from version
+// 2.18.0 on.
+const (
+	backendTrustStoreOnPremisePath  = "/api/v1/configuration/connector/onPremise/truststore"
+	backendTrustStoreOnPremisesPath = "/api/v1/configuration/connector/onPremises/truststore"
+)
+
+func (s *Server) backendTrustStoreRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+backendTrustStoreOnPremisePath, s.getBackendTrustStore)
+	mux.HandleFunc("PATCH "+backendTrustStoreOnPremisePath, s.updateBackendTrustStore)
+	mux.HandleFunc("POST "+backendTrustStoreOnPremisePath+"/certificates", s.addTrustedBackend)
+	mux.HandleFunc("DELETE "+backendTrustStoreOnPremisePath+"/certificates/{alias}", s.deleteTrustedBackend)
+	mux.HandleFunc("GET "+backendTrustStoreOnPremisesPath, s.since(api.VersionOnPremisesPath, s.getBackendTrustStore))
+	mux.HandleFunc("PATCH "+backendTrustStoreOnPremisesPath, s.since(api.VersionOnPremisesPath, s.updateBackendTrustStore))
+	mux.HandleFunc("POST "+backendTrustStoreOnPremisesPath+"/certificates", s.since(api.VersionOnPremisesPath, s.addTrustedBackend))
+	mux.HandleFunc("DELETE "+backendTrustStoreOnPremisesPath+"/certificates/{alias}", s.since(api.VersionOnPremisesPath, s.deleteTrustedBackend))
+}
+
+func (s *Server) getBackendTrustStore(w http.ResponseWriter, r *http.Request) {
+	trustStore := apiobjects.BackendTrustStoreConfiguration{
+		TrustAllBackends: s.state.TrustAllBackends,
+		TrustedBackends:  []apiobjects.TrustedBackends{},
+	}
+
+	for _, backend := range s.state.TrustedBackends {
+		trustStore.TrustedBackends = append(trustStore.TrustedBackends, apiobjects.TrustedBackends{
+			Alias:     backend.Alias,
+			SubjectDN: formatDN(backend.Certificate.Subject),
+			Issuer:    formatDN(backend.Certificate.Issuer),
+			ValidTo:   backend.Certificate.NotAfter.UnixMilli(),
+		})
+	}
+	writeJSON(w, http.StatusOK, trustStore)
+}
+
+func (s *Server) updateBackendTrustStore(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	if !body.has("trustAllBackends") {
+		writeResult(w, 0, nil, badRequest("Missing mandatory property 'trustAllBackends'"))
+		return
+	}
+
+	if s.state.TrustAllBackends, err = body.bool("trustAllBackends"); err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// addTrustedBackend adds an uploaded certificate to the trust store. The alias
+// is derived from the common name of the certificate.
+func (s *Server) addTrustedBackend(w http.ResponseWriter, r *http.Request) {
+	data, err := formFile(r, "certificate")
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	certs, err := parseCertificates(data)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	alias := strings.ToLower(strings.ReplaceAll(certs[0].Subject.CommonName, " ", "_"))
+	if alias == "" {
+		writeResult(w, 0, nil, badRequest("The certificate must contain a common name (CN)"))
+		return
+	}
+
+	if s.state.TrustedBackend(alias) != nil {
+		writeResult(w, 0, nil, conflict("A certificate with alias %s already exists in the trust store", alias))
+		return
+	}
+
+	s.state.TrustedBackends = append(s.state.TrustedBackends, &TrustedBackend{Alias: alias, Certificate: certs[0]})
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) deleteTrustedBackend(w http.ResponseWriter, r *http.Request) {
+	alias := r.PathValue("alias")
+
+	for i, backend := range s.state.TrustedBackends {
+		if backend.Alias == alias {
+			s.state.TrustedBackends = append(s.state.TrustedBackends[:i], s.state.TrustedBackends[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	writeResult(w, 0, nil, notFound("No certificate with alias %s in the trust store", alias))
+}
+
+// TrustedBackend returns the trust store entry with the given alias, or nil.
+func (s *State) TrustedBackend(alias string) *TrustedBackend {
+	for _, backend := range s.TrustedBackends {
+		if backend.Alias == alias {
+			return backend
+		}
+	}
+	return nil
+}
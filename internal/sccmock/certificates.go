@@ -0,0 +1,372 @@
+package sccmock
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+)
+
+var oidEmailAddress = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 1}
+
+func (s *Server) certificateRoutes(mux *http.ServeMux) {
+	for path, certificate := range map[string]func(*State) **x509.Certificate{
+		"/api/v1/configuration/connector/onPremise/systemCertificate": func(state *State) **x509.Certificate { return &state.SystemCertificate },
+		"/api/v1/configuration/connector/onPremise/ppCaCertificate":   func(state *State) **x509.Certificate { return &state.CACertificate },
+		"/api/v1/configuration/connector/ui/uiCertificate":            func(state *State) **x509.Certificate { return &state.UICertificate },
+	} {
+		h := certificateHandler{server: s, certificate: certificate}
+
+		mux.HandleFunc("GET "+path, h.get)
+		mux.HandleFunc("POST "+path, h.createSelfSigned)
+		mux.HandleFunc("PATCH "+path, h.uploadSignedChain)
+		mux.HandleFunc("PUT "+path, h.uploadPKCS12)
+		mux.HandleFunc("DELETE "+path, h.delete)
+	}
+}
+
+// certificateHandler serves one of the certificates of the Cloud Connector
+// (system certificate, CA certificate for principal propagation or UI
+// certificate), which all share the same API.
+type certificateHandler struct {
+	server      *Server
+	certificate func(*State) **x509.Certificate
+}
+
+func (h certificateHandler) get(w http.ResponseWriter, r *http.Request) {
+	cert := *h.certificate(h.server.state)
+	if cert == nil {
+		writeResult(w, 0, nil, notFound("No certificate configured"))
+		return
+	}
+
+	if r.Header.Get("Accept") == "application/pkix-cert" {
+		w.Header().Set("Content-Type", "application/pkix-cert")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(cert.Raw)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, certificateObject(cert))
+}
+
+func (h certificateHandler) createSelfSigned(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	if certType := body.string("type"); certType != "selfsigned" {
+		writeResult(w, 0, nil, badRequest("Unsupported certificate type %q", certType))
+		return
+	}
+
+	keySize, err := body.int("keySize")
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+	if keySize != 2048 && keySize != 4096 {
+		writeResult(w, 0, nil, badRequest("Invalid key size %d, expected 2048 or 4096", keySize))
+		return
+	}
+
+	subjectDN, err := body.requiredString("subjectDN")
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	template, err := certificateTemplate(subjectDN)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	if sans, ok := body["subjectAltNames"].([]any); ok {
+		for _, entry := range sans {
+			san, _ := entry.(map[string]any)
+			if err := addSubjectAltName(template, requestBody(san)); err != nil {
+				writeResult(w, 0, nil, err)
+				return
+			}
+		}
+	}
+
+	cert, err := selfSign(template)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	*h.certificate(h.server.state) = cert
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h certificateHandler) uploadSignedChain(w http.ResponseWriter, r *http.Request) {
+	data, err := formFile(r, "signedCertificate")
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	chain, err := parseCertificates(data)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	*h.certificate(h.server.state) = chain[0]
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// uploadPKCS12 accepts a PKCS#12 archive. The fake server does not decrypt the
+// archive, it installs a generated certificate with the subject CN=pkcs12
+// instead.
+func (h certificateHandler) uploadPKCS12(w http.ResponseWriter, r *http.Request) {
+	data, err := formFile(r, "pkcs12")
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+	if len(data) == 0 {
+		writeResult(w, 0, nil, badRequest("The PKCS#12 archive is empty"))
+		return
+	}
+	if r.FormValue("password") == "" {
+		writeResult(w, 0, nil, badRequest("Missing mandatory property 'password'"))
+		return
+	}
+
+	template, err := certificateTemplate("CN=pkcs12")
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	cert, err := selfSign(template)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	*h.certificate(h.server.state) = cert
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h certificateHandler) delete(w http.ResponseWriter, r *http.Request) {
+	certificate := h.certificate(h.server.state)
+	if *certificate == nil {
+		writeResult(w, 0, nil, notFound("No certificate configured"))
+		return
+	}
+
+	*certificate = nil
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func formFile(r *http.Request, field string) ([]byte, error) {
+	file, _, err := r.FormFile(field)
+	if err != nil {
+		return nil, badRequest("Missing file '%s' in multipart request: %v", field, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, badRequest("Failed to read file '%s': %v", field, err)
+	}
+	return data, nil
+}
+
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, badRequest("Invalid certificate: %v", err)
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, badRequest("No PEM encoded X.509 certificate found")
+	}
+	return certs, nil
+}
+
+// certificateTemplate returns a certificate template with the subject parsed
+// from a distinguished name like "CN=host,O=Org,C=DE".
+func certificateTemplate(subjectDN string) (*x509.Certificate, error) {
+	var subject pkix.Name
+
+	for part := range strings.SplitSeq(subjectDN, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, badRequest("Invalid subject DN %q", subjectDN)
+		}
+		value = strings.TrimSpace(value)
+
+		switch strings.ToUpper(strings.TrimSpace(key)) {
+		case "CN":
+			subject.CommonName = value
+		case "EMAIL":
+			subject.ExtraNames = append(subject.ExtraNames, pkix.AttributeTypeAndValue{Type: oidEmailAddress, Value: value})
+		case "L":
+			subject.Locality = []string{value}
+		case "OU":
+			subject.OrganizationalUnit = []string{value}
+		case "O":
+			subject.Organization = []string{value}
+		case "ST":
+			subject.Province = []string{value}
+		case "C":
+			subject.Country = []string{value}
+		default:
+			return nil, badRequest("Unsupported attribute %q in subject DN %q", key, subjectDN)
+		}
+	}
+
+	if subject.CommonName == "" {
+		return nil, badRequest("The subject DN %q must contain a common name (CN)", subjectDN)
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Truncate(time.Second)
+	return &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      subject,
+		NotBefore:    now,
+		NotAfter:     now.AddDate(1, 0, 0),
+	}, nil
+}
+
+func addSubjectAltName(template *x509.Certificate, san requestBody) error {
+	value := san.string("value")
+	if value == "" {
+		return badRequest("Missing value of subject alternative name")
+	}
+
+	switch san.string("type") {
+	case "DNS":
+		template.DNSNames = append(template.DNSNames, value)
+	case "IP":
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return badRequest("Invalid IP address %q", value)
+		}
+		template.IPAddresses = append(template.IPAddresses, ip)
+	case "RFC822":
+		template.EmailAddresses = append(template.EmailAddresses, value)
+	case "URI":
+		uri, err := url.Parse(value)
+		if err != nil {
+			return badRequest("Invalid URI %q", value)
+		}
+		template.URIs = append(template.URIs, uri)
+	default:
+		return oneOf("type", san.string("type"), "DNS", "IP", "RFC822", "URI")
+	}
+	return nil
+}
+
+// selfSign issues the certificate described by template. An ECDSA key is used
+// regardless of the requested key size to keep the fake server fast.
+func selfSign(template *x509.Certificate) (*x509.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(der)
+}
+
+func certificateObject(cert *x509.Certificate) apiobjects.Certificate {
+	object := apiobjects.Certificate{
+		SubjectDN:          formatDN(cert.Subject),
+		Issuer:             formatDN(cert.Issuer),
+		NotBeforeTimeStamp: cert.NotBefore.UnixMilli(),
+		NotAfterTimeStamp:  cert.NotAfter.UnixMilli(),
+		SerialNumber:       formatSerialNumber(cert.SerialNumber),
+	}
+
+	for _, name := range cert.DNSNames {
+		object.SubjectAltNames = append(object.SubjectAltNames, apiobjects.SubjectAltNames{Type: "DNS", Value: name})
+	}
+	for _, ip := range cert.IPAddresses {
+		object.SubjectAltNames = append(object.SubjectAltNames, apiobjects.SubjectAltNames{Type: "IP", Value: ip.String()})
+	}
+	for _, email := range cert.EmailAddresses {
+		object.SubjectAltNames = append(object.SubjectAltNames, apiobjects.SubjectAltNames{Type: "RFC822", Value: email})
+	}
+	for _, uri := range cert.URIs {
+		object.SubjectAltNames = append(object.SubjectAltNames, apiobjects.SubjectAltNames{Type: "URI", Value: uri.String()})
+	}
+
+	return object
+}
+
+// formatDN renders a distinguished name in the order used by the Cloud
+// Connector: CN, EMAIL, L, OU, O, ST, C.
+func formatDN(name pkix.Name) string {
+	var parts []string
+	add := func(key string, values ...string) {
+		if len(values) > 0 && values[0] != "" {
+			parts = append(parts, fmt.Sprintf("%s=%s", key, values[0]))
+		}
+	}
+
+	add("CN", name.CommonName)
+	for _, attr := range name.Names {
+		if attr.Type.Equal(oidEmailAddress) {
+			add("EMAIL", fmt.Sprint(attr.Value))
+		}
+	}
+	add("L", name.Locality...)
+	add("OU", name.OrganizationalUnit...)
+	add("O", name.Organization...)
+	add("ST", name.Province...)
+	add("C", name.Country...)
+
+	return strings.Join(parts, ",")
+}
+
+func formatSerialNumber(serial *big.Int) string {
+	b := serial.Bytes()
+	parts := make([]string, len(b))
+	for i, v := range b {
+		parts[i] = fmt.Sprintf("%02x", v)
+	}
+	return strings.Join(parts, ":")
+}
@@ -0,0 +1,120 @@
+package sccmock
+
+import (
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+)
+
+func (s *Server) domainMappingRoutes(mux *http.ServeMux) {
+	base := subaccountsPath + "/{regionHost}/{subaccount}/domainMappings"
+
+	mux.HandleFunc("GET "+base, s.listDomainMappings)
+	mux.HandleFunc("POST "+base, s.createDomainMapping)
+	mux.HandleFunc("PUT "+base+"/{internalDomain}", s.updateDomainMapping)
+	mux.HandleFunc("DELETE "+base+"/{internalDomain}", s.deleteDomainMapping)
+}
+
+func (s *Server) listDomainMappings(w http.ResponseWriter, r *http.Request) {
+	sa, err := s.state.findSubaccount(r.PathValue("regionHost"), r.PathValue("subaccount"))
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	mappings := []apiobjects.DomainMapping{}
+	for _, m := range sa.DomainMappings {
+		mappings = append(mappings, *m)
+	}
+	writeJSON(w, http.StatusOK, mappings)
+}
+
+func (s *Server) createDomainMapping(w http.ResponseWriter, r *http.Request) {
+	sa, err := s.state.findSubaccount(r.PathValue("regionHost"), r.PathValue("subaccount"))
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	mapping, err := decodeDomainMapping(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	if sa.DomainMapping(mapping.InternalDomain) != nil {
+		writeResult(w, 0, nil, conflict("Domain mapping for internal domain %s already exists", mapping.InternalDomain))
+		return
+	}
+
+	sa.DomainMappings = append(sa.DomainMappings, mapping)
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) updateDomainMapping(w http.ResponseWriter, r *http.Request) {
+	sa, mapping, err := s.findDomainMapping(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	updated, err := decodeDomainMapping(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	if other := sa.DomainMapping(updated.InternalDomain); other != nil && other != mapping {
+		writeResult(w, 0, nil, conflict("Domain mapping for internal domain %s already exists", updated.InternalDomain))
+		return
+	}
+
+	*mapping = *updated
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteDomainMapping(w http.ResponseWriter, r *http.Request) {
+	sa, mapping, err := s.findDomainMapping(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	for i, candidate := range sa.DomainMappings {
+		if candidate == mapping {
+			sa.DomainMappings = append(sa.DomainMappings[:i], sa.DomainMappings[i+1:]...)
+			break
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) findDomainMapping(r *http.Request) (*Subaccount, *apiobjects.DomainMapping, error) {
+	sa, err := s.state.findSubaccount(r.PathValue("regionHost"), r.PathValue("subaccount"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	internalDomain := r.PathValue("internalDomain")
+	mapping := sa.DomainMapping(internalDomain)
+	if mapping == nil {
+		return nil, nil, notFound("Domain mapping for internal domain %s not found", internalDomain)
+	}
+	return sa, mapping, nil
+}
+
+func decodeDomainMapping(r *http.Request) (*apiobjects.DomainMapping, error) {
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping := &apiobjects.DomainMapping{}
+	if mapping.VirtualDomain, err = body.requiredString("virtualDomain"); err != nil {
+		return nil, err
+	}
+	if mapping.InternalDomain, err = body.requiredString("internalDomain"); err != nil {
+		return nil, err
+	}
+	return mapping, nil
+}
@@ -0,0 +1,166 @@
+package sccmock
+
+import (
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+)
+
+const (
+	haMasterConfigPath = "/api/v1/configuration/connector/ha/master/config"
+	haShadowConfigPath = "/api/v1/configuration/connector/ha/shadow/config"
+	haShadowStatePath  = "/api/v1/configuration/connector/ha/shadow/state"
+)
+
+// Connection states of the shadow instance.
+const (
+	HAShadowConnected    = "connected"
+	HAShadowDisconnected = "disconnected"
+)
+
+// Defaults of the shadow configuration of a new installation.
+var defaultHAShadowConfiguration = apiobjects.HAShadowConfiguration{
+	MasterPort:             8443,
+	CheckIntervalInSeconds: 5,
+	TakeoverDelayInSeconds: 30,
+	ConnectTimeoutInMillis: 15000,
+	RequestTimeoutInMillis: 60000,
+}
+
+func (s *Server) highAvailabilityRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+haMasterConfigPath, s.getHAMasterConfiguration)
+	mux.HandleFunc("PUT "+haMasterConfigPath, s.updateHAMasterConfiguration)
+	mux.HandleFunc("GET "+haShadowConfigPath, s.getHAShadowConfiguration)
+	mux.HandleFunc("PUT "+haShadowConfigPath, s.updateHAShadowConfiguration)
+	mux.HandleFunc("GET "+haShadowStatePath, s.getHAShadowState)
+	mux.HandleFunc("POST "+haShadowStatePath, s.changeHAShadowState)
+}
+
+func (s *Server) getHAMasterConfiguration(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.state.HAMaster)
+}
+
+func (s *Server) updateHAMasterConfiguration(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	if !body.has("haEnabled") {
+		writeResult(w, 0, nil, badRequest("Missing mandatory property 'haEnabled'"))
+		return
+	}
+
+	haEnabled, err := body.bool("haEnabled")
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	s.state.HAMaster = apiobjects.HAMasterConfiguration{
+		HAEnabled:         haEnabled,
+		AllowedShadowHost: body.string("allowedShadowHost"),
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getHAShadowConfiguration(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.state.HAShadow)
+}
+
+func (s *Server) updateHAShadowConfiguration(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	masterHost, err := body.requiredString("masterHost")
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	config := s.state.HAShadow
+	config.MasterHost = masterHost
+	if body.has("ownHost") {
+		config.OwnHost = body.string("ownHost")
+	}
+
+	for _, property := range []struct {
+		key   string
+		value *int64
+	}{
+		{"masterPort", &config.MasterPort},
+		{"checkIntervalInSeconds", &config.CheckIntervalInSeconds},
+		{"takeoverDelayInSeconds", &config.TakeoverDelayInSeconds},
+		{"connectTimeoutInMillis", &config.ConnectTimeoutInMillis},
+		{"requestTimeoutInMillis", &config.RequestTimeoutInMillis},
+	} {
+		if !body.has(property.key) {
+			continue
+		}
+		if *property.value, err = body.int(property.key); err != nil {
+			writeResult(w, 0, nil, err)
+			return
+		}
+		if *property.value < 1 {
+			writeResult(w, 0, nil, badRequest("Property '%s' must be positive", property.key))
+			return
+		}
+	}
+
+	s.state.HAShadow = config
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getHAShadowState(w http.ResponseWriter, r *http.Request) {
+	state := apiobjects.HAShadowState{State: HAShadowDisconnected, OwnHosts: []string{}}
+	if s.state.HAShadowConnected {
+		state.State = HAShadowConnected
+	}
+	writeJSON(w, http.StatusOK, state)
+}
+
+// changeHAShadowState connects to or disconnects from the master instance. The
+// fake master instance accepts the credentials of the fake server.
+func (s *Server) changeHAShadowState(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	op, err := body.requiredString("op")
+	if err == nil {
+		err = oneOf("op", op, "CONNECT", "DISCONNECT")
+	}
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	if op == "DISCONNECT" {
+		s.state.HAShadowConnected = false
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	switch {
+	case s.state.HAShadow.MasterHost == "":
+		err = badRequest("No master instance configured")
+	case s.state.HAShadowConnected:
+		err = conflict("Shadow instance is already connected to %s", s.state.HAShadow.MasterHost)
+	case body.string("user") != Username || body.string("password") != Password:
+		err = badRequest("Connection to master instance %s failed: invalid credentials", s.state.HAShadow.MasterHost)
+	}
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	s.state.HAShadowConnected = true
+	s.state.HAShadowConnects++
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,94 @@
+package sccmock
+
+import (
+	"net/http"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+)
+
+// Kerberos is configured below onPremise, and below onPremises from version
+// 2.18.0 on.
+const (
+	kerberosOnPremisePath  = "/api/v1/configuration/connector/onPremise/kerberos"
+	kerberosOnPremisesPath = "/api/v1/configuration/connector/onPremises/kerberos"
+)
+
+func (s *Server) kerberosRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+kerberosOnPremisePath, s.getKerberosSettings)
+	mux.HandleFunc("PUT "+kerberosOnPremisePath, s.updateKerberosSettings)
+	mux.HandleFunc("DELETE "+kerberosOnPremisePath, s.deleteKerberosSettings)
+	mux.HandleFunc("GET "+kerberosOnPremisesPath, s.since(api.VersionOnPremisesPath, s.getKerberosSettings))
+	mux.HandleFunc("PUT "+kerberosOnPremisesPath, s.since(api.VersionOnPremisesPath, s.updateKerberosSettings))
+	mux.HandleFunc("DELETE "+kerberosOnPremisesPath, s.since(api.VersionOnPremisesPath, s.deleteKerberosSettings))
+}
+
+func (s *Server) getKerberosSettings(w http.ResponseWriter, r *http.Request) {
+	settings := apiobjects.KerberosSettings{KDCs: []apiobjects.KerberosKDC{}}
+	if s.state.Kerberos != nil {
+		settings = *s.state.Kerberos
+	}
+
+	// The keytab is never returned.
+	settings.Keytab = ""
+	writeJSON(w, http.StatusOK, settings)
+}
+
+// updateKerberosSettings replaces the Kerberos configuration. A request
+// without keytab keeps the stored keytab.
+func (s *Server) updateKerberosSettings(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	settings := apiobjects.KerberosSettings{Keytab: body.string("keytab")}
+	if settings.Realm, err = body.requiredString("realm"); err == nil {
+		settings.ServiceUser, err = body.requiredString("serviceUser")
+	}
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	kdcs, _ := body["kdcs"].([]any)
+	if len(kdcs) == 0 {
+		writeResult(w, 0, nil, badRequest("At least one KDC is required"))
+		return
+	}
+	for _, kdc := range kdcs {
+		entry, _ := kdc.(map[string]any)
+		host, err := requestBody(entry).requiredString("host")
+		if err != nil {
+			writeResult(w, 0, nil, err)
+			return
+		}
+		port, err := requestBody(entry).int("port")
+		if err != nil {
+			writeResult(w, 0, nil, err)
+			return
+		}
+		if port < 1 || port > 65535 {
+			writeResult(w, 0, nil, badRequest("Invalid port %d of KDC %s, the port must be between 1 and 65535", port, host))
+			return
+		}
+		settings.KDCs = append(settings.KDCs, apiobjects.KerberosKDC{Host: host, Port: port})
+	}
+
+	if settings.Keytab == "" {
+		if s.state.Kerberos == nil {
+			writeResult(w, 0, nil, badRequest("Missing mandatory property 'keytab'"))
+			return
+		}
+		settings.Keytab = s.state.Kerberos.Keytab
+	}
+
+	s.state.Kerberos = &settings
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteKerberosSettings(w http.ResponseWriter, r *http.Request) {
+	s.state.Kerberos = nil
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,73 @@
+package sccmock
+
+import (
+	"fmt"
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+)
+
+const proxySettingsPath = "/api/v1/configuration/connector/proxy"
+
+func (s *Server) proxySettingsRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+proxySettingsPath, s.getProxySettings)
+	mux.HandleFunc("PUT "+proxySettingsPath, s.updateProxySettings)
+	mux.HandleFunc("DELETE "+proxySettingsPath, s.deleteProxySettings)
+}
+
+func (s *Server) getProxySettings(w http.ResponseWriter, r *http.Request) {
+	settings := apiobjects.ProxySettings{}
+	if s.state.ProxySettings != nil {
+		settings = *s.state.ProxySettings
+	}
+
+	// The password is never returned, only masked if one is set.
+	if settings.Password != "" {
+		settings.Password = "***"
+	}
+	writeJSON(w, http.StatusOK, settings)
+}
+
+func (s *Server) updateProxySettings(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	host, err := body.requiredString("host")
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	port, err := body.int("port")
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+	if port < 1 || port > 65535 {
+		writeResult(w, 0, nil, badRequest("Invalid port %d, the port must be between 1 and 65535", port))
+		return
+	}
+
+	user := body.string("user")
+	password := body.string("password")
+	if password != "" && user == "" {
+		writeResult(w, 0, nil, badRequest("A password requires a user"))
+		return
+	}
+
+	s.state.ProxySettings = &apiobjects.ProxySettings{
+		Host:     host,
+		Port:     fmt.Sprint(port),
+		User:     user,
+		Password: password,
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteProxySettings(w http.ResponseWriter, r *http.Request) {
+	s.state.ProxySettings = nil
+	w.WriteHeader(http.StatusNoContent)
+}
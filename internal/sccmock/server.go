@@ -0,0 +1,247 @@
+// Package sccmock provides a stateful fake of the Cloud Connector REST API for
+// offline tests. It keeps the configuration in memory, so tests can run the
+// full create, read, update and delete cycle of resources and simulate drift by
+// changing the state directly, without a live Cloud Connector or recorded
+// fixtures.
+package sccmock
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+)
+
+// Credentials of the Administrator of the fake server (the initial
+// credentials of a Cloud Connector installation).
+const (
+	Username = "Administrator"
+	Password = "manage"
+)
+
+// Server is a fake Cloud Connector listening on a local httptest server.
+type Server struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	state *State
+}
+
+// NewServer starts a fake Cloud Connector with an empty configuration. The
+// server is closed when the test finishes.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{state: newState()}
+	s.Server = httptest.NewServer(s.routes())
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Update runs fn with exclusive access to the state of the fake server. Tests
+// use it to seed configuration or to simulate changes made outside Terraform.
+func (s *Server) Update(fn func(state *State)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn(s.state)
+}
+
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /api/v1/connector/version", s.getVersion)
+
+	s.subaccountRoutes(mux)
+	s.systemMappingRoutes(mux)
+	s.domainMappingRoutes(mux)
+	s.serviceChannelRoutes(mux)
+	s.certificateRoutes(mux)
+	s.backendTrustStoreRoutes(mux)
+	s.proxySettingsRoutes(mux)
+	s.subjectPatternRuleRoutes(mux)
+	s.highAvailabilityRoutes(mux)
+	s.auditLogRoutes(mux)
+	s.traceSettingsRoutes(mux)
+	s.kerberosRoutes(mux)
+	s.sncRoutes(mux)
+	s.authenticationRoutes(mux)
+	s.userRoutes(mux)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("No resource found for %s %s", r.Method, r.URL.Path))
+	})
+
+	return s.authenticate(mux)
+}
+
+// authenticate serializes access to the state and rejects requests without
+// the basic authentication credentials of a local user.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		user, password, ok := r.BasicAuth()
+		if u := s.state.User(user); !ok || u == nil || u.Password != password {
+			w.Header().Set("WWW-Authenticate", `Basic realm="SAP Cloud Connector"`)
+			writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// since serves next from Cloud Connector version minimum on. Older versions
+// report 404 Not Found like for any unknown path.
+func (s *Server) since(minimum api.Version, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if version, err := api.ParseVersion(s.state.Version); err == nil && !version.AtLeast(minimum) {
+			writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("No resource found for %s %s", r.Method, r.URL.Path))
+			return
+		}
+		next(w, r)
+	}
+}
+
+func (s *Server) getVersion(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"version": s.state.Version})
+}
+
+// apiError is the error reported by a handler, rendered in the error format of
+// the Cloud Connector.
+type apiError struct {
+	status  int
+	errType string
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func badRequest(format string, args ...any) error {
+	return &apiError{http.StatusBadRequest, "ILLEGAL_ARGUMENT", fmt.Sprintf(format, args...)}
+}
+
+func notFound(format string, args ...any) error {
+	return &apiError{http.StatusNotFound, "NOT_FOUND", fmt.Sprintf(format, args...)}
+}
+
+func conflict(format string, args ...any) error {
+	return &apiError{http.StatusConflict, "CONFLICT", fmt.Sprintf(format, args...)}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, errType, message string) {
+	writeJSON(w, status, map[string]string{"type": errType, "message": message})
+}
+
+// writeResult writes the error if err is set, the body as JSON otherwise. A nil
+// body results in 204 No Content.
+func writeResult(w http.ResponseWriter, status int, body any, err error) {
+	var apiErr *apiError
+	switch {
+	case errors.As(err, &apiErr):
+		writeError(w, apiErr.status, apiErr.errType, apiErr.message)
+	case err != nil:
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", err.Error())
+	case body == nil:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeJSON(w, status, body)
+	}
+}
+
+// requestBody is a decoded JSON request body. The provider sends some numbers
+// and booleans as strings, so the accessors accept both representations.
+type requestBody map[string]any
+
+func decodeBody(r *http.Request) (requestBody, error) {
+	body := requestBody{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, badRequest("Invalid JSON request body: %v", err)
+	}
+	return body, nil
+}
+
+func (b requestBody) has(key string) bool {
+	_, ok := b[key]
+	return ok
+}
+
+func (b requestBody) string(key string) string {
+	switch v := b[key].(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func (b requestBody) requiredString(key string) (string, error) {
+	value := strings.TrimSpace(b.string(key))
+	if value == "" {
+		return "", badRequest("Missing mandatory property '%s'", key)
+	}
+	return value, nil
+}
+
+func (b requestBody) bool(key string) (bool, error) {
+	switch v := b[key].(type) {
+	case bool:
+		return v, nil
+	case string:
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, badRequest("Property '%s' must be a boolean, got %q", key, v)
+		}
+		return parsed, nil
+	case nil:
+		return false, nil
+	default:
+		return false, badRequest("Property '%s' must be a boolean", key)
+	}
+}
+
+func (b requestBody) int(key string) (int64, error) {
+	switch v := b[key].(type) {
+	case float64:
+		return int64(v), nil
+	case string:
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, badRequest("Property '%s' must be a number, got %q", key, v)
+		}
+		return parsed, nil
+	case nil:
+		return 0, nil
+	default:
+		return 0, badRequest("Property '%s' must be a number", key)
+	}
+}
+
+func oneOf(key, value string, allowed ...string) error {
+	for _, a := range allowed {
+		if value == a {
+			return nil
+		}
+	}
+	return badRequest("Invalid value %q for property '%s', expected one of: %s", value, key, strings.Join(allowed, ", "))
+}
@@ -0,0 +1,784 @@
+package sccmock_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	regionHost = "cf.eu12.hana.ondemand.com"
+	subaccount = "0bcb0012-a982-42f9-bda4-0a5cb15f88c8"
+)
+
+func TestServer_Authentication(t *testing.T) {
+	srv := sccmock.NewServer(t)
+
+	client := tfutils.NewTestClient(t, srv.Server)
+	client.Username = sccmock.Username
+	client.Password = "wrong"
+
+	var version map[string]string
+	diags := request(context.Background(), client, &version, "GET", "/api/v1/connector/version", nil, true)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Authentication Failed", diags[0].Summary())
+
+	client = tfutils.NewMockClient(t, srv)
+	diags = request(context.Background(), client, &version, "GET", "/api/v1/connector/version", nil, true)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, sccmock.DefaultVersion, version["version"])
+}
+
+func TestServer_Subaccount(t *testing.T) {
+	ctx := context.Background()
+	srv := sccmock.NewServer(t)
+	client := tfutils.NewMockClient(t, srv)
+
+	t.Run("create requires credentials", func(t *testing.T) {
+		var sa apiobjects.Subaccount
+		diags := request(ctx, client, &sa, "POST", endpoints.GetSubaccountBaseEndpoint(), map[string]any{
+			"regionHost": regionHost,
+			"subaccount": subaccount,
+		}, true)
+
+		requireAPIError(t, diags, "status 400: Missing mandatory property 'cloudUser'")
+	})
+
+	t.Run("create connects the tunnel", func(t *testing.T) {
+		var sa apiobjects.Subaccount
+		diags := request(ctx, client, &sa, "POST", endpoints.GetSubaccountBaseEndpoint(), map[string]any{
+			"regionHost":    regionHost,
+			"subaccount":    subaccount,
+			"cloudUser":     "user@example.com",
+			"cloudPassword": "secret",
+			"displayName":   "Test Subaccount",
+		}, true)
+
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, "Test Subaccount", sa.DisplayName)
+		assert.Equal(t, sccmock.TunnelConnected, sa.Tunnel.State)
+		assert.Equal(t, "user@example.com", sa.Tunnel.User)
+		assert.NotEmpty(t, sa.Tunnel.SubaccountCertificate.SerialNumber)
+	})
+
+	t.Run("create duplicate", func(t *testing.T) {
+		var sa apiobjects.Subaccount
+		diags := request(ctx, client, &sa, "POST", endpoints.GetSubaccountBaseEndpoint(), map[string]any{
+			"authenticationData": sccmock.AuthenticationData(regionHost, subaccount),
+		}, true)
+
+		requireAPIError(t, diags, "status 409")
+	})
+
+	t.Run("update rejects isManaged", func(t *testing.T) {
+		var sa apiobjects.Subaccount
+		diags := request(ctx, client, &sa, "PUT", endpoints.GetSubaccountEndpoint(regionHost, subaccount), map[string]any{
+			"isManaged": "true",
+		}, true)
+
+		requireAPIError(t, diags, "status 400: Property 'isManaged' cannot be changed")
+	})
+
+	t.Run("reconnect failure", func(t *testing.T) {
+		srv.Update(func(state *sccmock.State) {
+			state.Subaccount(regionHost, subaccount).ConnectFailures = 1
+		})
+
+		endpoint := endpoints.GetSubaccountEndpoint(regionHost, subaccount)
+		var sa apiobjects.Subaccount
+		for _, want := range []string{sccmock.TunnelConnectFailure, sccmock.TunnelConnected} {
+			diags := request(ctx, client, &sa, "PUT", endpoint+"/state", map[string]any{"connected": "true"}, false)
+			require.False(t, diags.HasError(), diags)
+
+			diags = request(ctx, client, &sa, "GET", endpoint, nil, true)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, want, sa.Tunnel.State)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		endpoint := endpoints.GetSubaccountEndpoint(regionHost, subaccount)
+		var sa apiobjects.Subaccount
+
+		diags := request(ctx, client, &sa, "DELETE", endpoint, nil, false)
+		require.False(t, diags.HasError(), diags)
+
+		diags = request(ctx, client, &sa, "GET", endpoint, nil, true)
+		requireAPIError(t, diags, "status 404")
+	})
+}
+
+func TestServer_SystemMapping(t *testing.T) {
+	ctx := context.Background()
+	srv := newServerWithSubaccount(t)
+	client := tfutils.NewMockClient(t, srv)
+
+	base := endpoints.GetSystemMappingBaseEndpoint(regionHost, subaccount)
+	mapping := map[string]any{
+		"virtualHost":        "virtual.example.com",
+		"virtualPort":        "443",
+		"localHost":          "internal.example.com",
+		"localPort":          "8443",
+		"protocol":           "HTTPS",
+		"backendType":        "abapSys",
+		"authenticationMode": "NONE",
+		"hostInHeader":       "INTERNAL",
+	}
+
+	t.Run("invalid protocol", func(t *testing.T) {
+		invalid := map[string]any{}
+		for k, v := range mapping {
+			invalid[k] = v
+		}
+		invalid["protocol"] = "FTP"
+
+		var resp any
+		diags := request(ctx, client, &resp, "POST", base, invalid, false)
+		requireAPIError(t, diags, `status 400: Invalid value "FTP" for property 'protocol'`)
+	})
+
+	t.Run("create and read", func(t *testing.T) {
+		var resp any
+		diags := request(ctx, client, &resp, "POST", base, mapping, false)
+		require.False(t, diags.HasError(), diags)
+
+		var got apiobjects.SystemMapping
+		diags = request(ctx, client, &got, "GET", endpoints.GetSystemMappingEndpoint(regionHost, subaccount, "virtual.example.com", "443"), nil, true)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, "internal.example.com", got.InternalHost)
+		assert.Equal(t, "INTERNAL", got.HostInHeader)
+	})
+
+	t.Run("resource with encoded ID", func(t *testing.T) {
+		resources := endpoints.GetSystemMappingResourceBaseEndpoint(regionHost, subaccount, "virtual.example.com", "443")
+
+		var resp any
+		diags := request(ctx, client, &resp, "POST", resources, map[string]any{
+			"id":      "/api/my-service",
+			"enabled": "true",
+		}, false)
+		require.False(t, diags.HasError(), diags)
+
+		var res apiobjects.SystemMappingResource
+		diags = request(ctx, client, &res, "GET", endpoints.GetSystemMappingResourceEndpoint(regionHost, subaccount, "virtual.example.com", "443", "-api-my+2Dservice"), nil, true)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, "/api/my-service", res.URLPath)
+		assert.True(t, res.Enabled)
+
+		var got apiobjects.SystemMapping
+		diags = request(ctx, client, &got, "GET", endpoints.GetSystemMappingEndpoint(regionHost, subaccount, "virtual.example.com", "443"), nil, true)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, int64(1), got.EnabledResourcesCount)
+	})
+}
+
+func TestServer_DomainMapping(t *testing.T) {
+	ctx := context.Background()
+	srv := newServerWithSubaccount(t)
+	client := tfutils.NewMockClient(t, srv)
+
+	base := endpoints.GetDomainMappingBaseEndpoint(regionHost, subaccount)
+	body := map[string]any{"virtualDomain": "virtual.example.com", "internalDomain": "internal.example.com"}
+
+	var resp any
+	diags := request(ctx, client, &resp, "POST", base, body, false)
+	require.False(t, diags.HasError(), diags)
+
+	diags = request(ctx, client, &resp, "POST", base, body, false)
+	requireAPIError(t, diags, "status 409")
+
+	// Simulate a change made outside Terraform.
+	srv.Update(func(state *sccmock.State) {
+		state.Subaccount(regionHost, subaccount).DomainMapping("internal.example.com").VirtualDomain = "changed.example.com"
+	})
+
+	var mappings []apiobjects.DomainMapping
+	diags = request(ctx, client, &mappings, "GET", base, nil, true)
+	require.False(t, diags.HasError(), diags)
+	require.Len(t, mappings, 1)
+	assert.Equal(t, "changed.example.com", mappings[0].VirtualDomain)
+
+	diags = request(ctx, client, &resp, "DELETE", endpoints.GetDomainMappingEndpoint(regionHost, subaccount, "internal.example.com"), nil, false)
+	require.False(t, diags.HasError(), diags)
+}
+
+func TestServer_ServiceChannel(t *testing.T) {
+	ctx := context.Background()
+	srv := newServerWithSubaccount(t)
+	client := tfutils.NewMockClient(t, srv)
+
+	base := endpoints.GetSubaccountServiceChannelBaseEndpoint(regionHost, subaccount, sccmock.ChannelTypeABAPCloud)
+
+	var resp any
+	diags := request(ctx, client, &resp, "POST", base, map[string]any{
+		"abapCloudTenantHost": "tenant.abap.eu12.hana.ondemand.com",
+		"instanceNumber":      "100",
+		"connections":         "1",
+	}, false)
+	requireAPIError(t, diags, "status 400: Invalid instance number 100")
+
+	diags = request(ctx, client, &resp, "POST", base, map[string]any{
+		"abapCloudTenantHost": "tenant.abap.eu12.hana.ondemand.com",
+		"instanceNumber":      "10",
+		"connections":         "1",
+	}, false)
+	require.False(t, diags.HasError(), diags)
+
+	var channels []apiobjects.SubaccountABAPServiceChannel
+	diags = request(ctx, client, &channels, "GET", base, nil, true)
+	require.False(t, diags.HasError(), diags)
+	require.Len(t, channels, 1)
+	assert.Equal(t, int64(3310), channels[0].Port)
+	assert.False(t, channels[0].Enabled)
+
+	state := endpoints.GetSubaccountServiceChannelEndpoint(regionHost, subaccount, sccmock.ChannelTypeABAPCloud, channels[0].ID) + "/state"
+
+	srv.Update(func(state *sccmock.State) {
+		state.Subaccount(regionHost, subaccount).Tunnel.State = sccmock.TunnelDisconnected
+	})
+	diags = request(ctx, client, &resp, "PUT", state, map[string]any{"enabled": "true"}, false)
+	requireAPIError(t, diags, "status 409")
+
+	srv.Update(func(state *sccmock.State) {
+		state.Subaccount(regionHost, subaccount).Tunnel.State = sccmock.TunnelConnected
+	})
+	diags = request(ctx, client, &resp, "PUT", state, map[string]any{"enabled": "true"}, false)
+	require.False(t, diags.HasError(), diags)
+
+	srv.Update(func(state *sccmock.State) {
+		assert.True(t, state.Subaccount(regionHost, subaccount).ServiceChannel(sccmock.ChannelTypeABAPCloud, channels[0].ID).Enabled)
+	})
+}
+
+func TestServer_Certificates(t *testing.T) {
+	ctx := context.Background()
+	srv := sccmock.NewServer(t)
+	client := tfutils.NewMockClient(t, srv)
+
+	endpoint := endpoints.GetSystemCertificateEndpoint()
+
+	var cert apiobjects.Certificate
+	diags := request(ctx, client, &cert, "GET", endpoint, nil, true)
+	requireAPIError(t, diags, "status 404")
+
+	var resp any
+	diags = request(ctx, client, &resp, "POST", endpoint, map[string]any{
+		"type":      "selfsigned",
+		"keySize":   "4096",
+		"subjectDN": "CN=scc.example.com,OU=Integration,O=Example,C=DE",
+		"subjectAltNames": []any{
+			map[string]any{"type": "DNS", "value": "scc.example.com"},
+		},
+	}, false)
+	require.False(t, diags.HasError(), diags)
+
+	diags = request(ctx, client, &cert, "GET", endpoint, nil, true)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "CN=scc.example.com,OU=Integration,O=Example,C=DE", cert.SubjectDN)
+	assert.Equal(t, cert.SubjectDN, cert.Issuer)
+	assert.Equal(t, []apiobjects.SubjectAltNames{{Type: "DNS", Value: "scc.example.com"}}, cert.SubjectAltNames)
+
+	der, err := client.SystemCertificate().Download(ctx)
+	require.NoError(t, err)
+	parsed, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	assert.Equal(t, "scc.example.com", parsed.Subject.CommonName)
+}
+
+func TestServer_BackendTrustStore(t *testing.T) {
+	ctx := context.Background()
+	srv := sccmock.NewServer(t)
+	client := tfutils.NewMockClient(t, srv)
+
+	certificate := newPEMCertificate(t, "My Backend")
+
+	err := client.BackendTrustStore().UploadCertificate(ctx, certificate)
+	require.NoError(t, err)
+
+	err = client.BackendTrustStore().UploadCertificate(ctx, certificate)
+	requireAPIError(t, api.ErrorDiagnostics(err), "status 409")
+
+	var trustStore apiobjects.BackendTrustStoreConfiguration
+	diags := request(ctx, client, &trustStore, "GET", endpoints.GetBackendTrustStoreBaseEndpoint(false), nil, true)
+	require.False(t, diags.HasError(), diags)
+	require.Len(t, trustStore.TrustedBackends, 1)
+	assert.Equal(t, "my_backend", trustStore.TrustedBackends[0].Alias)
+	assert.Equal(t, "CN=My Backend", trustStore.TrustedBackends[0].SubjectDN)
+
+	var resp any
+	diags = request(ctx, client, &resp, "DELETE", endpoints.GetBackendTrustStoreCertificateEndpoint(false)+"/my_backend", nil, false)
+	require.False(t, diags.HasError(), diags)
+
+	srv.Update(func(state *sccmock.State) {
+		assert.Empty(t, state.TrustedBackends)
+	})
+}
+
+func TestServer_ProxySettings(t *testing.T) {
+	ctx := context.Background()
+	srv := sccmock.NewServer(t)
+	client := tfutils.NewMockClient(t, srv)
+
+	endpoint := endpoints.GetProxySettingsEndpoint()
+
+	var resp any
+	diags := request(ctx, client, &resp, "PUT", endpoint, map[string]any{
+		"host":     "proxy.example.com",
+		"port":     "8080",
+		"password": "secret",
+	}, false)
+	requireAPIError(t, diags, "status 400: A password requires a user")
+
+	diags = request(ctx, client, &resp, "PUT", endpoint, map[string]any{
+		"host":     "proxy.example.com",
+		"port":     "8080",
+		"user":     "proxy-user",
+		"password": "secret",
+	}, false)
+	require.False(t, diags.HasError(), diags)
+
+	var settings apiobjects.ProxySettings
+	diags = request(ctx, client, &settings, "GET", endpoint, nil, true)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, apiobjects.ProxySettings{Host: "proxy.example.com", Port: "8080", User: "proxy-user", Password: "***"}, settings)
+}
+
+func TestServer_SubjectPatternRules(t *testing.T) {
+	ctx := context.Background()
+	srv := sccmock.NewServer(t)
+	client := tfutils.NewMockClient(t, srv)
+
+	base := endpoints.GetSubjectPatternRulesBaseEndpoint()
+
+	var resp any
+	diags := request(ctx, client, &resp, "POST", base, map[string]any{
+		"condition":      map[string]any{"variable": "user_type", "operator": "is", "value": "Admin"},
+		"subjectPattern": map[string]any{"CN": "${name}"},
+	}, false)
+	requireAPIError(t, diags, "status 400: Invalid value \"Admin\" for variable user_type")
+
+	diags = request(ctx, client, &resp, "POST", base, map[string]any{
+		"description":    "technical users",
+		"condition":      map[string]any{"variable": "user_type", "operator": "is", "value": "Technical"},
+		"subjectPattern": map[string]any{"CN": "${name}"},
+	}, false)
+	require.False(t, diags.HasError(), diags)
+
+	var rules apiobjects.SubjectPatternRules
+	diags = request(ctx, client, &rules, "GET", base, nil, true)
+	require.False(t, diags.HasError(), diags)
+	require.Len(t, rules, 1)
+	assert.Equal(t, "user_type is Technical", rules[0].Condition)
+	assert.Equal(t, "${name}", rules[0].SubjectPattern.CommonName)
+
+	diags = request(ctx, client, &resp, "DELETE", endpoints.GetSubjectPatternRuleByIndexEndpoint(1), nil, false)
+	requireAPIError(t, diags, "status 404")
+}
+
+func newServerWithSubaccount(t *testing.T) *sccmock.Server {
+	t.Helper()
+
+	srv := sccmock.NewServer(t)
+
+	var sa apiobjects.Subaccount
+	diags := request(context.Background(), tfutils.NewMockClient(t, srv), &sa, "POST", endpoints.GetSubaccountBaseEndpoint(), map[string]any{
+		"authenticationData": sccmock.AuthenticationData(regionHost, subaccount),
+	}, true)
+	require.False(t, diags.HasError(), diags)
+
+	return srv
+}
+
+func newPEMCertificate(t *testing.T, commonName string) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+// request sends body as JSON to endpoint and decodes the response into respObj
+// if decode is set.
+func request[T any](ctx context.Context, client *api.RestApiClient, respObj *T, method string, endpoint string, body map[string]any, decode bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var requestBody []byte
+	if body != nil {
+		var err error
+		if requestBody, err = json.Marshal(body); err != nil {
+			diags.AddError("Failed to Marshal Request Body", err.Error())
+			return diags
+		}
+	}
+
+	response, diags := client.DoRequest(ctx, method, endpoint, requestBody, "", "")
+	if diags.HasError() {
+		return diags
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	if decode {
+		if err := json.NewDecoder(response.Body).Decode(respObj); err != nil {
+			diags.AddError("Failed to Unmarshal Response Body", err.Error())
+		}
+	}
+	return diags
+}
+
+func requireAPIError(t *testing.T, diags diag.Diagnostics, detail string) {
+	t.Helper()
+
+	require.True(t, diags.HasError(), "expected an error")
+	assert.Equal(t, "API Error", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), detail)
+}
+
+func TestServer_HighAvailability(t *testing.T) {
+	ctx := context.Background()
+	srv := sccmock.NewServer(t)
+	client := tfutils.NewMockClient(t, srv)
+
+	var resp any
+	diags := request(ctx, client, &resp, "POST", endpoints.GetShadowInstanceStateEndpoint(), map[string]any{
+		"op":       "CONNECT",
+		"user":     sccmock.Username,
+		"password": sccmock.Password,
+	}, false)
+	requireAPIError(t, diags, "status 400: No master instance configured")
+
+	diags = request(ctx, client, &resp, "PUT", endpoints.GetShadowInstanceConfigEndpoint(), map[string]any{
+		"masterHost":             "master.example.com",
+		"masterPort":             8443,
+		"checkIntervalInSeconds": 10,
+	}, false)
+	require.False(t, diags.HasError(), diags)
+
+	var config apiobjects.HAShadowConfiguration
+	diags = request(ctx, client, &config, "GET", endpoints.GetShadowInstanceConfigEndpoint(), nil, true)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, apiobjects.HAShadowConfiguration{
+		MasterHost:             "master.example.com",
+		MasterPort:             8443,
+		CheckIntervalInSeconds: 10,
+		TakeoverDelayInSeconds: 30,
+		ConnectTimeoutInMillis: 15000,
+		RequestTimeoutInMillis: 60000,
+	}, config)
+
+	diags = request(ctx, client, &resp, "POST", endpoints.GetShadowInstanceStateEndpoint(), map[string]any{
+		"op":       "CONNECT",
+		"user":     sccmock.Username,
+		"password": "wrong",
+	}, false)
+	requireAPIError(t, diags, "status 400: Connection to master instance master.example.com failed: invalid credentials")
+
+	diags = request(ctx, client, &resp, "POST", endpoints.GetShadowInstanceStateEndpoint(), map[string]any{
+		"op":       "CONNECT",
+		"user":     sccmock.Username,
+		"password": sccmock.Password,
+	}, false)
+	require.False(t, diags.HasError(), diags)
+
+	var state apiobjects.HAShadowState
+	diags = request(ctx, client, &state, "GET", endpoints.GetShadowInstanceStateEndpoint(), nil, true)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, sccmock.HAShadowConnected, state.State)
+
+	diags = request(ctx, client, &resp, "POST", endpoints.GetShadowInstanceStateEndpoint(), map[string]any{
+		"op": "DISCONNECT",
+	}, false)
+	require.False(t, diags.HasError(), diags)
+
+	srv.Update(func(state *sccmock.State) {
+		assert.False(t, state.HAShadowConnected)
+		assert.Equal(t, 1, state.HAShadowConnects)
+	})
+}
+
+func TestServer_AuditLogLevel(t *testing.T) {
+	ctx := context.Background()
+	srv := sccmock.NewServer(t)
+	client := tfutils.NewMockClient(t, srv)
+
+	endpoint := endpoints.GetAuditLogLevelEndpoint()
+
+	var resp any
+	diags := request(ctx, client, &resp, "PUT", endpoint, map[string]any{"level": "VERBOSE"}, false)
+	requireAPIError(t, diags, "status 400")
+
+	diags = request(ctx, client, &resp, "PUT", endpoint, map[string]any{"level": "ALL"}, false)
+	require.False(t, diags.HasError(), diags)
+
+	var level apiobjects.AuditLogLevel
+	diags = request(ctx, client, &level, "GET", endpoint, nil, true)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "ALL", level.Level)
+}
+
+func TestServer_TraceSettings(t *testing.T) {
+	ctx := context.Background()
+	srv := sccmock.NewServer(t)
+	client := tfutils.NewMockClient(t, srv)
+
+	endpoint := endpoints.GetTraceSettingsEndpoint()
+
+	var resp any
+	diags := request(ctx, client, &resp, "PUT", endpoint, map[string]any{"cpicTraceLevel": 4}, false)
+	requireAPIError(t, diags, "status 400: Invalid CPI-C trace level 4, the level must be between 0 and 3")
+
+	diags = request(ctx, client, &resp, "PUT", endpoint, map[string]any{
+		"cloudConnectorLogLevel": "DEBUG",
+		"payloadTraceEnabled":    true,
+	}, false)
+	require.False(t, diags.HasError(), diags)
+
+	var settings apiobjects.TraceSettings
+	diags = request(ctx, client, &settings, "GET", endpoint, nil, true)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, apiobjects.TraceSettings{
+		CloudConnectorLogLevel: "DEBUG",
+		OtherLogLevel:          "INFO",
+		PayloadTraceEnabled:    true,
+	}, settings)
+}
+
+func TestServer_Kerberos(t *testing.T) {
+	ctx := context.Background()
+	srv := sccmock.NewServer(t)
+	client := tfutils.NewMockClient(t, srv)
+
+	endpoint := endpoints.GetKerberosEndpoint(true)
+	settings := map[string]any{
+		"realm":       "EXAMPLE.COM",
+		"kdcs":        []any{map[string]any{"host": "kdc1.example.com", "port": 88}},
+		"serviceUser": "scc-service",
+	}
+
+	var resp any
+	diags := request(ctx, client, &resp, "PUT", endpoint, settings, false)
+	requireAPIError(t, diags, "status 400: Missing mandatory property 'keytab'")
+
+	settings["keytab"] = "a2V5dGFi"
+	diags = request(ctx, client, &resp, "PUT", endpoint, settings, false)
+	require.False(t, diags.HasError(), diags)
+
+	// Without keytab, the stored keytab is kept.
+	delete(settings, "keytab")
+	settings["kdcs"] = []any{map[string]any{"host": "kdc2.example.com", "port": 750}}
+	diags = request(ctx, client, &resp, "PUT", endpoint, settings, false)
+	require.False(t, diags.HasError(), diags)
+
+	var kerberos apiobjects.KerberosSettings
+	diags = request(ctx, client, &kerberos, "GET", endpoints.GetKerberosEndpoint(false), nil, true)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, apiobjects.KerberosSettings{
+		Realm:       "EXAMPLE.COM",
+		KDCs:        []apiobjects.KerberosKDC{{Host: "kdc2.example.com", Port: 750}},
+		ServiceUser: "scc-service",
+	}, kerberos)
+
+	srv.Update(func(state *sccmock.State) {
+		assert.Equal(t, "a2V5dGFi", state.Kerberos.Keytab)
+		state.Version = "2.17.0"
+	})
+
+	diags = request(ctx, client, &kerberos, "GET", endpoint, nil, true)
+	requireAPIError(t, diags, "status 404")
+}
+
+func TestServer_SNC(t *testing.T) {
+	ctx := context.Background()
+	srv := sccmock.NewServer(t)
+	client := tfutils.NewMockClient(t, srv)
+
+	endpoint := endpoints.GetSNCEndpoint(true)
+
+	var resp any
+	diags := request(ctx, client, &resp, "PUT", endpoint, map[string]any{
+		"libraryPath": "/opt/sap/scc/sapcrypto/libsapcrypto.so",
+		"myName":      "CN=SCC",
+	}, false)
+	requireAPIError(t, diags, "status 400: Invalid SNC name \"CN=SCC\", the name must start with 'p:'")
+
+	diags = request(ctx, client, &resp, "PUT", endpoint, map[string]any{
+		"libraryPath": "/opt/sap/scc/sapcrypto/libsapcrypto.so",
+		"myName":      "p:CN=SCC",
+	}, false)
+	require.False(t, diags.HasError(), diags)
+
+	var settings apiobjects.SNCSettings
+	diags = request(ctx, client, &settings, "GET", endpoints.GetSNCEndpoint(false), nil, true)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, apiobjects.SNCSettings{LibraryPath: "/opt/sap/scc/sapcrypto/libsapcrypto.so", MyName: "p:CN=SCC", QoP: 3}, settings)
+
+	srv.Update(func(state *sccmock.State) {
+		state.Version = "2.17.0"
+	})
+
+	diags = request(ctx, client, &settings, "GET", endpoint, nil, true)
+	requireAPIError(t, diags, "status 404")
+}
+
+func TestServer_LDAPAuthentication(t *testing.T) {
+	ctx := context.Background()
+	srv := sccmock.NewServer(t)
+	client := tfutils.NewMockClient(t, srv)
+
+	var resp any
+	diags := request(ctx, client, &resp, "PUT", endpoints.GetAuthenticationEndpoint(), map[string]any{"type": "ldap"}, false)
+	requireAPIError(t, diags, "status 400: LDAP authentication is not configured")
+
+	diags = request(ctx, client, &resp, "PUT", endpoints.GetLDAPAuthenticationEndpoint(), map[string]any{
+		"hosts":    []any{map[string]any{"host": "ldap.example.com", "port": 636, "isSecure": true}},
+		"user":     "cn=scc,dc=example,dc=com",
+		"password": "secret",
+		"userBase": "ou=users,dc=example,dc=com",
+		"roleBase": "ou=groups,dc=example,dc=com",
+	}, false)
+	require.False(t, diags.HasError(), diags)
+
+	var ldap apiobjects.LDAPAuthentication
+	diags = request(ctx, client, &ldap, "GET", endpoints.GetLDAPAuthenticationEndpoint(), nil, true)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, []apiobjects.LDAPHost{{Host: "ldap.example.com", Port: 636, IsSecure: true}}, ldap.Hosts)
+	assert.Empty(t, ldap.Password)
+
+	diags = request(ctx, client, &resp, "PUT", endpoints.GetAuthenticationEndpoint(), map[string]any{"type": "ldap"}, false)
+	require.False(t, diags.HasError(), diags)
+
+	diags = request(ctx, client, &resp, "DELETE", endpoints.GetLDAPAuthenticationEndpoint(), nil, false)
+	requireAPIError(t, diags, "status 409: LDAP authentication is active and cannot be removed")
+}
+
+func TestServer_ChangePassword(t *testing.T) {
+	ctx := context.Background()
+	srv := sccmock.NewServer(t)
+	srv.Update(func(state *sccmock.State) {
+		state.Users = append(state.Users, &sccmock.User{Name: "operator", Password: "old"})
+	})
+	client := tfutils.NewMockClient(t, srv)
+
+	var resp any
+	diags := request(ctx, client, &resp, "PUT", endpoints.GetBasicAuthenticationEndpoint(), map[string]any{
+		"user":        "operator",
+		"password":    "wrong",
+		"newPassword": "new",
+	}, false)
+	requireAPIError(t, diags, "status 400: The current password of user operator is wrong")
+
+	diags = request(ctx, client, &resp, "PUT", endpoints.GetBasicAuthenticationEndpoint(), map[string]any{
+		"user":        "unknown",
+		"password":    "old",
+		"newPassword": "new",
+	}, false)
+	requireAPIError(t, diags, "status 404: User unknown not found")
+
+	diags = request(ctx, client, &resp, "PUT", endpoints.GetBasicAuthenticationEndpoint(), map[string]any{
+		"user":        "operator",
+		"password":    "old",
+		"newPassword": "new",
+	}, false)
+	require.False(t, diags.HasError(), diags)
+
+	// The new password authenticates the user.
+	client = tfutils.NewTestClient(t, srv.Server)
+	client.Username = "operator"
+	client.Password = "new"
+	var version map[string]string
+	diags = request(ctx, client, &version, "GET", "/api/v1/connector/version", nil, true)
+	require.False(t, diags.HasError(), diags)
+}
+
+func TestServer_Users(t *testing.T) {
+	ctx := context.Background()
+	srv := sccmock.NewServer(t)
+	client := tfutils.NewMockClient(t, srv)
+
+	var resp any
+	diags := request(ctx, client, &resp, "POST", endpoints.GetUsersBaseEndpoint(), map[string]any{"user": "jdoe"}, false)
+	requireAPIError(t, diags, "status 400: Missing mandatory property 'password'")
+
+	diags = request(ctx, client, &resp, "POST", endpoints.GetUsersBaseEndpoint(), map[string]any{
+		"user":        "jdoe",
+		"password":    "secret",
+		"description": "John Doe",
+	}, false)
+	require.False(t, diags.HasError(), diags)
+
+	diags = request(ctx, client, &resp, "POST", endpoints.GetUsersBaseEndpoint(), map[string]any{"user": "jdoe", "password": "secret"}, false)
+	requireAPIError(t, diags, "status 409: User jdoe already exists")
+
+	diags = request(ctx, client, &resp, "PUT", endpoints.GetUserRolesEndpoint("jdoe"), map[string]any{"roles": []string{"sccdisplay", "sccunknown"}}, false)
+	requireAPIError(t, diags, `status 400: Invalid value "sccunknown" for property 'roles'`)
+
+	diags = request(ctx, client, &resp, "PUT", endpoints.GetUserRolesEndpoint("jdoe"), map[string]any{"roles": []string{"sccdisplay", "sccsupport"}}, false)
+	require.False(t, diags.HasError(), diags)
+
+	var users []apiobjects.User
+	diags = request(ctx, client, &users, "GET", endpoints.GetUsersBaseEndpoint(), nil, true)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, []apiobjects.User{
+		{User: sccmock.Username, Roles: []string{"sccadmin"}},
+		{User: "jdoe", Description: "John Doe", Roles: []string{"sccdisplay", "sccsupport"}},
+	}, users)
+
+	diags = request(ctx, client, &resp, "DELETE", endpoints.GetUserEndpoint("jdoe"), nil, false)
+	require.False(t, diags.HasError(), diags)
+
+	var user apiobjects.User
+	diags = request(ctx, client, &user, "GET", endpoints.GetUserEndpoint("jdoe"), nil, true)
+	requireAPIError(t, diags, "status 404: User jdoe not found")
+}
+
+func TestServer_SubaccountTrust(t *testing.T) {
+	ctx := context.Background()
+	srv := newServerWithSubaccount(t)
+	srv.Update(func(state *sccmock.State) {
+		state.Subaccount(regionHost, subaccount).IdentityProviders = []apiobjects.SubaccountTrusted{
+			{Name: "accounts.sap.com", Trusted: true},
+			{Name: "corporate-idp", Trusted: true},
+		}
+	})
+	subaccounts := tfutils.NewMockClient(t, srv).Subaccounts()
+
+	err := subaccounts.SetTrustedIdentityProviders(ctx, regionHost, subaccount, []apiobjects.SubaccountTrusted{{Name: "unknown-idp", Trusted: true}})
+	assert.ErrorContains(t, err, "Unknown identity provider unknown-idp")
+
+	err = subaccounts.SetTrustedIdentityProviders(ctx, regionHost, subaccount, []apiobjects.SubaccountTrusted{{Name: "accounts.sap.com", Trusted: false}})
+	require.NoError(t, err)
+
+	trust, err := subaccounts.GetTrust(ctx, regionHost, subaccount)
+	require.NoError(t, err)
+	assert.Equal(t, []apiobjects.SubaccountTrusted{
+		{Name: "accounts.sap.com", Trusted: false},
+		{Name: "corporate-idp", Trusted: true},
+	}, trust.IdentityProviders)
+	assert.Empty(t, trust.Applications)
+}
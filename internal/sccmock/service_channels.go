@@ -0,0 +1,277 @@
+package sccmock
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+)
+
+// Service channel types supported by the fake server.
+const (
+	ChannelTypeABAPCloud    = "ABAPCloud"
+	ChannelTypeABAPCloudSNC = "ABAPCloudSNC"
+	ChannelTypeK8S          = "K8S"
+)
+
+func (s *Server) serviceChannelRoutes(mux *http.ServeMux) {
+	base := subaccountsPath + "/{regionHost}/{subaccount}/channels/{type}"
+	item := base + "/{id}"
+
+	mux.HandleFunc("GET "+base, s.listServiceChannels)
+	mux.HandleFunc("POST "+base, s.createServiceChannel)
+	mux.HandleFunc("GET "+item, s.getServiceChannel)
+	mux.HandleFunc("PUT "+item, s.updateServiceChannel)
+	mux.HandleFunc("DELETE "+item, s.deleteServiceChannel)
+	mux.HandleFunc("PUT "+item+"/state", s.updateServiceChannelState)
+}
+
+func (s *Server) listServiceChannels(w http.ResponseWriter, r *http.Request) {
+	sa, channelType, err := s.findServiceChannels(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	channels := []any{}
+	for _, c := range sa.ServiceChannels {
+		if c.Type == channelType {
+			channels = append(channels, c.apiObject())
+		}
+	}
+	writeJSON(w, http.StatusOK, channels)
+}
+
+func (s *Server) createServiceChannel(w http.ResponseWriter, r *http.Request) {
+	sa, channelType, err := s.findServiceChannels(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	channel := &ServiceChannel{Type: channelType}
+	if err := channel.apply(body); err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	for _, c := range sa.ServiceChannels {
+		if c.Type == channel.Type && c.Host == channel.Host && c.Port == channel.Port {
+			writeResult(w, 0, nil, conflict("A %s service channel for %s on port %d already exists", channel.Type, channel.Host, channel.Port))
+			return
+		}
+	}
+
+	sa.nextChannelID++
+	channel.ID = sa.nextChannelID
+	sa.ServiceChannels = append(sa.ServiceChannels, channel)
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) getServiceChannel(w http.ResponseWriter, r *http.Request) {
+	_, channel, err := s.findServiceChannel(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, channel.apiObject())
+}
+
+func (s *Server) updateServiceChannel(w http.ResponseWriter, r *http.Request) {
+	_, channel, err := s.findServiceChannel(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	updated := *channel
+	if err := updated.apply(body); err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	*channel = updated
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteServiceChannel(w http.ResponseWriter, r *http.Request) {
+	sa, channel, err := s.findServiceChannel(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	for i, candidate := range sa.ServiceChannels {
+		if candidate == channel {
+			sa.ServiceChannels = append(sa.ServiceChannels[:i], sa.ServiceChannels[i+1:]...)
+			break
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) updateServiceChannelState(w http.ResponseWriter, r *http.Request) {
+	sa, channel, err := s.findServiceChannel(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	enabled, err := body.bool("enabled")
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	if enabled && sa.Tunnel.State != TunnelConnected {
+		writeResult(w, 0, nil, conflict("Service channel %d cannot be enabled while subaccount %s is not connected", channel.ID, sa.ID))
+		return
+	}
+
+	if enabled && !channel.Enabled {
+		channel.ConnectedSince = time.Now().UnixMilli()
+	}
+	if !enabled {
+		channel.ConnectedSince = 0
+	}
+	channel.Enabled = enabled
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) findServiceChannels(r *http.Request) (*Subaccount, string, error) {
+	sa, err := s.state.findSubaccount(r.PathValue("regionHost"), r.PathValue("subaccount"))
+	if err != nil {
+		return nil, "", err
+	}
+
+	channelType := r.PathValue("type")
+	if err := oneOf("type", channelType, ChannelTypeABAPCloud, ChannelTypeABAPCloudSNC, ChannelTypeK8S); err != nil {
+		return nil, "", err
+	}
+	return sa, channelType, nil
+}
+
+func (s *Server) findServiceChannel(r *http.Request) (*Subaccount, *ServiceChannel, error) {
+	sa, channelType, err := s.findServiceChannels(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		return nil, nil, badRequest("Invalid service channel ID %q", r.PathValue("id"))
+	}
+
+	channel := sa.ServiceChannel(channelType, id)
+	if channel == nil {
+		return nil, nil, notFound("%s service channel %d not found", channelType, id)
+	}
+	return sa, channel, nil
+}
+
+// apply validates and applies a service channel request body.
+func (c *ServiceChannel) apply(body requestBody) error {
+	var err error
+
+	switch c.Type {
+	case ChannelTypeK8S:
+		if c.Host, err = body.requiredString("k8sCluster"); err != nil {
+			return err
+		}
+		if c.K8SService, err = body.requiredString("k8sService"); err != nil {
+			return err
+		}
+		if c.Port, err = body.int("port"); err != nil {
+			return err
+		}
+		if c.Port < 1 || c.Port > 65535 {
+			return badRequest("Invalid port %d, the port must be between 1 and 65535", c.Port)
+		}
+	default:
+		if c.Host, err = body.requiredString("abapCloudTenantHost"); err != nil {
+			return err
+		}
+		if c.InstanceNumber, err = body.int("instanceNumber"); err != nil {
+			return err
+		}
+		if c.InstanceNumber < 0 || c.InstanceNumber > 99 {
+			return badRequest("Invalid instance number %d, the instance number must be between 0 and 99", c.InstanceNumber)
+		}
+		// The local port is derived from the instance number like for an
+		// ABAP application server.
+		c.Port = 3300 + c.InstanceNumber
+		if c.Type == ChannelTypeABAPCloudSNC {
+			c.Port = 4800 + c.InstanceNumber
+		}
+	}
+
+	if c.Connections, err = body.int("connections"); err != nil {
+		return err
+	}
+	if c.Connections < 1 {
+		return badRequest("Invalid number of connections %d, at least one connection is required", c.Connections)
+	}
+
+	c.Comment = body.string("comment")
+	return nil
+}
+
+func (c *ServiceChannel) apiObject() any {
+	opened := int64(0)
+	if c.Enabled {
+		opened = c.Connections
+	}
+
+	if c.Type == ChannelTypeK8S {
+		return apiobjects.SubaccountK8SServiceChannel{
+			K8SClusterHost: c.Host,
+			K8SServiceID:   c.K8SService,
+			ID:             c.ID,
+			Type:           c.Type,
+			LocalPort:      c.Port,
+			Enabled:        c.Enabled,
+			Connections:    c.Connections,
+			Description:    c.Comment,
+			State: apiobjects.SubaccountK8SServiceChannelState{
+				Connected:               c.Enabled,
+				OpenedConnections:       opened,
+				ConnectedSinceTimeStamp: c.ConnectedSince,
+			},
+		}
+	}
+
+	return apiobjects.SubaccountABAPServiceChannel{
+		ABAPCloudTenantHost: c.Host,
+		InstanceNumber:      c.InstanceNumber,
+		ID:                  c.ID,
+		Type:                c.Type,
+		Port:                c.Port,
+		Enabled:             c.Enabled,
+		Connections:         c.Connections,
+		Comment:             c.Comment,
+		State: apiobjects.SubaccountABAPServiceChannelState{
+			Connected:               c.Enabled,
+			OpenedConnections:       opened,
+			ConnectedSinceTimeStamp: c.ConnectedSince,
+		},
+	}
+}
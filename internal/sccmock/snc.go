@@ -0,0 +1,79 @@
+package sccmock
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+)
+
+// SNC is configured below onPremise, and below onPremises from version 2.18.0
+// on.
+const (
+	sncOnPremisePath  = "/api/v1/configuration/connector/onPremise/snc"
+	sncOnPremisesPath = "/api/v1/configuration/connector/onPremises/snc"
+)
+
+// defaultSNCQualityOfProtection is the quality of protection of a new
+// installation, i.e. privacy protection.
+const defaultSNCQualityOfProtection = 3
+
+func (s *Server) sncRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+sncOnPremisePath, s.getSNCSettings)
+	mux.HandleFunc("PUT "+sncOnPremisePath, s.updateSNCSettings)
+	mux.HandleFunc("DELETE "+sncOnPremisePath, s.deleteSNCSettings)
+	mux.HandleFunc("GET "+sncOnPremisesPath, s.since(api.VersionOnPremisesPath, s.getSNCSettings))
+	mux.HandleFunc("PUT "+sncOnPremisesPath, s.since(api.VersionOnPremisesPath, s.updateSNCSettings))
+	mux.HandleFunc("DELETE "+sncOnPremisesPath, s.since(api.VersionOnPremisesPath, s.deleteSNCSettings))
+}
+
+func (s *Server) getSNCSettings(w http.ResponseWriter, r *http.Request) {
+	settings := apiobjects.SNCSettings{QoP: defaultSNCQualityOfProtection}
+	if s.state.SNC != nil {
+		settings = *s.state.SNC
+	}
+	writeJSON(w, http.StatusOK, settings)
+}
+
+func (s *Server) updateSNCSettings(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	settings := apiobjects.SNCSettings{QoP: defaultSNCQualityOfProtection}
+	if settings.LibraryPath, err = body.requiredString("libraryPath"); err == nil {
+		settings.MyName, err = body.requiredString("myName")
+	}
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+	if !strings.HasPrefix(settings.MyName, "p:") {
+		writeResult(w, 0, nil, badRequest("Invalid SNC name %q, the name must start with 'p:'", settings.MyName))
+		return
+	}
+
+	if body.has("qop") {
+		if settings.QoP, err = body.int("qop"); err != nil {
+			writeResult(w, 0, nil, err)
+			return
+		}
+		switch settings.QoP {
+		case 1, 2, 3, 8, 9:
+		default:
+			writeResult(w, 0, nil, badRequest("Invalid quality of protection %d, expected one of: 1, 2, 3, 8, 9", settings.QoP))
+			return
+		}
+	}
+
+	s.state.SNC = &settings
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteSNCSettings(w http.ResponseWriter, r *http.Request) {
+	s.state.SNC = nil
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,211 @@
+package sccmock
+
+import (
+	"crypto/x509"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+)
+
+// DefaultVersion is the Cloud Connector version reported by a new fake server.
+const DefaultVersion = "2.19.0"
+
+// State is the configuration held by the fake server. Tests can read and
+// change it through Server.Update.
+type State struct {
+	Version string
+
+	Subaccounts []*Subaccount
+
+	SystemCertificate *x509.Certificate
+	CACertificate     *x509.Certificate
+	UICertificate     *x509.Certificate
+
+	TrustAllBackends bool
+	TrustedBackends  []*TrustedBackend
+
+	ProxySettings       *apiobjects.ProxySettings
+	SubjectPatternRules []*SubjectPatternRule
+
+	// AuditLogLevel is one of SECURITY, ALL or OFF.
+	AuditLogLevel string
+	TraceSettings apiobjects.TraceSettings
+
+	HAMaster apiobjects.HAMasterConfiguration
+	HAShadow apiobjects.HAShadowConfiguration
+	// HAShadowConnected tells whether the shadow instance is connected to its
+	// master instance; HAShadowConnects counts the successful connects.
+	HAShadowConnected bool
+	HAShadowConnects  int
+
+	// Kerberos and SNC are nil as long as they are not configured.
+	Kerberos *apiobjects.KerberosSettings
+	SNC      *apiobjects.SNCSettings
+
+	// AuthenticationType is the user store administrators are authenticated
+	// against, basic or ldap. LDAP is nil as long as it is not configured.
+	AuthenticationType string
+	LDAP               *apiobjects.LDAPAuthentication
+
+	// Users are the local users the fake server authenticates requests
+	// against, initially the Administrator with Username and Password.
+	Users []*User
+}
+
+// Subaccount is a subaccount connected to the fake Cloud Connector together
+// with its configuration.
+type Subaccount struct {
+	RegionHost             string
+	ID                     string
+	LocationID             string
+	DisplayName            string
+	Description            string
+	IsManaged              bool
+	AutoCertificateRenewal bool
+	Tunnel                 apiobjects.SubaccountTunnel
+
+	CloudUser     string
+	AutoTrustSync bool
+	// IdentityProviders and Applications are the trust configuration last
+	// synchronized from SAP BTP.
+	IdentityProviders []apiobjects.SubaccountTrusted
+	Applications      []apiobjects.SubaccountTrusted
+	// ConnectFailures is the number of subsequent connection attempts that end
+	// in the tunnel state ConnectFailure.
+	ConnectFailures int
+
+	SystemMappings  []*SystemMapping
+	DomainMappings  []*apiobjects.DomainMapping
+	ServiceChannels []*ServiceChannel
+
+	nextChannelID int64
+}
+
+// SystemMapping is a system mapping of a subaccount with its resources.
+type SystemMapping struct {
+	apiobjects.SystemMapping
+
+	Resources []*apiobjects.SystemMappingResource
+}
+
+// ServiceChannel is a service channel of a subaccount. Type is one of
+// ABAPCloud, ABAPCloudSNC or K8S; Host holds the ABAP Cloud tenant host or the
+// Kubernetes cluster host depending on the type.
+type ServiceChannel struct {
+	ID             int64
+	Type           string
+	Host           string
+	K8SService     string
+	InstanceNumber int64
+	Port           int64
+	Enabled        bool
+	Connections    int64
+	Comment        string
+	ConnectedSince int64
+}
+
+// User is a local user of the Cloud Connector with its password and roles.
+type User struct {
+	Name        string
+	Password    string
+	Description string
+	Roles       []string
+}
+
+// TrustedBackend is a certificate in the backend trust store.
+type TrustedBackend struct {
+	Alias       string
+	Certificate *x509.Certificate
+}
+
+// SubjectPatternRule is a rule for the subject of the principal propagation
+// certificates. Condition holds the condition in its textual form as reported
+// by the Cloud Connector, e.g. "user_type is Business".
+type SubjectPatternRule struct {
+	Description    string
+	Condition      string
+	SubjectPattern apiobjects.SubjectPattern
+}
+
+func newState() *State {
+	return &State{
+		Version:            DefaultVersion,
+		AuditLogLevel:      "SECURITY",
+		AuthenticationType: apiobjects.AuthenticationTypeBasic,
+		TraceSettings:      defaultTraceSettings,
+		HAShadow:           defaultHAShadowConfiguration,
+		Users: []*User{{
+			Name:     Username,
+			Password: Password,
+			Roles:    []string{apiobjects.RoleAdministrator},
+		}},
+	}
+}
+
+// Subaccount returns the subaccount with the given region host and ID, or nil.
+func (s *State) Subaccount(regionHost, subaccount string) *Subaccount {
+	for _, sa := range s.Subaccounts {
+		if sa.RegionHost == regionHost && sa.ID == subaccount {
+			return sa
+		}
+	}
+	return nil
+}
+
+func (s *State) findSubaccount(regionHost, subaccount string) (*Subaccount, error) {
+	sa := s.Subaccount(regionHost, subaccount)
+	if sa == nil {
+		return nil, notFound("Subaccount %s on region %s not found", subaccount, regionHost)
+	}
+	return sa, nil
+}
+
+// User returns the local user with the given name, or nil.
+func (s *State) User(name string) *User {
+	for _, u := range s.Users {
+		if u.Name == name {
+			return u
+		}
+	}
+	return nil
+}
+
+func (s *State) findUser(name string) (*User, error) {
+	u := s.User(name)
+	if u == nil {
+		return nil, notFound("User %s not found", name)
+	}
+	return u, nil
+}
+
+// SystemMapping returns the system mapping with the given virtual host and
+// port, or nil.
+func (sa *Subaccount) SystemMapping(virtualHost, virtualPort string) *SystemMapping {
+	for _, m := range sa.SystemMappings {
+		if m.VirtualHost == virtualHost && m.VirtualPort == virtualPort {
+			return m
+		}
+	}
+	return nil
+}
+
+// ServiceChannel returns the service channel with the given type and ID, or
+// nil.
+func (sa *Subaccount) ServiceChannel(channelType string, id int64) *ServiceChannel {
+	for _, c := range sa.ServiceChannels {
+		if c.Type == channelType && c.ID == id {
+			return c
+		}
+	}
+	return nil
+}
+
+// DomainMapping returns the domain mapping with the given internal domain, or
+// nil.
+func (sa *Subaccount) DomainMapping(internalDomain string) *apiobjects.DomainMapping {
+	for _, m := range sa.DomainMappings {
+		if m.InternalDomain == internalDomain {
+			return m
+		}
+	}
+	return nil
+}
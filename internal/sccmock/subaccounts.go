@@ -0,0 +1,403 @@
+package sccmock
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"time"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+)
+
+const subaccountsPath = "/api/v1/configuration/subaccounts"
+
+// Tunnel states reported by the Cloud Connector.
+const (
+	TunnelConnected      = "Connected"
+	TunnelDisconnected   = "Disconnected"
+	TunnelConnectFailure = "ConnectFailure"
+)
+
+// AuthenticationData returns authentication data accepted by the fake server
+// to add the subaccount with the given region host and ID.
+func AuthenticationData(regionHost, subaccount string) string {
+	data, _ := json.Marshal(map[string]string{"regionHost": regionHost, "subaccount": subaccount})
+	return string(data)
+}
+
+func (s *Server) subaccountRoutes(mux *http.ServeMux) {
+	item := subaccountsPath + "/{regionHost}/{subaccount}"
+
+	mux.HandleFunc("GET "+subaccountsPath, s.listSubaccounts)
+	mux.HandleFunc("POST "+subaccountsPath, s.createSubaccount)
+	mux.HandleFunc("GET "+item, s.getSubaccount)
+	mux.HandleFunc("PUT "+item, s.updateSubaccount)
+	mux.HandleFunc("DELETE "+item, s.deleteSubaccount)
+	mux.HandleFunc("PUT "+item+"/state", s.updateTunnelState)
+	mux.HandleFunc("POST "+item+"/validity", s.renewSubaccountCertificate)
+	mux.HandleFunc("POST "+item+"/trust", s.syncTrustConfiguration)
+	mux.HandleFunc("GET "+item+"/trust", s.getTrustConfiguration)
+	mux.HandleFunc("PUT "+item+"/trust", s.updateTrustConfiguration)
+	mux.HandleFunc("PUT "+item+"/trust/identityProviders", s.updateTrustedIdentityProviders)
+	mux.HandleFunc("PUT "+item+"/trust/applications", s.updateTrustedApplications)
+}
+
+func (s *Server) listSubaccounts(w http.ResponseWriter, r *http.Request) {
+	subaccounts := []apiobjects.Subaccount{}
+	for _, sa := range s.state.Subaccounts {
+		subaccounts = append(subaccounts, sa.apiObject())
+	}
+	writeJSON(w, http.StatusOK, subaccounts)
+}
+
+func (s *Server) createSubaccount(w http.ResponseWriter, r *http.Request) {
+	sa, err := s.addSubaccount(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, sa.apiObject())
+}
+
+func (s *Server) addSubaccount(r *http.Request) (*Subaccount, error) {
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	sa := &Subaccount{
+		LocationID:  body.string("locationID"),
+		DisplayName: body.string("displayName"),
+		Description: body.string("description"),
+		Tunnel:      apiobjects.SubaccountTunnel{State: TunnelDisconnected},
+	}
+
+	if body.has("authenticationData") {
+		var data struct {
+			RegionHost string `json:"regionHost"`
+			Subaccount string `json:"subaccount"`
+		}
+		if err := json.Unmarshal([]byte(body.string("authenticationData")), &data); err != nil || data.RegionHost == "" || data.Subaccount == "" {
+			return nil, badRequest("Invalid or expired authentication data")
+		}
+		sa.RegionHost, sa.ID = data.RegionHost, data.Subaccount
+	} else {
+		if sa.RegionHost, err = body.requiredString("regionHost"); err != nil {
+			return nil, err
+		}
+		if sa.ID, err = body.requiredString("subaccount"); err != nil {
+			return nil, err
+		}
+		if sa.CloudUser, err = body.requiredString("cloudUser"); err != nil {
+			return nil, err
+		}
+		if _, err = body.requiredString("cloudPassword"); err != nil {
+			return nil, err
+		}
+	}
+
+	if sa.IsManaged, err = body.bool("isManaged"); err != nil {
+		return nil, err
+	}
+	if sa.AutoCertificateRenewal, err = body.bool("autoCertRenewal"); err != nil {
+		return nil, err
+	}
+
+	if s.state.Subaccount(sa.RegionHost, sa.ID) != nil {
+		return nil, conflict("Subaccount %s on region %s already exists", sa.ID, sa.RegionHost)
+	}
+
+	// Adding a subaccount issues its certificate and opens the tunnel.
+	sa.renewCertificate()
+	sa.connect()
+
+	s.state.Subaccounts = append(s.state.Subaccounts, sa)
+	return sa, nil
+}
+
+func (s *Server) getSubaccount(w http.ResponseWriter, r *http.Request) {
+	sa, err := s.state.findSubaccount(r.PathValue("regionHost"), r.PathValue("subaccount"))
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, sa.apiObject())
+}
+
+func (s *Server) updateSubaccount(w http.ResponseWriter, r *http.Request) {
+	sa, err := s.state.findSubaccount(r.PathValue("regionHost"), r.PathValue("subaccount"))
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	if body.has("isManaged") {
+		isManaged, err := body.bool("isManaged")
+		if err != nil {
+			writeResult(w, 0, nil, err)
+			return
+		}
+		if isManaged != sa.IsManaged {
+			writeResult(w, 0, nil, badRequest("Property 'isManaged' cannot be changed"))
+			return
+		}
+	}
+
+	if body.has("autoCertRenewal") {
+		if sa.AutoCertificateRenewal, err = body.bool("autoCertRenewal"); err != nil {
+			writeResult(w, 0, nil, err)
+			return
+		}
+	}
+
+	sa.LocationID = body.string("locationID")
+	sa.DisplayName = body.string("displayName")
+	sa.Description = body.string("description")
+
+	writeJSON(w, http.StatusOK, sa.apiObject())
+}
+
+func (s *Server) deleteSubaccount(w http.ResponseWriter, r *http.Request) {
+	sa, err := s.state.findSubaccount(r.PathValue("regionHost"), r.PathValue("subaccount"))
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	for i, candidate := range s.state.Subaccounts {
+		if candidate == sa {
+			s.state.Subaccounts = append(s.state.Subaccounts[:i], s.state.Subaccounts[i+1:]...)
+			break
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) updateTunnelState(w http.ResponseWriter, r *http.Request) {
+	sa, err := s.state.findSubaccount(r.PathValue("regionHost"), r.PathValue("subaccount"))
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	if !body.has("connected") {
+		writeResult(w, 0, nil, badRequest("Missing mandatory property 'connected'"))
+		return
+	}
+
+	connected, err := body.bool("connected")
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	if connected {
+		sa.connect()
+	} else {
+		sa.disconnect()
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) renewSubaccountCertificate(w http.ResponseWriter, r *http.Request) {
+	sa, err := s.state.findSubaccount(r.PathValue("regionHost"), r.PathValue("subaccount"))
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	if _, err := body.requiredString("user"); err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+	if _, err := body.requiredString("password"); err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	sa.renewCertificate()
+	writeJSON(w, http.StatusOK, sa.apiObject())
+}
+
+func (s *Server) syncTrustConfiguration(w http.ResponseWriter, r *http.Request) {
+	sa, err := s.state.findSubaccount(r.PathValue("regionHost"), r.PathValue("subaccount"))
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	if sa.Tunnel.State != TunnelConnected {
+		writeResult(w, 0, nil, conflict("The trust configuration of subaccount %s cannot be synchronized while it is not connected", sa.ID))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) updateTrustConfiguration(w http.ResponseWriter, r *http.Request) {
+	sa, err := s.state.findSubaccount(r.PathValue("regionHost"), r.PathValue("subaccount"))
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	if sa.AutoTrustSync, err = body.bool("autoSyncTrustEnabled"); err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getTrustConfiguration(w http.ResponseWriter, r *http.Request) {
+	sa, err := s.state.findSubaccount(r.PathValue("regionHost"), r.PathValue("subaccount"))
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, apiobjects.SubaccountTrustConfiguration{
+		AutoSyncTrustEnabled: sa.AutoTrustSync,
+		IdentityProviders:    append([]apiobjects.SubaccountTrusted{}, sa.IdentityProviders...),
+		Applications:         append([]apiobjects.SubaccountTrusted{}, sa.Applications...),
+	})
+}
+
+func (s *Server) updateTrustedIdentityProviders(w http.ResponseWriter, r *http.Request) {
+	sa, err := s.state.findSubaccount(r.PathValue("regionHost"), r.PathValue("subaccount"))
+	if err == nil {
+		err = updateTrusted(r, sa.IdentityProviders, "identity provider")
+	}
+	writeResult(w, 0, nil, err)
+}
+
+func (s *Server) updateTrustedApplications(w http.ResponseWriter, r *http.Request) {
+	sa, err := s.state.findSubaccount(r.PathValue("regionHost"), r.PathValue("subaccount"))
+	if err == nil {
+		err = updateTrusted(r, sa.Applications, "application")
+	}
+	writeResult(w, 0, nil, err)
+}
+
+// updateTrusted sets whether the synchronized entities listed in the request
+// body are trusted. Entities that were not synchronized are rejected.
+func updateTrusted(r *http.Request, entities []apiobjects.SubaccountTrusted, kind string) error {
+	var request []apiobjects.SubaccountTrusted
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		return badRequest("Invalid JSON request body: %v", err)
+	}
+
+	for _, entry := range request {
+		i := slices.IndexFunc(entities, func(entity apiobjects.SubaccountTrusted) bool { return entity.Name == entry.Name })
+		if i < 0 {
+			return badRequest("Unknown %s %s", kind, entry.Name)
+		}
+	}
+	for _, entry := range request {
+		i := slices.IndexFunc(entities, func(entity apiobjects.SubaccountTrusted) bool { return entity.Name == entry.Name })
+		entities[i].Trusted = entry.Trusted
+	}
+	return nil
+}
+
+func (sa *Subaccount) apiObject() apiobjects.Subaccount {
+	return apiobjects.Subaccount{
+		RegionHost:             sa.RegionHost,
+		Subaccount:             sa.ID,
+		LocationID:             sa.LocationID,
+		DisplayName:            sa.DisplayName,
+		Description:            sa.Description,
+		AutoCertificateRenewal: &sa.AutoCertificateRenewal,
+		IsManaged:              &sa.IsManaged,
+		Tunnel:                 sa.tunnel(),
+	}
+}
+
+// tunnel returns the tunnel details including the state of the service
+// channels of the subaccount.
+func (sa *Subaccount) tunnel() apiobjects.SubaccountTunnel {
+	tunnel := sa.Tunnel
+	tunnel.ApplicationConnections = []apiobjects.SubaccountApplicationConnections{}
+	tunnel.ServiceChannels = []apiobjects.SubaccountServiceChannels{}
+
+	for _, c := range sa.ServiceChannels {
+		state := "Disabled"
+		if c.Enabled {
+			state = "Enabled"
+		}
+		tunnel.ServiceChannels = append(tunnel.ServiceChannels, apiobjects.SubaccountServiceChannels{
+			Type:    c.Type,
+			State:   state,
+			Details: fmt.Sprintf("%s:%d", c.Host, c.Port),
+			Comment: c.Comment,
+		})
+	}
+
+	return tunnel
+}
+
+func (sa *Subaccount) connect() {
+	if sa.ConnectFailures > 0 {
+		sa.ConnectFailures--
+		sa.Tunnel.State = TunnelConnectFailure
+		sa.Tunnel.ConnectedSinceTimeStamp = 0
+		return
+	}
+
+	if sa.Tunnel.State != TunnelConnected {
+		sa.Tunnel.ConnectedSinceTimeStamp = time.Now().UnixMilli()
+	}
+	sa.Tunnel.State = TunnelConnected
+	sa.Tunnel.User = sa.CloudUser
+	sa.Tunnel.Connections = 1
+}
+
+func (sa *Subaccount) disconnect() {
+	sa.Tunnel.State = TunnelDisconnected
+	sa.Tunnel.ConnectedSinceTimeStamp = 0
+	sa.Tunnel.Connections = 0
+}
+
+func (sa *Subaccount) renewCertificate() {
+	now := time.Now()
+	sa.Tunnel.SubaccountCertificate = apiobjects.SubaccountCertificate{
+		NotBeforeTimeStamp: now.UnixMilli(),
+		NotAfterTimeStamp:  now.AddDate(1, 0, 0).UnixMilli(),
+		SubjectDN:          fmt.Sprintf("CN=%s,L=%s,OU=SAP Cloud Connector,O=SAP SE,C=DE", sa.ID, sa.RegionHost),
+		Issuer:             "CN=SAP Cloud Platform Client CA,OU=SAP Cloud Platform Clients,O=SAP SE,L=Walldorf,C=DE",
+		SerialNumber:       randomSerialNumber(),
+	}
+}
+
+// randomSerialNumber returns a random certificate serial number in the colon
+// separated hex format reported by the Cloud Connector.
+func randomSerialNumber() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return formatSerialNumber(new(big.Int).SetBytes(b))
+}
@@ -0,0 +1,155 @@
+package sccmock
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+)
+
+const subjectPatternRulesPath = "/api/v1/configuration/connector/onPremises/subjectPatternRules"
+
+func (s *Server) subjectPatternRuleRoutes(mux *http.ServeMux) {
+	// The subject pattern rules are only served below onPremises.
+	mux.HandleFunc("GET "+subjectPatternRulesPath, s.since(api.VersionOnPremisesPath, s.listSubjectPatternRules))
+	mux.HandleFunc("POST "+subjectPatternRulesPath, s.since(api.VersionOnPremisesPath, s.createSubjectPatternRule))
+	mux.HandleFunc("PUT "+subjectPatternRulesPath+"/{index}", s.since(api.VersionOnPremisesPath, s.updateSubjectPatternRule))
+	mux.HandleFunc("DELETE "+subjectPatternRulesPath+"/{index}", s.since(api.VersionOnPremisesPath, s.deleteSubjectPatternRule))
+}
+
+func (s *Server) listSubjectPatternRules(w http.ResponseWriter, r *http.Request) {
+	rules := apiobjects.SubjectPatternRules{}
+	for _, rule := range s.state.SubjectPatternRules {
+		rules = append(rules, apiobjects.SubjectPatternRule{
+			Description:    rule.Description,
+			Condition:      rule.Condition,
+			SubjectPattern: rule.SubjectPattern,
+		})
+	}
+	writeJSON(w, http.StatusOK, rules)
+}
+
+func (s *Server) createSubjectPatternRule(w http.ResponseWriter, r *http.Request) {
+	rule, err := decodeSubjectPatternRule(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	s.state.SubjectPatternRules = append(s.state.SubjectPatternRules, rule)
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) updateSubjectPatternRule(w http.ResponseWriter, r *http.Request) {
+	index, err := s.subjectPatternRuleIndex(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	rule, err := decodeSubjectPatternRule(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	s.state.SubjectPatternRules[index] = rule
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteSubjectPatternRule(w http.ResponseWriter, r *http.Request) {
+	index, err := s.subjectPatternRuleIndex(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	s.state.SubjectPatternRules = append(s.state.SubjectPatternRules[:index], s.state.SubjectPatternRules[index+1:]...)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) subjectPatternRuleIndex(r *http.Request) (int, error) {
+	index, err := strconv.Atoi(r.PathValue("index"))
+	if err != nil {
+		return 0, badRequest("Invalid subject pattern rule index %q", r.PathValue("index"))
+	}
+	if index < 0 || index >= len(s.state.SubjectPatternRules) {
+		return 0, notFound("Subject pattern rule %d not found", index)
+	}
+	return index, nil
+}
+
+func decodeSubjectPatternRule(r *http.Request) (*SubjectPatternRule, error) {
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	condition, _ := body["condition"].(map[string]any)
+	if condition == nil {
+		return nil, badRequest("Missing mandatory property 'condition'")
+	}
+
+	conditionText, err := formatCondition(requestBody(condition))
+	if err != nil {
+		return nil, err
+	}
+
+	pattern, _ := body["subjectPattern"].(map[string]any)
+	subjectPattern := apiobjects.SubjectPattern{
+		CommonName:       requestBody(pattern).string("CN"),
+		Email:            requestBody(pattern).string("EMAIL"),
+		Locality:         requestBody(pattern).string("L"),
+		OrganizationUnit: requestBody(pattern).string("OU"),
+		Organization:     requestBody(pattern).string("O"),
+		State:            requestBody(pattern).string("ST"),
+		Country:          requestBody(pattern).string("C"),
+	}
+	if subjectPattern == (apiobjects.SubjectPattern{}) {
+		return nil, badRequest("The subject pattern must contain at least one attribute")
+	}
+
+	return &SubjectPatternRule{
+		Description:    body.string("description"),
+		Condition:      conditionText,
+		SubjectPattern: subjectPattern,
+	}, nil
+}
+
+// formatCondition renders a condition in the textual form reported by the
+// Cloud Connector.
+func formatCondition(condition requestBody) (string, error) {
+	operator := condition.string("operator")
+	if operator == "always_true" {
+		return "always true", nil
+	}
+
+	variable, err := condition.requiredString("variable")
+	if err != nil {
+		return "", err
+	}
+	value := condition.string("value")
+
+	if variable == "user_type" && value != "Business" && value != "Technical" {
+		return "", badRequest("Invalid value %q for variable user_type, expected Business or Technical", value)
+	}
+
+	switch operator {
+	case "is":
+		return fmt.Sprintf("%s is %s", variable, value), nil
+	case "is_not":
+		return fmt.Sprintf("%s is not %s", variable, value), nil
+	case "exist", "exist_not":
+		if value != "" {
+			return "", badRequest("Operator %s does not accept a value", operator)
+		}
+		if operator == "exist" {
+			return variable + " exists", nil
+		}
+		return variable + " does not exist", nil
+	default:
+		return "", oneOf("operator", operator, "is", "is_not", "exist", "exist_not", "always_true")
+	}
+}
@@ -0,0 +1,444 @@
+package sccmock
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+)
+
+func (s *Server) systemMappingRoutes(mux *http.ServeMux) {
+	base := subaccountsPath + "/{regionHost}/{subaccount}/systemMappings"
+	item := base + "/{mapping}"
+
+	mux.HandleFunc("GET "+base, s.listSystemMappings)
+	mux.HandleFunc("POST "+base, s.createSystemMapping)
+	mux.HandleFunc("GET "+item, s.getSystemMapping)
+	mux.HandleFunc("PUT "+item, s.updateSystemMapping)
+	mux.HandleFunc("DELETE "+item, s.deleteSystemMapping)
+
+	mux.HandleFunc("GET "+item+"/resources", s.listSystemMappingResources)
+	mux.HandleFunc("POST "+item+"/resources", s.createSystemMappingResource)
+	mux.HandleFunc("GET "+item+"/resources/{resource}", s.getSystemMappingResource)
+	mux.HandleFunc("PUT "+item+"/resources/{resource}", s.updateSystemMappingResource)
+	mux.HandleFunc("DELETE "+item+"/resources/{resource}", s.deleteSystemMappingResource)
+}
+
+func (s *Server) listSystemMappings(w http.ResponseWriter, r *http.Request) {
+	sa, err := s.state.findSubaccount(r.PathValue("regionHost"), r.PathValue("subaccount"))
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	mappings := []apiobjects.SystemMapping{}
+	for _, m := range sa.SystemMappings {
+		mappings = append(mappings, m.apiObject())
+	}
+	writeJSON(w, http.StatusOK, mappings)
+}
+
+func (s *Server) createSystemMapping(w http.ResponseWriter, r *http.Request) {
+	sa, err := s.state.findSubaccount(r.PathValue("regionHost"), r.PathValue("subaccount"))
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	mapping := &SystemMapping{}
+	mapping.CreationDate = fmt.Sprint(time.Now().UnixMilli())
+
+	for _, field := range []struct {
+		key    string
+		target *string
+	}{
+		{"virtualHost", &mapping.VirtualHost},
+		{"virtualPort", &mapping.VirtualPort},
+		{"localHost", &mapping.InternalHost},
+		{"localPort", &mapping.InternalPort},
+	} {
+		if *field.target, err = body.requiredString(field.key); err != nil {
+			writeResult(w, 0, nil, err)
+			return
+		}
+	}
+
+	if sa.SystemMapping(mapping.VirtualHost, mapping.VirtualPort) != nil {
+		writeResult(w, 0, nil, conflict("System mapping %s:%s already exists", mapping.VirtualHost, mapping.VirtualPort))
+		return
+	}
+
+	if err := mapping.apply(body); err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	sa.SystemMappings = append(sa.SystemMappings, mapping)
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) getSystemMapping(w http.ResponseWriter, r *http.Request) {
+	_, mapping, err := s.findSystemMapping(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, mapping.apiObject())
+}
+
+func (s *Server) updateSystemMapping(w http.ResponseWriter, r *http.Request) {
+	_, mapping, err := s.findSystemMapping(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	if host := body.string("virtualHost"); host != "" && host != mapping.VirtualHost {
+		writeResult(w, 0, nil, badRequest("Property 'virtualHost' cannot be changed"))
+		return
+	}
+	if port := body.string("virtualPort"); port != "" && port != mapping.VirtualPort {
+		writeResult(w, 0, nil, badRequest("Property 'virtualPort' cannot be changed"))
+		return
+	}
+
+	updated := *mapping
+	if body.has("localHost") {
+		if updated.InternalHost, err = body.requiredString("localHost"); err != nil {
+			writeResult(w, 0, nil, err)
+			return
+		}
+	}
+	if body.has("localPort") {
+		if updated.InternalPort, err = body.requiredString("localPort"); err != nil {
+			writeResult(w, 0, nil, err)
+			return
+		}
+	}
+
+	if err := updated.apply(body); err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	*mapping = updated
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteSystemMapping(w http.ResponseWriter, r *http.Request) {
+	sa, mapping, err := s.findSystemMapping(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	for i, candidate := range sa.SystemMappings {
+		if candidate == mapping {
+			sa.SystemMappings = append(sa.SystemMappings[:i], sa.SystemMappings[i+1:]...)
+			break
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listSystemMappingResources(w http.ResponseWriter, r *http.Request) {
+	_, mapping, err := s.findSystemMapping(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	resources := []apiobjects.SystemMappingResource{}
+	for _, res := range mapping.Resources {
+		resources = append(resources, *res)
+	}
+	writeJSON(w, http.StatusOK, resources)
+}
+
+func (s *Server) createSystemMappingResource(w http.ResponseWriter, r *http.Request) {
+	_, mapping, err := s.findSystemMapping(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	id, err := body.requiredString("id")
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	if mapping.Resource(id) != nil {
+		writeResult(w, 0, nil, conflict("Resource %s already exists for system mapping %s:%s", id, mapping.VirtualHost, mapping.VirtualPort))
+		return
+	}
+
+	res := &apiobjects.SystemMappingResource{
+		URLPath:      id,
+		CreationDate: fmt.Sprint(time.Now().UnixMilli()),
+	}
+	if err := applySystemMappingResource(res, body); err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	mapping.Resources = append(mapping.Resources, res)
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *Server) getSystemMappingResource(w http.ResponseWriter, r *http.Request) {
+	_, res, err := s.findSystemMappingResource(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Server) updateSystemMappingResource(w http.ResponseWriter, r *http.Request) {
+	_, res, err := s.findSystemMappingResource(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	updated := *res
+	if err := applySystemMappingResource(&updated, body); err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	*res = updated
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteSystemMappingResource(w http.ResponseWriter, r *http.Request) {
+	mapping, res, err := s.findSystemMappingResource(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	for i, candidate := range mapping.Resources {
+		if candidate == res {
+			mapping.Resources = append(mapping.Resources[:i], mapping.Resources[i+1:]...)
+			break
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) findSystemMapping(r *http.Request) (*Subaccount, *SystemMapping, error) {
+	sa, err := s.state.findSubaccount(r.PathValue("regionHost"), r.PathValue("subaccount"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The system mapping is addressed as <virtual host>:<virtual port>.
+	key := r.PathValue("mapping")
+	separator := strings.LastIndex(key, ":")
+	if separator < 0 {
+		return nil, nil, badRequest("Invalid system mapping identifier %q, expected <virtual host>:<virtual port>", key)
+	}
+
+	mapping := sa.SystemMapping(key[:separator], key[separator+1:])
+	if mapping == nil {
+		return nil, nil, notFound("System mapping %s not found", key)
+	}
+	return sa, mapping, nil
+}
+
+func (s *Server) findSystemMappingResource(r *http.Request) (*SystemMapping, *apiobjects.SystemMappingResource, error) {
+	_, mapping, err := s.findSystemMapping(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	id := decodeResourceID(r.PathValue("resource"))
+	res := mapping.Resource(id)
+	if res == nil {
+		return nil, nil, notFound("Resource %s not found for system mapping %s:%s", id, mapping.VirtualHost, mapping.VirtualPort)
+	}
+	return mapping, res, nil
+}
+
+// Resource returns the resource with the given URL path, or nil.
+func (m *SystemMapping) Resource(id string) *apiobjects.SystemMappingResource {
+	for _, res := range m.Resources {
+		if res.URLPath == id {
+			return res
+		}
+	}
+	return nil
+}
+
+// apply validates and applies the optional properties of a system mapping
+// request body.
+func (m *SystemMapping) apply(body requestBody) error {
+	var err error
+
+	if m.Protocol, err = body.requiredString("protocol"); err != nil {
+		return err
+	}
+	if err := oneOf("protocol", m.Protocol, "HTTP", "HTTPS", "RFC", "RFCS", "RFCWS", "LDAP", "LDAPS", "TCP", "TCPS"); err != nil {
+		return err
+	}
+
+	if m.BackendType, err = body.requiredString("backendType"); err != nil {
+		return err
+	}
+	if err := oneOf("backendType", m.BackendType, "abapSys", "netweaverCE", "netweaverGW", "applServerJava", "PI", "hana", "otherSAPsys", "nonSAPsys"); err != nil {
+		return err
+	}
+
+	if m.AuthenticationMode, err = body.requiredString("authenticationMode"); err != nil {
+		return err
+	}
+	if err := oneOf("authenticationMode", m.AuthenticationMode, "NONE", "NONE_RESTRICTED", "X509_GENERAL", "X509_RESTRICTED", "KERBEROS"); err != nil {
+		return err
+	}
+
+	isHTTP := m.Protocol == "HTTP" || m.Protocol == "HTTPS"
+	isRFC := m.Protocol == "RFC" || m.Protocol == "RFCS" || m.Protocol == "RFCWS"
+
+	m.HostInHeader = ""
+	if isHTTP {
+		m.HostInHeader = "VIRTUAL"
+	}
+	if body.string("hostInHeader") != "" {
+		if !isHTTP {
+			return badRequest("Property 'hostInHeader' is only supported for the protocols HTTP and HTTPS")
+		}
+		hostInHeader := strings.ToUpper(body.string("hostInHeader"))
+		if err := oneOf("hostInHeader", hostInHeader, "INTERNAL", "VIRTUAL"); err != nil {
+			return err
+		}
+		m.HostInHeader = hostInHeader
+	}
+
+	if body.string("sncPartnerName") != "" && m.Protocol != "RFCS" {
+		return badRequest("Property 'sncPartnerName' is only supported for the protocol RFCS")
+	}
+	if body.string("sapRouter") != "" && m.Protocol != "RFC" && m.Protocol != "RFCS" {
+		return badRequest("Property 'sapRouter' is only supported for the protocols RFC and RFCS")
+	}
+
+	for key, target := range map[string]*string{
+		"description":    &m.Description,
+		"sid":            &m.Sid,
+		"sapRouter":      &m.SAPRouter,
+		"sncPartnerName": &m.SNCPartnerName,
+	} {
+		if body.has(key) {
+			*target = body.string(key)
+		}
+	}
+
+	if clients, ok := body["allowedClients"].([]any); ok {
+		if len(clients) > 0 && !isRFC {
+			return badRequest("Property 'allowedClients' is only supported for RFC based protocols")
+		}
+		m.AllowedClients = []string{}
+		for _, c := range clients {
+			client := fmt.Sprint(c)
+			if len(client) != 3 {
+				return badRequest("Invalid client %q, clients must consist of 3 characters", client)
+			}
+			m.AllowedClients = append(m.AllowedClients, client)
+		}
+	}
+
+	if users, ok := body["blacklistedUsers"].([]any); ok {
+		if len(users) > 0 && !isRFC {
+			return badRequest("Property 'blacklistedUsers' is only supported for RFC based protocols")
+		}
+		m.BlacklistedUsers = []apiobjects.BlacklistedUsers{}
+		for _, u := range users {
+			user, _ := u.(map[string]any)
+			entry := requestBody(user)
+			m.BlacklistedUsers = append(m.BlacklistedUsers, apiobjects.BlacklistedUsers{
+				Client: entry.string("client"),
+				User:   entry.string("user"),
+			})
+		}
+	}
+
+	return nil
+}
+
+func (m *SystemMapping) apiObject() apiobjects.SystemMapping {
+	mapping := m.SystemMapping
+	mapping.TotalResourcesCount = int64(len(m.Resources))
+	mapping.EnabledResourcesCount = 0
+	for _, res := range m.Resources {
+		if res.Enabled {
+			mapping.EnabledResourcesCount++
+		}
+	}
+	if mapping.AllowedClients == nil {
+		mapping.AllowedClients = []string{}
+	}
+	if mapping.BlacklistedUsers == nil {
+		mapping.BlacklistedUsers = []apiobjects.BlacklistedUsers{}
+	}
+	return mapping
+}
+
+func applySystemMappingResource(res *apiobjects.SystemMappingResource, body requestBody) error {
+	var err error
+
+	if body.has("enabled") {
+		if res.Enabled, err = body.bool("enabled"); err != nil {
+			return err
+		}
+	}
+	if body.has("exactMatchOnly") {
+		if res.PathOnly, err = body.bool("exactMatchOnly"); err != nil {
+			return err
+		}
+	}
+	if body.has("websocketUpgradeAllowed") {
+		if res.WebsocketUpgradeAllowed, err = body.bool("websocketUpgradeAllowed"); err != nil {
+			return err
+		}
+	}
+	if body.has("description") {
+		res.Description = body.string("description")
+	}
+
+	return nil
+}
+
+// decodeResourceID reverses the encoding of resource IDs in URI paths, where
+// '/' is replaced with '-', '-' with '+2D' and '+' with '+2B'.
+func decodeResourceID(encoded string) string {
+	decoded := strings.ReplaceAll(encoded, "-", "/")
+	decoded = strings.ReplaceAll(decoded, "+2D", "-")
+	return strings.ReplaceAll(decoded, "+2B", "+")
+}
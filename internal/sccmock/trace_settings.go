@@ -0,0 +1,75 @@
+package sccmock
+
+import (
+	"net/http"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+)
+
+const traceSettingsPath = "/api/v1/configuration/connector/trace"
+
+var traceLogLevels = []string{"ALL", "DEBUG", "INFO", "WARN", "ERROR", "OFF"}
+
+// Trace settings of a new installation.
+var defaultTraceSettings = apiobjects.TraceSettings{
+	CloudConnectorLogLevel: "INFO",
+	OtherLogLevel:          "INFO",
+}
+
+func (s *Server) traceSettingsRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+traceSettingsPath, s.getTraceSettings)
+	mux.HandleFunc("PUT "+traceSettingsPath, s.updateTraceSettings)
+}
+
+func (s *Server) getTraceSettings(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.state.TraceSettings)
+}
+
+func (s *Server) updateTraceSettings(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	settings := s.state.TraceSettings
+	if body.has("cloudConnectorLogLevel") {
+		settings.CloudConnectorLogLevel = body.string("cloudConnectorLogLevel")
+		if err := oneOf("cloudConnectorLogLevel", settings.CloudConnectorLogLevel, traceLogLevels...); err != nil {
+			writeResult(w, 0, nil, err)
+			return
+		}
+	}
+	if body.has("otherLogLevel") {
+		settings.OtherLogLevel = body.string("otherLogLevel")
+		if err := oneOf("otherLogLevel", settings.OtherLogLevel, traceLogLevels...); err != nil {
+			writeResult(w, 0, nil, err)
+			return
+		}
+	}
+	if body.has("cpicTraceLevel") {
+		if settings.CPICTraceLevel, err = body.int("cpicTraceLevel"); err != nil {
+			writeResult(w, 0, nil, err)
+			return
+		}
+		if settings.CPICTraceLevel < 0 || settings.CPICTraceLevel > 3 {
+			writeResult(w, 0, nil, badRequest("Invalid CPI-C trace level %d, the level must be between 0 and 3", settings.CPICTraceLevel))
+			return
+		}
+	}
+	if body.has("payloadTraceEnabled") {
+		if settings.PayloadTraceEnabled, err = body.bool("payloadTraceEnabled"); err != nil {
+			writeResult(w, 0, nil, err)
+			return
+		}
+	}
+	if body.has("fourEyesPrinciple") {
+		if settings.FourEyesPrinciple, err = body.bool("fourEyesPrinciple"); err != nil {
+			writeResult(w, 0, nil, err)
+			return
+		}
+	}
+
+	s.state.TraceSettings = settings
+	w.WriteHeader(http.StatusNoContent)
+}
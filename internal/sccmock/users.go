@@ -0,0 +1,142 @@
+package sccmock
+
+import (
+	"net/http"
+	"slices"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+)
+
+const usersPath = basicAuthenticationPath + "/users"
+
+func (s *Server) userRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+usersPath, s.listUsers)
+	mux.HandleFunc("POST "+usersPath, s.createUser)
+	mux.HandleFunc("GET "+usersPath+"/{user}", s.getUser)
+	mux.HandleFunc("PUT "+usersPath+"/{user}", s.updateUser)
+	mux.HandleFunc("DELETE "+usersPath+"/{user}", s.deleteUser)
+	mux.HandleFunc("PUT "+usersPath+"/{user}/roles", s.setUserRoles)
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	users := []apiobjects.User{}
+	for _, u := range s.state.Users {
+		users = append(users, userResponse(u))
+	}
+	writeJSON(w, http.StatusOK, users)
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	u, err := s.state.findUser(r.PathValue("user"))
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, userResponse(u))
+}
+
+// createUser creates a local user without roles.
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	u := &User{Password: body.string("password"), Description: body.string("description"), Roles: []string{}}
+	u.Name, err = body.requiredString("user")
+	if err == nil && u.Password == "" {
+		err = badRequest("Missing mandatory property 'password'")
+	}
+	if err == nil && s.state.User(u.Name) != nil {
+		err = conflict("User %s already exists", u.Name)
+	}
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	s.state.Users = append(s.state.Users, u)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// updateUser changes the description of a local user and its password, if
+// one is sent.
+func (s *Server) updateUser(w http.ResponseWriter, r *http.Request) {
+	u, err := s.state.findUser(r.PathValue("user"))
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	u.Description = body.string("description")
+	if password := body.string("password"); password != "" {
+		u.Password = password
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("user")
+
+	for i, u := range s.state.Users {
+		if u.Name == name {
+			s.state.Users = append(s.state.Users[:i], s.state.Users[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	writeResult(w, 0, nil, notFound("User %s not found", name))
+}
+
+// setUserRoles replaces the roles of a local user.
+func (s *Server) setUserRoles(w http.ResponseWriter, r *http.Request) {
+	u, err := s.state.findUser(r.PathValue("user"))
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		writeResult(w, 0, nil, err)
+		return
+	}
+
+	entries, ok := body["roles"].([]any)
+	if !ok {
+		writeResult(w, 0, nil, badRequest("Missing mandatory property 'roles'"))
+		return
+	}
+
+	roles := []string{}
+	for _, entry := range entries {
+		role, _ := entry.(string)
+		err := oneOf("roles", role, apiobjects.RoleAdministrator, apiobjects.RoleSubaccountAdministrator, apiobjects.RoleDisplay, apiobjects.RoleSupport, apiobjects.RoleMonitoring)
+		if err != nil {
+			writeResult(w, 0, nil, err)
+			return
+		}
+		if !slices.Contains(roles, role) {
+			roles = append(roles, role)
+		}
+	}
+
+	u.Roles = roles
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// userResponse renders a local user without its password.
+func userResponse(u *User) apiobjects.User {
+	return apiobjects.User{
+		User:        u.Name,
+		Description: u.Description,
+		Roles:       slices.Clone(u.Roles),
+	}
+}
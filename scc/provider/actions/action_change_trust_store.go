@@ -3,11 +3,8 @@ package actions
 import (
 	"context"
 	"fmt"
-	"io"
-	"net/http"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework/action"
@@ -42,6 +39,7 @@ __Tips:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/truststore-ca-certificates#change-truststore-configuration-(master-only)>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ActionInstanceAttribute(),
 			"trust_all_backends": schema.BoolAttribute{
 				MarkdownDescription: "Flag (boolean) indicating whether all backends are trusted (true), or only the backends represented by certificates are trusted (false)",
 				Required:            true,
@@ -78,49 +76,10 @@ func (a *ChangeTrustStoreAction) InvokeWithPlan(ctx context.Context, plan model.
 		return
 	}
 
-	endpoint := endpoints.GetBackendTrustStoreBaseEndpoint()
-	planBody := map[string]any{
-		"trustAllBackends": plan.TrustAllBackends.ValueBool(),
-	}
-
 	helpers.SafeProgress(resp, "Updating backend trust configuration...")
-	backendResponse, diags := helpers.SendRequestFunc(a.Client, planBody, endpoint, helpers.ActionPatchRequest)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	defer func() {
-		if err := backendResponse.Body.Close(); err != nil {
-			resp.Diagnostics.AddError(
-				"Failed to Close Response Body",
-				fmt.Sprintf("failed to close response body: %v", err),
-			)
-		}
-	}()
-
-	if backendResponse.StatusCode != http.StatusNoContent {
-		body, err := io.ReadAll(backendResponse.Body)
-		closeErr := backendResponse.Body.Close()
-		if err != nil {
-			resp.Diagnostics.AddError("Failed to Read Response Body", fmt.Sprintf("failed to read response body: %v", err))
-			return
-		}
-
-		if closeErr != nil {
-			resp.Diagnostics.AddError("Failed to Close Response Body", fmt.Sprintf("failed to close response body: %v", closeErr))
-			return
-		}
-
-		resp.Diagnostics.AddError(
-			"Failed to Change Backend Trust Configuration",
-			fmt.Sprintf(
-				"Expected HTTP %d but received %d.\nResponse: %s",
-				http.StatusNoContent,
-				backendResponse.StatusCode,
-				string(body),
-			),
-		)
+	err := a.Client.BackendTrustStore().SetTrustAllBackends(ctx, plan.TrustAllBackends.ValueBool())
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -135,5 +94,7 @@ func (a *ChangeTrustStoreAction) Invoke(ctx context.Context, req action.InvokeRe
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	a.InvokeWithPlan(ctx, plan, resp)
 }
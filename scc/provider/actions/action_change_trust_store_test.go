@@ -4,19 +4,20 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	"github.com/SAP/terraform-provider-scc/scc/provider/actions"
-	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework/action"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestChangeTrustStoreAction_Metadata(t *testing.T) {
@@ -73,64 +74,32 @@ func TestChangeTrustStoreAction_Configure_InvalidType(t *testing.T) {
 	assert.True(t, resp.Diagnostics.HasError())
 }
 
-func TestChangeTrustStoreAction_Invoke_Success_TrustAll(t *testing.T) {
-	a := &actions.ChangeTrustStoreAction{
-		Client: &api.RestApiClient{},
+func TestChangeTrustStoreAction_Invoke(t *testing.T) {
+	tests := []struct {
+		name      string
+		trustAll  bool
+		status    int
+		wantBody  string
+		wantError bool
+	}{
+		{name: "trust all backends", trustAll: true, status: http.StatusNoContent, wantBody: `{"trustAllBackends":true}`},
+		{name: "trust the allowlist", trustAll: false, status: http.StatusNoContent, wantBody: `{"trustAllBackends":false}`},
+		{name: "request fails", trustAll: true, status: http.StatusInternalServerError, wantBody: `{"trustAllBackends":true}`, wantError: true},
 	}
 
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	var capturedBody map[string]any
-
-	helpers.SendRequestFunc = func(
-		client *api.RestApiClient,
-		body map[string]any,
-		endpoint string,
-		actionType string,
-	) (*http.Response, diag.Diagnostics) {
-		capturedBody = body
-		return &http.Response{
-			StatusCode: http.StatusNoContent,
-			Body:       io.NopCloser(strings.NewReader("")),
-		}, nil
-	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, patches := newTrustStoreTestClient(t, tt.status)
+			a := &actions.ChangeTrustStoreAction{Client: client}
 
-	resp := newTestResp()
-	a.InvokeWithPlan(context.Background(), trustStorePlan(true), resp)
-
-	assert.False(t, resp.Diagnostics.HasError())
-	assert.Equal(t, true, capturedBody["trustAllBackends"])
-}
+			resp := newTestResp()
+			a.InvokeWithPlan(context.Background(), trustStorePlan(tt.trustAll), resp)
 
-func TestChangeTrustStoreAction_Invoke_Success_TrustSpecific(t *testing.T) {
-	a := &actions.ChangeTrustStoreAction{
-		Client: &api.RestApiClient{},
-	}
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	var capturedBody map[string]any
-
-	helpers.SendRequestFunc = func(
-		client *api.RestApiClient,
-		body map[string]any,
-		endpoint string,
-		actionType string,
-	) (*http.Response, diag.Diagnostics) {
-		capturedBody = body
-		return &http.Response{
-			StatusCode: http.StatusNoContent,
-			Body:       io.NopCloser(strings.NewReader("")),
-		}, nil
+			assert.Equal(t, tt.wantError, resp.Diagnostics.HasError(), resp.Diagnostics)
+			require.NotEmpty(t, *patches)
+			assert.JSONEq(t, tt.wantBody, (*patches)[0])
+		})
 	}
-
-	resp := newTestResp()
-	a.InvokeWithPlan(context.Background(), trustStorePlan(false), resp)
-
-	assert.False(t, resp.Diagnostics.HasError())
-	assert.Equal(t, false, capturedBody["trustAllBackends"])
 }
 
 func TestChangeTrustStoreAction_Invoke_NullTrustAllBackends(t *testing.T) {
@@ -165,84 +134,28 @@ func TestChangeTrustStoreAction_Invoke_UnknownTrustAllBackends(t *testing.T) {
 	assert.True(t, resp.Diagnostics.HasError())
 }
 
-func TestChangeTrustStoreAction_Invoke_SendRequestError(t *testing.T) {
-	a := &actions.ChangeTrustStoreAction{
-		Client: &api.RestApiClient{},
-	}
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	helpers.SendRequestFunc = func(
-		client *api.RestApiClient,
-		body map[string]any,
-		endpoint string,
-		actionType string,
-	) (*http.Response, diag.Diagnostics) {
-		var d diag.Diagnostics
-		d.AddError("Request Failed", "simulated network error")
-		return nil, d
-	}
-
-	resp := newTestResp()
-	a.InvokeWithPlan(context.Background(), trustStorePlan(true), resp)
-
-	assert.True(t, resp.Diagnostics.HasError())
-}
-
-func TestChangeTrustStoreAction_Invoke_UnexpectedStatusCode(t *testing.T) {
-	a := &actions.ChangeTrustStoreAction{
-		Client: &api.RestApiClient{},
-	}
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	helpers.SendRequestFunc = func(
-		client *api.RestApiClient,
-		body map[string]any,
-		endpoint string,
-		actionType string,
-	) (*http.Response, diag.Diagnostics) {
-		return &http.Response{
-			StatusCode: http.StatusInternalServerError,
-			Body:       io.NopCloser(strings.NewReader(`{"error":"internal server error"}`)),
-		}, nil
-	}
-
-	resp := newTestResp()
-	a.InvokeWithPlan(context.Background(), trustStorePlan(true), resp)
-
-	assert.True(t, resp.Diagnostics.HasError())
-}
-
-func TestChangeTrustStoreAction_Invoke_UsesCorrectActionType(t *testing.T) {
-	a := &actions.ChangeTrustStoreAction{
-		Client: &api.RestApiClient{},
-	}
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	var capturedActionType string
-
-	helpers.SendRequestFunc = func(
-		client *api.RestApiClient,
-		body map[string]any,
-		endpoint string,
-		actionType string,
-	) (*http.Response, diag.Diagnostics) {
-		capturedActionType = actionType
-		return &http.Response{
-			StatusCode: http.StatusNoContent,
-			Body:       io.NopCloser(strings.NewReader("")),
-		}, nil
-	}
-
-	resp := newTestResp()
-	a.InvokeWithPlan(context.Background(), trustStorePlan(true), resp)
-
-	assert.Equal(t, helpers.ActionPatchRequest, capturedActionType)
+// newTrustStoreTestClient returns a client for a server that answers the
+// PATCH requests to the trust store with status and records their bodies.
+// All other requests, such as the version lookup, are answered with 404.
+func newTrustStoreTestClient(t *testing.T, status int) (*api.RestApiClient, *[]string) {
+	t.Helper()
+
+	var patches []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPatch || !strings.HasSuffix(req.URL.Path, "/truststore") {
+			http.NotFound(w, req)
+			return
+		}
+		body, _ := io.ReadAll(req.Body)
+		patches = append(patches, string(body))
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+
+	baseURL, _ := url.Parse(srv.URL)
+	client := &api.RestApiClient{BaseURL: baseURL, Client: srv.Client()}
+	client.Retry.MaxRetries = 0
+	return client, &patches
 }
 
 func trustStorePlan(trustAll bool) model.BackendTrustStoreActionConfig {
@@ -270,24 +183,15 @@ func TestChangeTrustStoreAction_Schema(t *testing.T) {
 
 func TestChangeTrustStoreAction_Invoke_TopLevel(t *testing.T) {
 	a := actions.NewChangeTrustStoreAction().(*actions.ChangeTrustStoreAction)
-	a.Client = &api.RestApiClient{}
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	helpers.SendRequestFunc = func(client *api.RestApiClient, body map[string]any, endpoint string, actionType string) (*http.Response, diag.Diagnostics) {
-		return &http.Response{
-			StatusCode: http.StatusNoContent,
-			Body:       io.NopCloser(strings.NewReader("")),
-		}, nil
-	}
+	a.Client, _ = newTrustStoreTestClient(t, http.StatusNoContent)
 
 	schemaResp := &action.SchemaResponse{}
 	a.Schema(context.Background(), action.SchemaRequest{}, schemaResp)
 
 	raw := tftypes.NewValue(
-		tftypes.Object{AttributeTypes: map[string]tftypes.Type{"trust_all_backends": tftypes.Bool}},
+		tftypes.Object{AttributeTypes: map[string]tftypes.Type{"instance": tftypes.String, "trust_all_backends": tftypes.Bool}},
 		map[string]tftypes.Value{
+			"instance":           tftypes.NewValue(tftypes.String, nil),
 			"trust_all_backends": tftypes.NewValue(tftypes.Bool, true),
 		},
 	)
@@ -2,22 +2,36 @@ package actions
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	defaultBackupFileName = "scc_backup_" + helpers.BackupTimestampPlaceholder + ".zip"
+	defaultBackupFileMode = "0600"
 )
 
 type CreateBackupAction struct {
 	Client *api.RestApiClient
+	// Sink receives the backup archive. Defaults to the local filesystem.
+	Sink helpers.BackupSink
 }
 
 var _ action.Action = &CreateBackupAction{}
@@ -32,12 +46,44 @@ func (a *CreateBackupAction) Metadata(ctx context.Context, req action.MetadataRe
 
 func (a *CreateBackupAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Creates a backup of the cloud connector instance.",
+		MarkdownDescription: `Creates a backup of the cloud connector instance.
+
+The SHA-256 checksum of the written backup file is reported as a progress message, as actions cannot return values.`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ActionInstanceAttribute(),
 			"password": schema.StringAttribute{
 				MarkdownDescription: "The password used to protect the backup file. Action schema attributes cannot be marked as sensitive, so this value may be visible in Terraform configuration and logs. Use a strong password and handle it securely.",
 				Required:            true,
 			},
+			"output_directory": schema.StringAttribute{
+				MarkdownDescription: "The directory the backup file is written to. The directory is created if it does not exist. Defaults to the current working directory.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"file_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the backup file. The placeholder `{timestamp}` is replaced with the UTC creation time in the format `YYYYMMDD_hhmmss`. Defaults to `scc_backup_{timestamp}.zip`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^/\\]+$`), "must be a file name without a directory"),
+				},
+			},
+			"file_mode": schema.StringAttribute{
+				MarkdownDescription: "The permissions of the backup file in octal notation. Defaults to `0600`, so the file is only readable by its owner.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^0?[0-7]{3}$`), "must be an octal file mode, e.g. 0600"),
+				},
+			},
+			"keep_last_n": schema.Int64Attribute{
+				MarkdownDescription: "The number of backup files to keep in the output directory. After the backup was written, older backup files named after `file_name` are deleted, ordered by the creation time in place of `{timestamp}`. Files without a valid timestamp in the format `YYYYMMDD_hhmmss` are never deleted. If not set or if `file_name` does not contain `{timestamp}`, no files are deleted.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -70,64 +116,46 @@ func (a *CreateBackupAction) InvokeWithPlan(ctx context.Context, plan model.Back
 		return
 	}
 
-	endpoint := endpoints.GetBackupEndpoint()
-	planBody := map[string]any{
-		"password": plan.Password.ValueString(),
-	}
-
 	helpers.SafeProgress(resp, "Initiating backup creation...")
-	backupResponse, diags := helpers.SendRequestFunc(a.Client, planBody, endpoint, helpers.ActionCreateRequest)
+	backupBytes, diags := helpers.DownloadBackupFunc(ctx, a.Client, plan.Password.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if backupResponse == nil || backupResponse.Body == nil {
-		resp.Diagnostics.AddError("Invalid API Response", "Backup response body is nil")
-		return
+	fileName := defaultBackupFileName
+	if !plan.FileName.IsNull() && !plan.FileName.IsUnknown() {
+		fileName = plan.FileName.ValueString()
 	}
 
-	defer func() {
-		if err := backupResponse.Body.Close(); err != nil {
-			// log but don’t fail action
-			resp.Diagnostics.AddWarning(
-				"Failed to close response body",
-				err.Error(),
-			)
-		}
-	}()
-
-	backupBytes, err := io.ReadAll(backupResponse.Body)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to Read Backup Response",
-			fmt.Sprintf("An error occurred while reading the backup response body: %v", err),
-		)
-		return
+	outputDirectory := "."
+	if !plan.OutputDirectory.IsNull() && !plan.OutputDirectory.IsUnknown() {
+		outputDirectory = plan.OutputDirectory.ValueString()
 	}
 
-	contentType := backupResponse.Header.Get("Content-Type")
-	if contentType != "application/zip" {
-		resp.Diagnostics.AddError(
-			"Unexpected Content-Type",
-			fmt.Sprintf("Expected 'application/zip', but got '%s'. The backup response may be invalid.", contentType),
-		)
-		return
+	fileModeValue := defaultBackupFileMode
+	if !plan.FileMode.IsNull() && !plan.FileMode.IsUnknown() {
+		fileModeValue = plan.FileMode.ValueString()
 	}
 
-	if len(backupBytes) == 0 {
+	fileMode, err := strconv.ParseUint(fileModeValue, 8, 32)
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Empty Backup Response",
-			"The API response did not contain a valid backup archive.",
+			"Invalid File Mode",
+			fmt.Sprintf("The file mode %q is not a valid octal file mode: %v", fileModeValue, err),
 		)
 		return
 	}
 
-	fileName := fmt.Sprintf("scc_backup_%s.zip", time.Now().UTC().Format("20060102_150405"))
+	timestamp := time.Now().UTC().Format(helpers.BackupTimestampLayout)
+	filePath := filepath.Join(outputDirectory, strings.ReplaceAll(fileName, helpers.BackupTimestampPlaceholder, timestamp))
 
-	filePath := filepath.Join(".", fileName)
+	sink := a.Sink
+	if sink == nil {
+		sink = helpers.LocalBackupSink{}
+	}
 
-	err = os.WriteFile(filePath, backupBytes, 0644)
+	checksum, err := writeBackup(sink, filePath, os.FileMode(fileMode), backupBytes)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to Write Backup to File",
@@ -138,6 +166,37 @@ func (a *CreateBackupAction) InvokeWithPlan(ctx context.Context, plan model.Back
 
 	helpers.SafeProgress(resp, "Backup generated successfully")
 	helpers.SafeProgress(resp, fmt.Sprintf("Backup saved to %s", filePath))
+	helpers.SafeProgress(resp, fmt.Sprintf("Backup SHA-256 checksum: %s", checksum))
+
+	if !plan.KeepLastN.IsNull() && !plan.KeepLastN.IsUnknown() {
+		removed, diags := helpers.PruneBackups(sink, outputDirectory, fileName, int(plan.KeepLastN.ValueInt64()))
+		resp.Diagnostics.Append(diags...)
+		for _, file := range removed {
+			helpers.SafeProgress(resp, fmt.Sprintf("Removed old backup %s", file))
+		}
+	}
+}
+
+// writeBackup writes the backup archive to the sink and returns its hex encoded SHA-256 checksum.
+// A partially written file is removed again.
+func writeBackup(sink helpers.BackupSink, path string, mode os.FileMode, backup []byte) (string, error) {
+	w, err := sink.Create(path, mode)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	_, err = io.MultiWriter(w, hash).Write(backup)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = sink.Remove(path)
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
 func (a *CreateBackupAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
@@ -148,5 +207,7 @@ func (a *CreateBackupAction) Invoke(ctx context.Context, req action.InvokeReques
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	a.InvokeWithPlan(ctx, plan, resp)
 }
@@ -1,20 +1,23 @@
 package actions_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
-	"strings"
 	"testing"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	"github.com/SAP/terraform-provider-scc/scc/provider/actions"
-	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
 	"github.com/hashicorp/terraform-plugin-framework/action"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -62,27 +65,7 @@ func TestCreateBackupAction_Configure_InvalidType(t *testing.T) {
 
 func TestCreateBackupAction_Invoke_Success(t *testing.T) {
 	a := &actions.CreateBackupAction{
-		Client: &api.RestApiClient{},
-	}
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	zipBytes := []byte("PK\x03\x04test zip content")
-
-	helpers.SendRequestFunc = func(
-		client *api.RestApiClient,
-		body map[string]any,
-		endpoint string,
-		actionType string,
-	) (*http.Response, diag.Diagnostics) {
-		return &http.Response{
-			StatusCode: 200,
-			Header: http.Header{
-				"Content-Type": []string{"application/zip"},
-			},
-			Body: io.NopCloser(strings.NewReader(string(zipBytes))),
-		}, nil
+		Client: newBackupTestClient(t, "application/zip", "PK\x03\x04test zip content"),
 	}
 
 	resp := newTestResp()
@@ -115,23 +98,9 @@ func TestCreateBackupAction_Invoke_MissingPassword(t *testing.T) {
 	assert.True(t, resp.Diagnostics.HasError())
 }
 
-func TestCreateBackupAction_Invoke_NilResponseBody(t *testing.T) {
+func TestCreateBackupAction_Invoke_NoContent(t *testing.T) {
 	a := &actions.CreateBackupAction{
-		Client: &api.RestApiClient{},
-	}
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	helpers.SendRequestFunc = func(
-		client *api.RestApiClient,
-		body map[string]any,
-		endpoint string,
-		actionType string,
-	) (*http.Response, diag.Diagnostics) {
-		return &http.Response{
-			StatusCode: 200,
-		}, nil
+		Client: newBackupTestClient(t, "", ""),
 	}
 
 	resp := newTestResp()
@@ -143,25 +112,7 @@ func TestCreateBackupAction_Invoke_NilResponseBody(t *testing.T) {
 
 func TestCreateBackupAction_Invoke_EmptyBackup(t *testing.T) {
 	a := &actions.CreateBackupAction{
-		Client: &api.RestApiClient{},
-	}
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	helpers.SendRequestFunc = func(
-		client *api.RestApiClient,
-		body map[string]any,
-		endpoint string,
-		actionType string,
-	) (*http.Response, diag.Diagnostics) {
-		return &http.Response{
-			StatusCode: 200,
-			Header: http.Header{
-				"Content-Type": []string{"application/zip"},
-			},
-			Body: io.NopCloser(strings.NewReader("")),
-		}, nil
+		Client: newBackupTestClient(t, "application/zip", ""),
 	}
 
 	resp := newTestResp()
@@ -173,25 +124,7 @@ func TestCreateBackupAction_Invoke_EmptyBackup(t *testing.T) {
 
 func TestCreateBackupAction_Invoke_InvalidContentType(t *testing.T) {
 	a := &actions.CreateBackupAction{
-		Client: &api.RestApiClient{},
-	}
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	helpers.SendRequestFunc = func(
-		client *api.RestApiClient,
-		body map[string]any,
-		endpoint string,
-		actionType string,
-	) (*http.Response, diag.Diagnostics) {
-		return &http.Response{
-			StatusCode: 200,
-			Header: http.Header{
-				"Content-Type": []string{"application/json"},
-			},
-			Body: io.NopCloser(strings.NewReader(`{"error":"test"}`)),
-		}, nil
+		Client: newBackupTestClient(t, "application/json", `{"error":"test"}`),
 	}
 
 	resp := newTestResp()
@@ -203,7 +136,11 @@ func TestCreateBackupAction_Invoke_InvalidContentType(t *testing.T) {
 
 func testBackupPlan() model.BackupActionConfig {
 	return model.BackupActionConfig{
-		Password: types.StringValue("test-password"),
+		Password:        types.StringValue("test-password"),
+		OutputDirectory: types.StringNull(),
+		FileName:        types.StringNull(),
+		FileMode:        types.StringNull(),
+		KeepLastN:       types.Int64Null(),
 	}
 }
 
@@ -238,25 +175,28 @@ func TestCreateBackupAction_Configure_NilProviderData(t *testing.T) {
 
 func TestCreateBackupAction_Invoke_TopLevel(t *testing.T) {
 	a := actions.NewCreateBackupAction().(*actions.CreateBackupAction)
-	a.Client = &api.RestApiClient{}
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	helpers.SendRequestFunc = func(client *api.RestApiClient, body map[string]any, endpoint string, actionType string) (*http.Response, diag.Diagnostics) {
-		return &http.Response{
-			StatusCode: http.StatusOK,
-			Header:     http.Header{"Content-Type": []string{"application/zip"}},
-			Body:       io.NopCloser(strings.NewReader("PK\x03\x04zip-content")),
-		}, nil
-	}
+	a.Client = newBackupTestClient(t, "application/zip", "PK\x03\x04zip-content")
 
 	schemaResp := &action.SchemaResponse{}
 	a.Schema(context.Background(), action.SchemaRequest{}, schemaResp)
 
 	raw := tftypes.NewValue(
-		tftypes.Object{AttributeTypes: map[string]tftypes.Type{"password": tftypes.String}},
-		map[string]tftypes.Value{"password": tftypes.NewValue(tftypes.String, "mypassword")},
+		tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"instance":         tftypes.String,
+			"password":         tftypes.String,
+			"output_directory": tftypes.String,
+			"file_name":        tftypes.String,
+			"file_mode":        tftypes.String,
+			"keep_last_n":      tftypes.Number,
+		}},
+		map[string]tftypes.Value{
+			"instance":         tftypes.NewValue(tftypes.String, nil),
+			"password":         tftypes.NewValue(tftypes.String, "mypassword"),
+			"output_directory": tftypes.NewValue(tftypes.String, nil),
+			"file_name":        tftypes.NewValue(tftypes.String, nil),
+			"file_mode":        tftypes.NewValue(tftypes.String, nil),
+			"keep_last_n":      tftypes.NewValue(tftypes.Number, nil),
+		},
 	)
 	req := action.InvokeRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw}}
 	resp := newTestResp()
@@ -284,3 +224,144 @@ func TestCreateBackupAction_Invoke_ConfigGetError(t *testing.T) {
 
 	assert.True(t, resp.Diagnostics.HasError())
 }
+
+// memoryBackupSink is a test double for helpers.BackupSink that keeps all
+// backup files in memory.
+type memoryBackupSink struct {
+	files    map[string][]byte
+	modes    map[string]os.FileMode
+	failOpen bool
+}
+
+func newMemoryBackupSink(existing ...string) *memoryBackupSink {
+	s := &memoryBackupSink{files: map[string][]byte{}, modes: map[string]os.FileMode{}}
+	for _, path := range existing {
+		s.files[path] = []byte("old")
+	}
+	return s
+}
+
+type memoryBackupFile struct {
+	bytes.Buffer
+	sink *memoryBackupSink
+	path string
+}
+
+func (f *memoryBackupFile) Close() error {
+	f.sink.files[f.path] = f.Bytes()
+	return nil
+}
+
+func (s *memoryBackupSink) Create(path string, mode os.FileMode) (io.WriteCloser, error) {
+	if s.failOpen {
+		return nil, errors.New("disk full")
+	}
+	s.modes[path] = mode
+	return &memoryBackupFile{sink: s, path: path}, nil
+}
+
+func (s *memoryBackupSink) List(pattern string) ([]string, error) {
+	var matches []string
+	for path := range s.files {
+		if ok, _ := filepath.Match(pattern, path); ok {
+			matches = append(matches, path)
+		}
+	}
+	return matches, nil
+}
+
+func (s *memoryBackupSink) Remove(path string) error {
+	delete(s.files, path)
+	return nil
+}
+
+// newBackupTestClient returns a client for a Cloud Connector that answers the
+// backup request with content of the given content type.
+func newBackupTestClient(t *testing.T, contentType string, content string) *api.RestApiClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if contentType != "" {
+			w.Header().Set("Content-Type", contentType)
+		}
+		_, _ = w.Write([]byte(content))
+	}))
+	t.Cleanup(server.Close)
+
+	return tfutils.NewTestClient(t, server)
+}
+
+func TestCreateBackupAction_Invoke_Sink(t *testing.T) {
+	content := "PK\x03\x04zip-content"
+	sum := sha256.Sum256([]byte(content))
+
+	t.Run("defaults", func(t *testing.T) {
+		sink := newMemoryBackupSink()
+		a := &actions.CreateBackupAction{Client: newBackupTestClient(t, "application/zip", content), Sink: sink}
+
+		var progress []string
+		resp := newTestResp()
+		resp.SendProgress = func(event action.InvokeProgressEvent) { progress = append(progress, event.Message) }
+
+		a.InvokeWithPlan(context.Background(), testBackupPlan(), resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.Len(t, sink.files, 1)
+		for path, data := range sink.files {
+			assert.Regexp(t, `^scc_backup_\d{8}_\d{6}\.zip$`, path)
+			assert.Equal(t, content, string(data))
+			assert.Equal(t, os.FileMode(0600), sink.modes[path])
+		}
+		assert.Contains(t, progress, "Backup SHA-256 checksum: "+hex.EncodeToString(sum[:]))
+	})
+
+	t.Run("custom directory, name and mode", func(t *testing.T) {
+		sink := newMemoryBackupSink()
+		a := &actions.CreateBackupAction{Client: newBackupTestClient(t, "application/zip", content), Sink: sink}
+
+		plan := testBackupPlan()
+		plan.OutputDirectory = types.StringValue("backups")
+		plan.FileName = types.StringValue("connector.zip")
+		plan.FileMode = types.StringValue("0640")
+
+		resp := newTestResp()
+		a.InvokeWithPlan(context.Background(), plan, resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.Equal(t, content, string(sink.files[filepath.Join("backups", "connector.zip")]))
+		assert.Equal(t, os.FileMode(0640), sink.modes[filepath.Join("backups", "connector.zip")])
+	})
+
+	t.Run("keep last n", func(t *testing.T) {
+		sink := newMemoryBackupSink(
+			filepath.Join("backups", "scc_backup_20240101_000000.zip"),
+			filepath.Join("backups", "scc_backup_20240102_000000.zip"),
+			filepath.Join("backups", "scc_backup_20240103_000000.zip"),
+			filepath.Join("backups", "unrelated.zip"),
+		)
+		a := &actions.CreateBackupAction{Client: newBackupTestClient(t, "application/zip", content), Sink: sink}
+
+		plan := testBackupPlan()
+		plan.OutputDirectory = types.StringValue("backups")
+		plan.KeepLastN = types.Int64Value(2)
+
+		resp := newTestResp()
+		a.InvokeWithPlan(context.Background(), plan, resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.Len(t, sink.files, 3)
+		assert.Contains(t, sink.files, filepath.Join("backups", "scc_backup_20240103_000000.zip"))
+		assert.Contains(t, sink.files, filepath.Join("backups", "unrelated.zip"))
+	})
+
+	t.Run("sink failure", func(t *testing.T) {
+		sink := newMemoryBackupSink()
+		sink.failOpen = true
+		a := &actions.CreateBackupAction{Client: newBackupTestClient(t, "application/zip", content), Sink: sink}
+
+		resp := newTestResp()
+		a.InvokeWithPlan(context.Background(), testBackupPlan(), resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Failed to Write Backup to File", resp.Diagnostics.Errors()[0].Summary())
+	})
+}
@@ -3,14 +3,13 @@ package actions
 import (
 	"context"
 	"fmt"
-	"io"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -38,6 +37,7 @@ func (a *GenerateCSRAction) Schema(ctx context.Context, req action.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Generates a Certificate Signing Request (CSR) based on the type of Certificate.",
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ActionInstanceAttribute(),
 			"type": schema.StringAttribute{
 				MarkdownDescription: "The type of Certificate for which to generate the CSR.",
 				Required:            true,
@@ -190,11 +190,9 @@ func (a *GenerateCSRAction) InvokeWithPlan(ctx context.Context, plan model.CSRAc
 		return
 	}
 
-	subjectDN := helpers.BuildSubjectDNFunc(dnStruct)
-	planBody := map[string]any{
-		"type":      "csr",
-		"keySize":   plan.KeySize.ValueInt64(),
-		"subjectDN": subjectDN,
+	csrRequest := apiobjects.CertificateRequest{
+		KeySize:   plan.KeySize.ValueInt64(),
+		SubjectDN: helpers.BuildSubjectDNFunc(dnStruct),
 	}
 
 	if !plan.SubjectAlternativeNames.IsNull() &&
@@ -206,29 +204,24 @@ func (a *GenerateCSRAction) InvokeWithPlan(ctx context.Context, plan model.CSRAc
 			return
 		}
 
-		if len(sanList) > 0 {
-			sanFields := []map[string]string{}
-			for _, san := range sanList {
-				sanFields = append(sanFields, map[string]string{
-					"type":  san.Type.ValueString(),
-					"value": san.Value.ValueString(),
-				})
-			}
-
-			planBody["subjectAltNames"] = sanFields
+		for _, san := range sanList {
+			csrRequest.SubjectAltNames = append(csrRequest.SubjectAltNames, apiobjects.SubjectAltNames{
+				Type:  san.Type.ValueString(),
+				Value: san.Value.ValueString(),
+			})
 		}
 	}
 
 	certType := strings.ToLower(plan.Type.ValueString())
-	var endpoint string
+	var certificate *api.CertificateService
 
 	switch certType {
 	case "ca":
-		endpoint = endpoints.GetCACertificateEndpoint()
+		certificate = a.Client.CACertificate()
 	case "system":
-		endpoint = endpoints.GetSystemCertificateEndpoint()
+		certificate = a.Client.SystemCertificate()
 	case "ui":
-		endpoint = endpoints.GetUICertificateEndpoint()
+		certificate = a.Client.UICertificate()
 	default:
 		resp.Diagnostics.AddError(
 			"Invalid Certificate Type",
@@ -237,37 +230,14 @@ func (a *GenerateCSRAction) InvokeWithPlan(ctx context.Context, plan model.CSRAc
 		return
 	}
 
-	// Create CSR by calling the appropriate API endpoint based on the certificate type
-	csrResponse, diags := helpers.SendRequestFunc(a.Client, planBody, endpoint, helpers.ActionCreateRequest)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	if csrResponse == nil || csrResponse.Body == nil {
-		resp.Diagnostics.AddError("Invalid API Response", "CSR response body is nil")
-		return
-	}
-
-	csrBytes, err := io.ReadAll(csrResponse.Body)
-	defer func() {
-		if err := csrResponse.Body.Close(); err != nil {
-			// log but don’t fail action
-			resp.Diagnostics.AddWarning(
-				"Failed to close response body",
-				err.Error(),
-			)
-		}
-	}()
+	// Create CSR by calling the API endpoint of the certificate type
+	csrResponse, err := certificate.GenerateCSR(ctx, csrRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to Read CSR Response",
-			fmt.Sprintf("An error occurred while reading the CSR response body: %v", err),
-		)
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
-	csr := strings.TrimSpace(string(csrBytes))
+	csr := strings.TrimSpace(csrResponse)
 	if csr == "" {
 		resp.Diagnostics.AddError(
 			"Empty CSR Response",
@@ -299,5 +269,7 @@ func (a *GenerateCSRAction) Invoke(ctx context.Context, req action.InvokeRequest
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	a.InvokeWithPlan(ctx, plan, resp)
 }
@@ -5,13 +5,13 @@ import (
 	"io"
 	"math/big"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
-	"strings"
 	"testing"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	"github.com/SAP/terraform-provider-scc/scc/provider/actions"
-	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func newTestResp() *action.InvokeResponse {
@@ -67,56 +68,53 @@ func TestGenerateCSRAction_Configure_InvalidType(t *testing.T) {
 	assert.True(t, resp.Diagnostics.HasError())
 }
 
-func TestGenerateCSRAction_Invoke_Success(t *testing.T) {
-
-	a := &actions.GenerateCSRAction{
-		Client: &api.RestApiClient{},
+func TestGenerateCSRAction_Invoke(t *testing.T) {
+	tests := []struct {
+		certType string
+		path     string
+	}{
+		{certType: "ca", path: "/api/v1/configuration/connector/onPremise/ppCaCertificate"},
+		{certType: "system", path: "/api/v1/configuration/connector/onPremise/systemCertificate"},
+		{certType: "ui", path: "/api/v1/configuration/connector/ui/uiCertificate"},
 	}
 
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	helpers.SendRequestFunc = func(client *api.RestApiClient, body map[string]any, endpoint string, action string) (*http.Response, diag.Diagnostics) {
-		csr := "-----BEGIN CERTIFICATE REQUEST-----\nTEST\n-----END CERTIFICATE REQUEST-----"
-
-		return &http.Response{
-			StatusCode: 200,
-			Body:       io.NopCloser(strings.NewReader(csr)),
-		}, nil
-	}
+	for _, tt := range tests {
+		t.Run(tt.certType, func(t *testing.T) {
+			client, requests := newCSRTestClient(t, http.StatusOK, testCSR)
+			a := &actions.GenerateCSRAction{Client: client}
 
-	resp := newTestResp()
+			plan := testCSRPlan()
+			plan.Type = types.StringValue(tt.certType)
 
-	a.InvokeWithPlan(context.Background(), testCSRPlan(), resp)
+			resp := newTestResp()
+			a.InvokeWithPlan(context.Background(), plan, resp)
 
-	assert.False(t, resp.Diagnostics.HasError())
+			assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			require.Len(t, *requests, 1)
+			assert.Equal(t, tt.path, (*requests)[0].path)
+			assert.JSONEq(t, `{"type":"csr","keySize":2048,"subjectDN":"CN=example.com,O=SAP,C=IN"}`, (*requests)[0].body)
 
-	_, err := os.Stat("system_csr.pem")
-	assert.NoError(t, err)
+			csr, err := os.ReadFile(tt.certType + "_csr.pem")
+			assert.NoError(t, err)
+			assert.Equal(t, testCSR, string(csr))
 
-	if err := os.Remove("system_csr.pem"); err != nil && !os.IsNotExist(err) {
-		t.Fatalf("failed to remove test file: %v", err)
+			if err := os.Remove(tt.certType + "_csr.pem"); err != nil && !os.IsNotExist(err) {
+				t.Fatalf("failed to remove test file: %v", err)
+			}
+		})
 	}
 }
-func TestGenerateCSRAction_Invoke_EmptyCSR(t *testing.T) {
-	a := &actions.GenerateCSRAction{
-		Client: &api.RestApiClient{},
-	}
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
 
-	helpers.SendRequestFunc = func(client *api.RestApiClient, body map[string]any, endpoint string, action string) (*http.Response, diag.Diagnostics) {
-		return &http.Response{
-			Body: io.NopCloser(strings.NewReader("")),
-		}, nil
-	}
+func TestGenerateCSRAction_Invoke_EmptyCSR(t *testing.T) {
+	client, _ := newCSRTestClient(t, http.StatusOK, "")
+	a := &actions.GenerateCSRAction{Client: client}
 
 	resp := &action.InvokeResponse{}
 
 	a.InvokeWithPlan(context.Background(), testCSRPlan(), resp)
 
 	assert.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "Empty CSR Response")
 }
 
 func TestGenerateCSRAction_Invoke_InvalidType(t *testing.T) {
@@ -135,23 +133,8 @@ func TestGenerateCSRAction_Invoke_InvalidType(t *testing.T) {
 }
 
 func TestGenerateCSRAction_Invoke_WithSANs(t *testing.T) {
-	a := &actions.GenerateCSRAction{
-		Client: &api.RestApiClient{},
-	}
-
-	var capturedBody map[string]any
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	helpers.SendRequestFunc = func(client *api.RestApiClient, body map[string]any, endpoint string, action string) (*http.Response, diag.Diagnostics) {
-		capturedBody = body
-
-		return &http.Response{
-			StatusCode: 200,
-			Body:       io.NopCloser(strings.NewReader("-----BEGIN CERTIFICATE REQUEST-----\nTEST\n-----END CERTIFICATE REQUEST-----")),
-		}, nil
-	}
+	client, requests := newCSRTestClient(t, http.StatusOK, testCSR)
+	a := &actions.GenerateCSRAction{Client: client}
 
 	plan := testCSRPlan()
 
@@ -182,15 +165,8 @@ func TestGenerateCSRAction_Invoke_WithSANs(t *testing.T) {
 
 	assert.False(t, resp.Diagnostics.HasError())
 
-	sans, ok := capturedBody["subjectAltNames"]
-	assert.True(t, ok, "subjectAltNames key should exist")
-
-	sanList, ok := sans.([]map[string]string)
-	assert.True(t, ok, "subjectAltNames should be []map[string]string")
-
-	assert.Len(t, sanList, 1)
-	assert.Equal(t, "DNS", sanList[0]["type"])
-	assert.Equal(t, "example.com", sanList[0]["value"])
+	require.Len(t, *requests, 1)
+	assert.JSONEq(t, `{"type":"csr","keySize":2048,"subjectDN":"CN=example.com,O=SAP,C=IN","subjectAltNames":[{"type":"DNS","value":"example.com"}]}`, (*requests)[0].body)
 
 	_ = os.Remove("system_csr.pem")
 }
@@ -242,118 +218,21 @@ func TestGenerateCSRAction_Invoke_NullSubjectDN(t *testing.T) {
 	assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "Missing Subject DN")
 }
 
-func TestGenerateCSRAction_Invoke_CACertType(t *testing.T) {
-	a := &actions.GenerateCSRAction{Client: &api.RestApiClient{}}
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	var capturedEndpoint string
-	helpers.SendRequestFunc = func(client *api.RestApiClient, body map[string]any, endpoint string, actionType string) (*http.Response, diag.Diagnostics) {
-		capturedEndpoint = endpoint
-		csr := "-----BEGIN CERTIFICATE REQUEST-----\nTEST\n-----END CERTIFICATE REQUEST-----"
-		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(csr))}, nil
-	}
-
-	plan := testCSRPlan()
-	plan.Type = types.StringValue("ca")
-
-	resp := newTestResp()
-	a.InvokeWithPlan(context.Background(), plan, resp)
-
-	assert.False(t, resp.Diagnostics.HasError())
-	assert.NotEmpty(t, capturedEndpoint)
-	_ = os.Remove("ca_csr.pem")
-}
-
-func TestGenerateCSRAction_Invoke_UICertType(t *testing.T) {
-	a := &actions.GenerateCSRAction{Client: &api.RestApiClient{}}
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	var capturedEndpoint string
-	helpers.SendRequestFunc = func(client *api.RestApiClient, body map[string]any, endpoint string, actionType string) (*http.Response, diag.Diagnostics) {
-		capturedEndpoint = endpoint
-		csr := "-----BEGIN CERTIFICATE REQUEST-----\nTEST\n-----END CERTIFICATE REQUEST-----"
-		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(csr))}, nil
-	}
-
-	plan := testCSRPlan()
-	plan.Type = types.StringValue("ui")
-
-	resp := newTestResp()
-	a.InvokeWithPlan(context.Background(), plan, resp)
-
-	assert.False(t, resp.Diagnostics.HasError())
-	assert.NotEmpty(t, capturedEndpoint)
-	_ = os.Remove("ui_csr.pem")
-}
-
-func TestGenerateCSRAction_Invoke_SendRequestError(t *testing.T) {
-	a := &actions.GenerateCSRAction{Client: &api.RestApiClient{}}
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	helpers.SendRequestFunc = func(client *api.RestApiClient, body map[string]any, endpoint string, actionType string) (*http.Response, diag.Diagnostics) {
-		var d diag.Diagnostics
-		d.AddError("API Error", "connection refused")
-		return nil, d
-	}
-
-	resp := newTestResp()
-	a.InvokeWithPlan(context.Background(), testCSRPlan(), resp)
-
-	assert.True(t, resp.Diagnostics.HasError())
-}
-
-func TestGenerateCSRAction_Invoke_NilResponseBody(t *testing.T) {
-	a := &actions.GenerateCSRAction{Client: &api.RestApiClient{}}
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	helpers.SendRequestFunc = func(client *api.RestApiClient, body map[string]any, endpoint string, actionType string) (*http.Response, diag.Diagnostics) {
-		return &http.Response{StatusCode: 200, Body: nil}, nil
-	}
-
-	resp := newTestResp()
-	a.InvokeWithPlan(context.Background(), testCSRPlan(), resp)
-
-	assert.True(t, resp.Diagnostics.HasError())
-	assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "Invalid API Response")
-}
-
-func TestGenerateCSRAction_Invoke_NilResponse(t *testing.T) {
-	a := &actions.GenerateCSRAction{Client: &api.RestApiClient{}}
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	helpers.SendRequestFunc = func(client *api.RestApiClient, body map[string]any, endpoint string, actionType string) (*http.Response, diag.Diagnostics) {
-		return nil, nil
-	}
+func TestGenerateCSRAction_Invoke_RequestFails(t *testing.T) {
+	client, _ := newCSRTestClient(t, http.StatusInternalServerError, `{"type":"InternalError","message":"key generation failed"}`)
+	a := &actions.GenerateCSRAction{Client: client}
 
 	resp := newTestResp()
 	a.InvokeWithPlan(context.Background(), testCSRPlan(), resp)
 
 	assert.True(t, resp.Diagnostics.HasError())
-	assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "Invalid API Response")
+	_, err := os.Stat("system_csr.pem")
+	assert.True(t, os.IsNotExist(err), "no CSR should be written")
 }
 
 func TestGenerateCSRAction_Invoke_NullSANs(t *testing.T) {
-	a := &actions.GenerateCSRAction{Client: &api.RestApiClient{}}
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	var capturedBody map[string]any
-	helpers.SendRequestFunc = func(client *api.RestApiClient, body map[string]any, endpoint string, actionType string) (*http.Response, diag.Diagnostics) {
-		capturedBody = body
-		csr := "-----BEGIN CERTIFICATE REQUEST-----\nTEST\n-----END CERTIFICATE REQUEST-----"
-		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(csr))}, nil
-	}
+	client, requests := newCSRTestClient(t, http.StatusOK, testCSR)
+	a := &actions.GenerateCSRAction{Client: client}
 
 	plan := testCSRPlan()
 	plan.SubjectAlternativeNames = types.ListNull(types.ObjectType{
@@ -367,8 +246,8 @@ func TestGenerateCSRAction_Invoke_NullSANs(t *testing.T) {
 	a.InvokeWithPlan(context.Background(), plan, resp)
 
 	assert.False(t, resp.Diagnostics.HasError())
-	_, hasSANs := capturedBody["subjectAltNames"]
-	assert.False(t, hasSANs, "subjectAltNames should not be set when SANs list is null")
+	require.Len(t, *requests, 1)
+	assert.NotContains(t, (*requests)[0].body, "subjectAltNames", "subjectAltNames should not be set when SANs list is null")
 	_ = os.Remove("system_csr.pem")
 }
 
@@ -378,15 +257,7 @@ func TestGenerateCSRAction_Invoke_NullSANs(t *testing.T) {
 
 func TestGenerateCSRAction_Invoke_TopLevel(t *testing.T) {
 	a := actions.NewGenerateCSRAction().(*actions.GenerateCSRAction)
-	a.Client = &api.RestApiClient{}
-
-	oldSend := helpers.SendRequestFunc
-	defer func() { helpers.SendRequestFunc = oldSend }()
-
-	helpers.SendRequestFunc = func(client *api.RestApiClient, body map[string]any, endpoint string, actionType string) (*http.Response, diag.Diagnostics) {
-		csr := "-----BEGIN CERTIFICATE REQUEST-----\nTEST\n-----END CERTIFICATE REQUEST-----"
-		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(csr))}, nil
-	}
+	a.Client, _ = newCSRTestClient(t, http.StatusOK, testCSR)
 
 	schemaResp := &action.SchemaResponse{}
 	a.Schema(context.Background(), action.SchemaRequest{}, schemaResp)
@@ -402,12 +273,14 @@ func TestGenerateCSRAction_Invoke_TopLevel(t *testing.T) {
 	}
 	raw := tftypes.NewValue(tftypes.Object{
 		AttributeTypes: map[string]tftypes.Type{
+			"instance":                  tftypes.String,
 			"type":                      tftypes.String,
 			"key_size":                  tftypes.Number,
 			"subject_dn":                subjectDNType,
 			"subject_alternative_names": tftypes.List{ElementType: sanType},
 		},
 	}, map[string]tftypes.Value{
+		"instance": tftypes.NewValue(tftypes.String, nil),
 		"type":     tftypes.NewValue(tftypes.String, "system"),
 		"key_size": tftypes.NewValue(tftypes.Number, big.NewFloat(2048)),
 		"subject_dn": tftypes.NewValue(subjectDNType, map[string]tftypes.Value{
@@ -450,6 +323,33 @@ func TestGenerateCSRAction_Invoke_ConfigGetError(t *testing.T) {
 	assert.True(t, resp.Diagnostics.HasError())
 }
 
+const testCSR = "-----BEGIN CERTIFICATE REQUEST-----\nTEST\n-----END CERTIFICATE REQUEST-----"
+
+type csrRequest struct {
+	path string
+	body string
+}
+
+// newCSRTestClient returns a client for a server that answers all requests
+// with status and responseBody and records the path and body of the requests.
+func newCSRTestClient(t *testing.T, status int, responseBody string) (*api.RestApiClient, *[]csrRequest) {
+	t.Helper()
+
+	var requests []csrRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		requests = append(requests, csrRequest{path: req.URL.Path, body: string(body)})
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(responseBody))
+	}))
+	t.Cleanup(srv.Close)
+
+	baseURL, _ := url.Parse(srv.URL)
+	client := &api.RestApiClient{BaseURL: baseURL, Client: srv.Client()}
+	client.Retry.MaxRetries = 0
+	return client, &requests
+}
+
 func testCSRPlan() model.CSRActionConfig {
 	return model.CSRActionConfig{
 		Type:    types.StringValue("system"),
@@ -493,7 +393,7 @@ func testCSRPlan() model.CSRActionConfig {
 
 func TestRegistry_All(t *testing.T) {
 	all := actions.All()
-	assert.Len(t, all, 3)
+	assert.Len(t, all, 6)
 
 	ctx := context.Background()
 	names := make([]string, 0, len(all))
@@ -505,5 +405,8 @@ func TestRegistry_All(t *testing.T) {
 
 	assert.Contains(t, names, "scc_generate_csr")
 	assert.Contains(t, names, "scc_create_backup")
+	assert.Contains(t, names, "scc_restore_backup")
 	assert.Contains(t, names, "scc_change_trust_store")
+	assert.Contains(t, names, "scc_switch_to_ldap_authentication")
+	assert.Contains(t, names, "scc_sync_subaccount_trust_configuration")
 }
@@ -0,0 +1,120 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+)
+
+type RestoreBackupAction struct {
+	Client *api.RestApiClient
+}
+
+var _ action.Action = &RestoreBackupAction{}
+
+func NewRestoreBackupAction() action.Action {
+	return &RestoreBackupAction{}
+}
+
+func (a *RestoreBackupAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_restore_backup"
+}
+
+func (a *RestoreBackupAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Restores the configuration of the cloud connector instance from a backup archive, e.g. one created with the ` + "`scc_create_backup`" + ` action.
+
+The backup file is checked to be a valid ZIP archive before it is uploaded.
+
+~> **Caution:** Restoring a backup replaces the current configuration of the Cloud Connector instance. Resources managed by Terraform may drift from the restored configuration.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/backup>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ActionInstanceAttribute(),
+			"backup_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the backup archive (ZIP) to restore.",
+				Required:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password that was used to protect the backup file. Action schema attributes cannot be marked as sensitive, so this value may be visible in Terraform configuration and logs. Handle it securely.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (a *RestoreBackupAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Client = client
+}
+
+func (a *RestoreBackupAction) InvokeWithPlan(ctx context.Context, plan model.RestoreBackupActionConfig, resp *action.InvokeResponse) {
+	if plan.BackupFile.ValueString() == "" {
+		resp.Diagnostics.AddError(
+			"Missing Backup File",
+			"A non-empty backup file path is required to restore a backup.",
+		)
+		return
+	}
+
+	if plan.Password.ValueString() == "" {
+		resp.Diagnostics.AddError(
+			"Missing Password",
+			"A non-empty password is required to restore a backup.",
+		)
+		return
+	}
+
+	helpers.SafeProgress(resp, fmt.Sprintf("Validating backup archive %s...", plan.BackupFile.ValueString()))
+	backupBytes, diags := helpers.ReadBackupArchiveFunc(plan.BackupFile.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	helpers.SafeProgress(resp, fmt.Sprintf("Uploading backup archive (%d bytes)...", len(backupBytes)))
+	diags = helpers.UploadBackupFunc(ctx, a.Client, plan.BackupFile.ValueString(), backupBytes, plan.Password.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	helpers.SafeProgress(resp, "Backup restored successfully")
+}
+
+func (a *RestoreBackupAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var plan model.RestoreBackupActionConfig
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
+	a.InvokeWithPlan(ctx, plan, resp)
+}
@@ -0,0 +1,218 @@
+package actions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/scc/provider/actions"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestoreBackupAction_Metadata(t *testing.T) {
+	a := actions.NewRestoreBackupAction().(*actions.RestoreBackupAction)
+
+	resp := &action.MetadataResponse{}
+	a.Metadata(context.Background(), action.MetadataRequest{ProviderTypeName: "scc"}, resp)
+
+	assert.Equal(t, "scc_restore_backup", resp.TypeName)
+}
+
+func TestRestoreBackupAction_Schema(t *testing.T) {
+	a := actions.NewRestoreBackupAction().(*actions.RestoreBackupAction)
+	resp := &action.SchemaResponse{}
+	a.Schema(context.Background(), action.SchemaRequest{}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Schema.Attributes, "backup_file")
+	assert.Contains(t, resp.Schema.Attributes, "password")
+}
+
+func TestRestoreBackupAction_Configure(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		a := actions.NewRestoreBackupAction().(*actions.RestoreBackupAction)
+		resp := &action.ConfigureResponse{}
+		a.Configure(context.Background(), action.ConfigureRequest{ProviderData: &api.RestApiClient{}}, resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.NotNil(t, a.Client)
+	})
+
+	t.Run("nil provider data", func(t *testing.T) {
+		a := actions.NewRestoreBackupAction().(*actions.RestoreBackupAction)
+		resp := &action.ConfigureResponse{}
+		a.Configure(context.Background(), action.ConfigureRequest{ProviderData: nil}, resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.Nil(t, a.Client)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		a := actions.NewRestoreBackupAction().(*actions.RestoreBackupAction)
+		resp := &action.ConfigureResponse{}
+		a.Configure(context.Background(), action.ConfigureRequest{ProviderData: "wrong-type"}, resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+}
+
+func TestRestoreBackupAction_Invoke_Success(t *testing.T) {
+	a := &actions.RestoreBackupAction{Client: &api.RestApiClient{}}
+
+	oldRead := helpers.ReadBackupArchiveFunc
+	oldUpload := helpers.UploadBackupFunc
+	defer func() {
+		helpers.ReadBackupArchiveFunc = oldRead
+		helpers.UploadBackupFunc = oldUpload
+	}()
+
+	helpers.ReadBackupArchiveFunc = func(path string) ([]byte, diag.Diagnostics) {
+		assert.Equal(t, "scc_backup.zip", path)
+		return []byte("PK\x03\x04zip-content"), nil
+	}
+
+	var uploaded []byte
+	var password string
+	helpers.UploadBackupFunc = func(_ context.Context, _ *api.RestApiClient, fileName string, backupBytes []byte, pw string) diag.Diagnostics {
+		uploaded = backupBytes
+		password = pw
+		return nil
+	}
+
+	resp := newTestResp()
+	a.InvokeWithPlan(context.Background(), testRestoreBackupPlan(), resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, []byte("PK\x03\x04zip-content"), uploaded)
+	assert.Equal(t, "test-password", password)
+}
+
+func TestRestoreBackupAction_Invoke_MissingInputs(t *testing.T) {
+	a := &actions.RestoreBackupAction{Client: &api.RestApiClient{}}
+
+	t.Run("backup file", func(t *testing.T) {
+		plan := testRestoreBackupPlan()
+		plan.BackupFile = types.StringValue("")
+
+		resp := newTestResp()
+		a.InvokeWithPlan(context.Background(), plan, resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Missing Backup File", resp.Diagnostics.Errors()[0].Summary())
+	})
+
+	t.Run("password", func(t *testing.T) {
+		plan := testRestoreBackupPlan()
+		plan.Password = types.StringValue("")
+
+		resp := newTestResp()
+		a.InvokeWithPlan(context.Background(), plan, resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Missing Password", resp.Diagnostics.Errors()[0].Summary())
+	})
+}
+
+func TestRestoreBackupAction_Invoke_InvalidArchiveSkipsUpload(t *testing.T) {
+	a := &actions.RestoreBackupAction{Client: &api.RestApiClient{}}
+
+	oldRead := helpers.ReadBackupArchiveFunc
+	oldUpload := helpers.UploadBackupFunc
+	defer func() {
+		helpers.ReadBackupArchiveFunc = oldRead
+		helpers.UploadBackupFunc = oldUpload
+	}()
+
+	helpers.ReadBackupArchiveFunc = func(string) ([]byte, diag.Diagnostics) {
+		var d diag.Diagnostics
+		d.AddError("Invalid Backup Archive", "not a zip")
+		return nil, d
+	}
+
+	uploadCalled := false
+	helpers.UploadBackupFunc = func(context.Context, *api.RestApiClient, string, []byte, string) diag.Diagnostics {
+		uploadCalled = true
+		return nil
+	}
+
+	resp := newTestResp()
+	a.InvokeWithPlan(context.Background(), testRestoreBackupPlan(), resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.False(t, uploadCalled)
+}
+
+func TestRestoreBackupAction_Invoke_UploadFails(t *testing.T) {
+	a := &actions.RestoreBackupAction{Client: &api.RestApiClient{}}
+
+	oldRead := helpers.ReadBackupArchiveFunc
+	oldUpload := helpers.UploadBackupFunc
+	defer func() {
+		helpers.ReadBackupArchiveFunc = oldRead
+		helpers.UploadBackupFunc = oldUpload
+	}()
+
+	helpers.ReadBackupArchiveFunc = func(string) ([]byte, diag.Diagnostics) {
+		return []byte("PK\x03\x04zip-content"), nil
+	}
+	helpers.UploadBackupFunc = func(context.Context, *api.RestApiClient, string, []byte, string) diag.Diagnostics {
+		var d diag.Diagnostics
+		d.AddError("Failed to Restore Backup", "status code: 400")
+		return d
+	}
+
+	resp := newTestResp()
+	a.InvokeWithPlan(context.Background(), testRestoreBackupPlan(), resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+func TestRestoreBackupAction_Invoke_TopLevel(t *testing.T) {
+	a := actions.NewRestoreBackupAction().(*actions.RestoreBackupAction)
+	a.Client = &api.RestApiClient{}
+
+	oldRead := helpers.ReadBackupArchiveFunc
+	oldUpload := helpers.UploadBackupFunc
+	defer func() {
+		helpers.ReadBackupArchiveFunc = oldRead
+		helpers.UploadBackupFunc = oldUpload
+	}()
+
+	helpers.ReadBackupArchiveFunc = func(string) ([]byte, diag.Diagnostics) {
+		return []byte("PK\x03\x04zip-content"), nil
+	}
+	helpers.UploadBackupFunc = func(context.Context, *api.RestApiClient, string, []byte, string) diag.Diagnostics {
+		return nil
+	}
+
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(context.Background(), action.SchemaRequest{}, schemaResp)
+
+	raw := tftypes.NewValue(
+		tftypes.Object{AttributeTypes: map[string]tftypes.Type{"instance": tftypes.String, "backup_file": tftypes.String, "password": tftypes.String}},
+		map[string]tftypes.Value{
+			"instance":    tftypes.NewValue(tftypes.String, nil),
+			"backup_file": tftypes.NewValue(tftypes.String, "scc_backup.zip"),
+			"password":    tftypes.NewValue(tftypes.String, "mypassword"),
+		},
+	)
+	req := action.InvokeRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw}}
+	resp := newTestResp()
+	a.Invoke(context.Background(), req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+}
+
+func testRestoreBackupPlan() model.RestoreBackupActionConfig {
+	return model.RestoreBackupActionConfig{
+		BackupFile: types.StringValue("scc_backup.zip"),
+		Password:   types.StringValue("test-password"),
+	}
+}
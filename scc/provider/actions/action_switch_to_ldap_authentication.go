@@ -0,0 +1,107 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+)
+
+type SwitchToLDAPAuthenticationAction struct {
+	Client *api.RestApiClient
+}
+
+var _ action.Action = &SwitchToLDAPAuthenticationAction{}
+
+func NewSwitchToLDAPAuthenticationAction() action.Action {
+	return &SwitchToLDAPAuthenticationAction{}
+}
+
+func (a *SwitchToLDAPAuthenticationAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_to_ldap_authentication"
+}
+
+func (a *SwitchToLDAPAuthenticationAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Switches the Cloud Connector from its local user store to the LDAP configuration managed by ` + "`scc_ldap_authentication`" + `. Administrators then log on with their LDAP credentials and get the roles of their LDAP groups.
+
+~> **Caution:** After the switch, the local users, including the *Administrator*, can no longer log on. Make sure that the provider is configured with the credentials of an LDAP user that is mapped to the Administrator role, and that the LDAP configuration is correct, before invoking this action.
+
+If the Cloud Connector already authenticates against LDAP, the action does nothing.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/authentication-and-ui-settings>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ActionInstanceAttribute(),
+		},
+	}
+}
+
+func (a *SwitchToLDAPAuthenticationAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Client = client
+}
+
+func (a *SwitchToLDAPAuthenticationAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var plan model.SwitchToLDAPAuthenticationActionConfig
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
+	authenticationType, err := a.Client.Authentication().Type(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+	if authenticationType == apiobjects.AuthenticationTypeLDAP {
+		helpers.SafeProgress(resp, "The Cloud Connector already authenticates against LDAP.")
+		return
+	}
+
+	if _, err := a.Client.Authentication().LDAP(ctx); err != nil {
+		if api.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Missing LDAP Configuration",
+				"The Cloud Connector has no LDAP configuration. Configure LDAP with the scc_ldap_authentication resource before switching to LDAP authentication.",
+			)
+			return
+		}
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	helpers.SafeProgress(resp, "Switching to LDAP authentication...")
+	if err := a.Client.Authentication().SwitchToLDAP(ctx); err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	helpers.SafeProgress(resp, "The Cloud Connector now authenticates against LDAP.")
+}
@@ -0,0 +1,108 @@
+package actions_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/scc/provider/actions"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwitchToLDAPAuthenticationAction_Metadata(t *testing.T) {
+	a := actions.NewSwitchToLDAPAuthenticationAction()
+	resp := &action.MetadataResponse{}
+	a.Metadata(context.Background(), action.MetadataRequest{ProviderTypeName: "scc"}, resp)
+	assert.Equal(t, "scc_switch_to_ldap_authentication", resp.TypeName)
+}
+
+func TestSwitchToLDAPAuthenticationAction_Configure(t *testing.T) {
+	a := actions.NewSwitchToLDAPAuthenticationAction().(*actions.SwitchToLDAPAuthenticationAction)
+
+	resp := &action.ConfigureResponse{}
+	a.Configure(context.Background(), action.ConfigureRequest{ProviderData: "wrong-type"}, resp)
+	assert.True(t, resp.Diagnostics.HasError())
+
+	resp = &action.ConfigureResponse{}
+	a.Configure(context.Background(), action.ConfigureRequest{ProviderData: &api.RestApiClient{}}, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.NotNil(t, a.Client)
+}
+
+func TestSwitchToLDAPAuthenticationAction_Invoke(t *testing.T) {
+	tests := []struct {
+		name               string
+		authenticationType string
+		ldapStatus         int
+		wantError          string
+		wantSwitch         bool
+	}{
+		{name: "switches to LDAP", authenticationType: "basic", ldapStatus: http.StatusOK, wantSwitch: true},
+		{name: "already LDAP", authenticationType: "ldap", ldapStatus: http.StatusOK},
+		{name: "missing LDAP configuration", authenticationType: "basic", ldapStatus: http.StatusNotFound, wantError: "Missing LDAP Configuration"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var switchBody string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch req.Method + " " + req.URL.Path {
+				case "GET /api/v1/configuration/connector/authentication":
+					_, _ = w.Write([]byte(`{"type":"` + tt.authenticationType + `"}`))
+				case "GET /api/v1/configuration/connector/authentication/ldap":
+					w.WriteHeader(tt.ldapStatus)
+					_, _ = w.Write([]byte(`{"hosts":[{"host":"ldap.example.com","port":636,"isSecure":true}]}`))
+				case "PUT /api/v1/configuration/connector/authentication":
+					body, _ := io.ReadAll(req.Body)
+					switchBody = string(body)
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+				}
+			}))
+			defer srv.Close()
+
+			baseURL, _ := url.Parse(srv.URL)
+			a := &actions.SwitchToLDAPAuthenticationAction{Client: &api.RestApiClient{BaseURL: baseURL, Client: srv.Client()}}
+
+			resp := newTestResp()
+			a.Invoke(context.Background(), action.InvokeRequest{Config: switchToLDAPConfig(t, a)}, resp)
+
+			if tt.wantError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tt.wantError, resp.Diagnostics.Errors()[0].Summary())
+			} else {
+				require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			}
+
+			if tt.wantSwitch {
+				assert.JSONEq(t, `{"type":"ldap"}`, switchBody)
+			} else {
+				assert.Empty(t, switchBody)
+			}
+		})
+	}
+}
+
+func switchToLDAPConfig(t *testing.T, a *actions.SwitchToLDAPAuthenticationAction) tfsdk.Config {
+	t.Helper()
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(context.Background(), action.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"instance": tftypes.NewValue(tftypes.String, nil),
+		}),
+	}
+}
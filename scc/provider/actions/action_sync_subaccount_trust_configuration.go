@@ -0,0 +1,105 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type SyncSubaccountTrustConfigurationAction struct {
+	Client *api.RestApiClient
+}
+
+var _ action.Action = &SyncSubaccountTrustConfigurationAction{}
+
+func NewSyncSubaccountTrustConfigurationAction() action.Action {
+	return &SyncSubaccountTrustConfigurationAction{}
+}
+
+func (a *SyncSubaccountTrustConfigurationAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sync_subaccount_trust_configuration"
+}
+
+func (a *SyncSubaccountTrustConfigurationAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Synchronizes the trust configuration of a subaccount from SAP BTP once. The Cloud Connector then knows the identity providers and applications that were added or removed in SAP BTP, which can be trusted with ` + "`scc_subaccount_trust_configuration`" + `.
+
+Use this action to synchronize on demand, e.g. before applying ` + "`scc_subaccount_trust_configuration`" + `. To synchronize continuously, enable ` + "`auto_trust_sync`" + ` of ` + "`scc_subaccount`" + ` instead.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Subaccount Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/set-up-trust-for-principal-propagation>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ActionInstanceAttribute(),
+			"region_host": schema.StringAttribute{
+				MarkdownDescription: "Region Host Name.",
+				Required:            true,
+			},
+			"subaccount": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+		},
+	}
+}
+
+func (a *SyncSubaccountTrustConfigurationAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.Client = client
+}
+
+func (a *SyncSubaccountTrustConfigurationAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var plan model.SyncSubaccountTrustConfigurationActionConfig
+	diags := req.Config.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
+	regionHost := plan.RegionHost.ValueString()
+	subaccount := plan.Subaccount.ValueString()
+
+	helpers.SafeProgress(resp, fmt.Sprintf("Synchronizing the trust configuration of subaccount %s...", subaccount))
+	if err := a.Client.Subaccounts().SyncTrust(ctx, regionHost, subaccount); err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	trust, err := a.Client.Subaccounts().GetTrust(ctx, regionHost, subaccount)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	helpers.SafeProgress(resp, fmt.Sprintf("The trust configuration contains %d identity providers and %d applications.", len(trust.IdentityProviders), len(trust.Applications)))
+}
@@ -0,0 +1,99 @@
+package actions_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/scc/provider/actions"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const trustEndpoint = "/api/v1/configuration/subaccounts/cf.eu12.hana.ondemand.com/9f7390c8-f201-4b2d-b751-04c0a63c2671/trust"
+
+func TestSyncSubaccountTrustConfigurationAction_Metadata(t *testing.T) {
+	a := actions.NewSyncSubaccountTrustConfigurationAction()
+	resp := &action.MetadataResponse{}
+	a.Metadata(context.Background(), action.MetadataRequest{ProviderTypeName: "scc"}, resp)
+	assert.Equal(t, "scc_sync_subaccount_trust_configuration", resp.TypeName)
+}
+
+func TestSyncSubaccountTrustConfigurationAction_Configure(t *testing.T) {
+	a := actions.NewSyncSubaccountTrustConfigurationAction().(*actions.SyncSubaccountTrustConfigurationAction)
+
+	resp := &action.ConfigureResponse{}
+	a.Configure(context.Background(), action.ConfigureRequest{ProviderData: "wrong-type"}, resp)
+	assert.True(t, resp.Diagnostics.HasError())
+
+	resp = &action.ConfigureResponse{}
+	a.Configure(context.Background(), action.ConfigureRequest{ProviderData: &api.RestApiClient{}}, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.NotNil(t, a.Client)
+}
+
+func TestSyncSubaccountTrustConfigurationAction_Invoke(t *testing.T) {
+	tests := []struct {
+		name       string
+		syncStatus int
+		wantError  bool
+	}{
+		{name: "synchronizes the trust configuration", syncStatus: http.StatusNoContent},
+		{name: "unknown subaccount", syncStatus: http.StatusNotFound, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requests = append(requests, req.Method+" "+req.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				switch req.Method + " " + req.URL.Path {
+				case "POST " + trustEndpoint:
+					w.WriteHeader(tt.syncStatus)
+				case "GET " + trustEndpoint:
+					_, _ = w.Write([]byte(`{"identityProviders":[{"name":"accounts.sap.com","trusted":true}],"applications":[]}`))
+				default:
+					t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+				}
+			}))
+			defer srv.Close()
+
+			baseURL, _ := url.Parse(srv.URL)
+			a := &actions.SyncSubaccountTrustConfigurationAction{Client: &api.RestApiClient{BaseURL: baseURL, Client: srv.Client()}}
+
+			resp := newTestResp()
+			a.Invoke(context.Background(), action.InvokeRequest{Config: syncSubaccountTrustConfig(t, a)}, resp)
+
+			if tt.wantError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, []string{"POST " + trustEndpoint}, requests)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, []string{"POST " + trustEndpoint, "GET " + trustEndpoint}, requests)
+		})
+	}
+}
+
+func syncSubaccountTrustConfig(t *testing.T, a *actions.SyncSubaccountTrustConfigurationAction) tfsdk.Config {
+	t.Helper()
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(context.Background(), action.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"instance":    tftypes.NewValue(tftypes.String, nil),
+			"region_host": tftypes.NewValue(tftypes.String, "cf.eu12.hana.ondemand.com"),
+			"subaccount":  tftypes.NewValue(tftypes.String, "9f7390c8-f201-4b2d-b751-04c0a63c2671"),
+		}),
+	}
+}
@@ -6,6 +6,9 @@ func All() []func() action.Action {
 	return []func() action.Action{
 		NewGenerateCSRAction,
 		NewCreateBackupAction,
+		NewRestoreBackupAction,
 		NewChangeTrustStoreAction,
+		NewSwitchToLDAPAuthenticationAction,
+		NewSyncSubaccountTrustConfigurationAction,
 	}
 }
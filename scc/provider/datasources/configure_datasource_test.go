@@ -87,6 +87,48 @@ var dataSources = []testDataSource{
 			return r.(*datasources.SubaccountK8SServiceChannelsDataSource).Client
 		},
 	},
+	{
+		name:       "HAStatusDataSource",
+		datasource: &datasources.HAStatusDataSource{},
+		getClient: func(r datasource.DataSource) *api.RestApiClient {
+			return r.(*datasources.HAStatusDataSource).Client
+		},
+	},
+	{
+		name:       "AuditLogEntriesDataSource",
+		datasource: &datasources.AuditLogEntriesDataSource{},
+		getClient: func(r datasource.DataSource) *api.RestApiClient {
+			return r.(*datasources.AuditLogEntriesDataSource).Client
+		},
+	},
+	{
+		name:       "SubaccountTunnelStatusDataSource",
+		datasource: &datasources.SubaccountTunnelStatusDataSource{},
+		getClient: func(r datasource.DataSource) *api.RestApiClient {
+			return r.(*datasources.SubaccountTunnelStatusDataSource).Client
+		},
+	},
+	{
+		name:       "ConnectorVersionDataSource",
+		datasource: &datasources.ConnectorVersionDataSource{},
+		getClient: func(r datasource.DataSource) *api.RestApiClient {
+			return r.(*datasources.ConnectorVersionDataSource).Client
+		},
+	},
+	{
+		name:       "UsersDataSource",
+		datasource: &datasources.UsersDataSource{},
+		getClient: func(r datasource.DataSource) *api.RestApiClient {
+			return r.(*datasources.UsersDataSource).Client
+		},
+	},
+	{
+		name:       "SubjectPatternPreviewDataSource",
+		datasource: &datasources.SubjectPatternPreviewDataSource{},
+		getClient: func(r datasource.DataSource) *api.RestApiClient {
+			return r.(*datasources.SubjectPatternPreviewDataSource).Client
+		},
+	},
 }
 
 func TestAllDataSourceConfigure(t *testing.T) {
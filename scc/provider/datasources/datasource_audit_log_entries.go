@@ -0,0 +1,221 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &AuditLogEntriesDataSource{}
+
+func NewAuditLogEntriesDataSource() datasource.DataSource {
+	return &AuditLogEntriesDataSource{}
+}
+
+type AuditLogEntriesDataSource struct {
+	Client *api.RestApiClient
+}
+
+func (d *AuditLogEntriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_log_entries"
+}
+
+func (d *AuditLogEntriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Audit Log Entries Data Source.
+
+Reads the entries of the Cloud Connector audit log, optionally restricted to a time range and a user.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Display
+	* Support
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/manage-audit-logs>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.DataSourceInstanceAttribute(),
+			"from": schema.StringAttribute{
+				MarkdownDescription: "Only entries written at or after this point in time are returned. The value must be given in RFC 3339 format, e.g. `2025-01-01T00:00:00Z`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "Only entries written at or before this point in time are returned. The value must be given in RFC 3339 format, e.g. `2025-01-31T23:59:59Z`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "Only entries caused by this user are returned.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "List of audit log entries matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "Point in time (UTC) at which the entry was written.",
+							Computed:            true,
+						},
+						"user": schema.StringAttribute{
+							MarkdownDescription: "The user that caused the entry.",
+							Computed:            true,
+						},
+						"category": schema.StringAttribute{
+							MarkdownDescription: "The category of the audited event.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "The audit message.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AuditLogEntriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.Client = client
+}
+
+func (d *AuditLogEntriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.AuditLogEntriesConfig
+	diags := req.Config.Get(ctx, &data)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, data.Instance.ValueString())
+
+	from, diags := parseAuditLogTime(data.From, path.Root("from"))
+	resp.Diagnostics.Append(diags...)
+	to, diags := parseAuditLogTime(data.To, path.Root("to"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !from.IsNull() && !to.IsNull() && from.ValueInt64() > to.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("from"),
+			"Invalid Time Range",
+			fmt.Sprintf("The start of the time range (%s) must not be after its end (%s).", data.From.ValueString(), data.To.ValueString()),
+		)
+		return
+	}
+
+	query := url.Values{}
+	if !from.IsNull() {
+		query.Set("from", strconv.FormatInt(from.ValueInt64(), 10))
+	}
+	if !to.IsNull() {
+		query.Set("to", strconv.FormatInt(to.ValueInt64(), 10))
+	}
+	if !data.User.IsNull() {
+		query.Set("user", data.User.ValueString())
+	}
+
+	entries, err := d.Client.AuditLog().Entries(ctx, query)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	// Older Cloud Connector versions ignore the query parameters, so the
+	// filters are applied to the response as well.
+	respObj := apiobjects.AuditLogEntries{
+		Entries: filterAuditLogEntries(entries, from, to, data.User.ValueString()),
+	}
+
+	responseModel, diags := model.AuditLogEntriesValueFrom(ctx, data, respObj)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// parseAuditLogTime converts an RFC 3339 time into epoch milliseconds.
+// A null value yields null, meaning the range is open on that side.
+func parseAuditLogTime(value types.String, attributePath path.Path) (types.Int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return types.Int64Null(), diags
+	}
+
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Time Format",
+			fmt.Sprintf("Expected a time in RFC 3339 format (e.g. 2025-01-01T00:00:00Z). Got: %q", value.ValueString()),
+		)
+		return types.Int64Null(), diags
+	}
+
+	return types.Int64Value(t.UnixMilli()), diags
+}
+
+func filterAuditLogEntries(entries []apiobjects.AuditLogEntry, from, to types.Int64, user string) []apiobjects.AuditLogEntry {
+	filtered := []apiobjects.AuditLogEntry{}
+	for _, entry := range entries {
+		if !from.IsNull() && entry.Timestamp < from.ValueInt64() {
+			continue
+		}
+		if !to.IsNull() && entry.Timestamp > to.ValueInt64() {
+			continue
+		}
+		if user != "" && entry.User != user {
+			continue
+		}
+		filtered = append(filtered, entry)
+	}
+	return filtered
+}
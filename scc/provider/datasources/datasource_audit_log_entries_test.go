@@ -0,0 +1,137 @@
+package datasources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/scc/provider/datasources"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceAuditLogEntries_Read(t *testing.T) {
+	// 2025-01-01T00:00:00Z, 2025-01-02T00:00:00Z and 2025-01-03T00:00:00Z
+	entries := []apiobjects.AuditLogEntry{
+		{Timestamp: 1735689600000, User: "admin", Category: "CONFIGURATION", Message: "Proxy settings changed"},
+		{Timestamp: 1735776000000, User: "operator", Category: "SECURITY", Message: "Logon succeeded"},
+		{Timestamp: 1735862400000, User: "admin", Category: "SECURITY", Message: "Logon failed"},
+	}
+
+	t.Run("no filters", func(t *testing.T) {
+		state, query, diags := readAuditLogEntries(t, entries, model.AuditLogEntriesConfig{})
+		require.False(t, diags.HasError(), diags)
+
+		assert.Empty(t, query)
+		require.Len(t, state.Entries, 3)
+		assert.Equal(t, "2025-01-01 00:00:00", state.Entries[0].Timestamp.ValueString())
+		assert.Equal(t, "admin", state.Entries[0].User.ValueString())
+		assert.Equal(t, "CONFIGURATION", state.Entries[0].Category.ValueString())
+		assert.Equal(t, "Proxy settings changed", state.Entries[0].Message.ValueString())
+	})
+
+	t.Run("time range and user", func(t *testing.T) {
+		state, query, diags := readAuditLogEntries(t, entries, model.AuditLogEntriesConfig{
+			From: types.StringValue("2025-01-01T12:00:00Z"),
+			To:   types.StringValue("2025-01-03T00:00:00Z"),
+			User: types.StringValue("admin"),
+		})
+		require.False(t, diags.HasError(), diags)
+
+		assert.Equal(t, "1735732800000", query.Get("from"))
+		assert.Equal(t, "1735862400000", query.Get("to"))
+		assert.Equal(t, "admin", query.Get("user"))
+		require.Len(t, state.Entries, 1)
+		assert.Equal(t, "Logon failed", state.Entries[0].Message.ValueString())
+		assert.Equal(t, "2025-01-03T00:00:00Z", state.To.ValueString())
+	})
+
+	t.Run("start of the epoch", func(t *testing.T) {
+		state, query, diags := readAuditLogEntries(t, entries, model.AuditLogEntriesConfig{
+			From: types.StringValue("1970-01-01T00:00:00Z"),
+			To:   types.StringValue("1970-01-01T00:00:00Z"),
+		})
+		require.False(t, diags.HasError(), diags)
+
+		assert.Equal(t, "0", query.Get("from"))
+		assert.Equal(t, "0", query.Get("to"))
+		assert.Empty(t, state.Entries)
+	})
+
+	t.Run("invalid time format", func(t *testing.T) {
+		_, _, diags := readAuditLogEntries(t, entries, model.AuditLogEntriesConfig{
+			From: types.StringValue("yesterday"),
+		})
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Invalid Time Format", diags.Errors()[0].Summary())
+	})
+
+	t.Run("inverted time range", func(t *testing.T) {
+		_, _, diags := readAuditLogEntries(t, entries, model.AuditLogEntriesConfig{
+			From: types.StringValue("2025-01-03T00:00:00Z"),
+			To:   types.StringValue("2025-01-01T00:00:00Z"),
+		})
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Invalid Time Range", diags.Errors()[0].Summary())
+	})
+}
+
+func TestDataSourceAuditLogEntries_Read_APIFails(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	_, diags := readAuditLogEntriesFrom(t, srv, model.AuditLogEntriesConfig{})
+	assert.True(t, diags.HasError())
+}
+
+func readAuditLogEntries(t *testing.T, entries []apiobjects.AuditLogEntry, config model.AuditLogEntriesConfig) (model.AuditLogEntriesConfig, url.Values, diag.Diagnostics) {
+	t.Helper()
+	var query url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/connector/auditLog/entries", r.URL.Path)
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(entries)
+	}))
+	defer srv.Close()
+
+	state, diags := readAuditLogEntriesFrom(t, srv, config)
+	return state, query, diags
+}
+
+func readAuditLogEntriesFrom(t *testing.T, srv *httptest.Server, config model.AuditLogEntriesConfig) (model.AuditLogEntriesConfig, diag.Diagnostics) {
+	t.Helper()
+	ds := &datasources.AuditLogEntriesDataSource{Client: tfutils.NewTestClient(t, srv)}
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+	cfg := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil),
+	}
+	require.False(t, cfg.Set(context.Background(), &config).HasError())
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: cfg.Raw.Copy()}}
+	ds.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: cfg.Raw}}, resp)
+
+	var state model.AuditLogEntriesConfig
+	if !resp.Diagnostics.HasError() {
+		require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	}
+	return state, resp.Diagnostics
+}
@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -43,6 +41,7 @@ __Tips:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/proxy-settings>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.DataSourceInstanceAttribute(),
 			"trust_all_backends": schema.BoolAttribute{
 				MarkdownDescription: "Flag (boolean) indicating whether all backends are trusted (true), or only the backends represented by certificates are trusted (false).",
 				Computed:            true,
@@ -126,7 +125,6 @@ func (d *BackendTrustStoreDataSource) Configure(ctx context.Context, req datasou
 
 func (d *BackendTrustStoreDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data model.BackendTrustStoreDataSourceConfig
-	var respObj apiobjects.BackendTrustStoreConfiguration
 	diags := req.Config.Get(ctx, &data)
 
 	resp.Diagnostics.Append(diags...)
@@ -134,11 +132,11 @@ func (d *BackendTrustStoreDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
-	endpoint := endpoints.GetBackendTrustStoreBaseEndpoint()
+	ctx = api.WithInstance(ctx, data.Instance.ValueString())
 
-	diags = helpers.RequestAndUnmarshal(d.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := d.Client.BackendTrustStore().Get(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -148,6 +146,8 @@ func (d *BackendTrustStoreDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
+	responseModel.Instance = data.Instance
+
 	diags = resp.State.Set(ctx, &responseModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
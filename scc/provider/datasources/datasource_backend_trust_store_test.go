@@ -2,11 +2,20 @@ package datasources_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"fmt"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
 	"github.com/SAP/terraform-provider-scc/scc/provider/datasources"
 	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -14,36 +23,49 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDataSourceBackendTrustStore(t *testing.T) {
-	// To run this test, you need to have backend trust store configured with a certificate in your Cloud Connector Instance.
 	t.Parallel()
 
-	t.Run("happy path", func(t *testing.T) {
-		rec, user := tfutils.SetupVCR(t, "fixtures/datasource_backend_trust_store")
-		defer tfutils.StopQuietly(rec)
-
-		resource.Test(t, resource.TestCase{
-			IsUnitTest:               true,
-			ProtoV6ProviderFactories: tfutils.GetTestProviders(rec.GetDefaultClient()),
-			Steps: []resource.TestStep{
-				{
-					Config: tfutils.ProviderConfig(user) + DataSourceBackendTrustStore("scc_bts"),
-					Check: resource.ComposeAggregateTestCheckFunc(
-						resource.TestCheckResourceAttrSet("data.scc_backend_trust_store.scc_bts", "trust_all_backends"),
-						resource.TestCheckResourceAttr("data.scc_backend_trust_store.scc_bts", "trusted_backends.#", "1"),
-
-						resource.TestCheckResourceAttrSet("data.scc_backend_trust_store.scc_bts", "trusted_backends.0.alias"),
-						resource.TestCheckResourceAttrSet("data.scc_backend_trust_store.scc_bts", "trusted_backends.0.subject_dn.cn"),
-						resource.TestCheckResourceAttrSet("data.scc_backend_trust_store.scc_bts", "trusted_backends.0.issuer"),
-						resource.TestMatchResourceAttr("data.scc_backend_trust_store.scc_bts", "trusted_backends.0.valid_to", tfutils.RegexpValidTimeStamp),
-					),
-				},
-			},
+	setupTrustStore := func(t *testing.T, version string) (*sccmock.Server, tfutils.User) {
+		srv, user := tfutils.SetupMock(t)
+		backend := newTrustedBackend(t, "My Backend")
+		srv.Update(func(state *sccmock.State) {
+			state.Version = version
+			state.TrustedBackends = append(state.TrustedBackends, backend)
 		})
+		return srv, user
+	}
+
+	for name, version := range map[string]string{
+		"happy path": sccmock.DefaultVersion,
+		"happy path - Cloud Connector before 2.18.0": "2.17.0",
+	} {
+		t.Run(name, func(t *testing.T) {
+			srv, user := setupTrustStore(t, version)
+
+			resource.Test(t, resource.TestCase{
+				IsUnitTest:               true,
+				ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+				Steps: []resource.TestStep{
+					{
+						Config: tfutils.ProviderConfig(user) + DataSourceBackendTrustStore("scc_bts"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("data.scc_backend_trust_store.scc_bts", "trust_all_backends", "false"),
+							resource.TestCheckResourceAttr("data.scc_backend_trust_store.scc_bts", "trusted_backends.#", "1"),
 
-	})
+							resource.TestCheckResourceAttr("data.scc_backend_trust_store.scc_bts", "trusted_backends.0.alias", "my_backend"),
+							resource.TestCheckResourceAttr("data.scc_backend_trust_store.scc_bts", "trusted_backends.0.subject_dn.cn", "My Backend"),
+							resource.TestCheckResourceAttrSet("data.scc_backend_trust_store.scc_bts", "trusted_backends.0.issuer"),
+							resource.TestMatchResourceAttr("data.scc_backend_trust_store.scc_bts", "trusted_backends.0.valid_to", tfutils.RegexpValidTimeStamp),
+						),
+					},
+				},
+			})
+		})
+	}
 }
 
 func DataSourceBackendTrustStore(datasourceName string) string {
@@ -70,3 +92,28 @@ func TestDataSourceBackendTrustStore_Read_APIError(t *testing.T) {
 
 	assert.True(t, resp.Diagnostics.HasError())
 }
+
+// newTrustedBackend returns a self-signed certificate with common name cn as
+// stored in the backend trust store of the fake Cloud Connector.
+func newTrustedBackend(t *testing.T, cn string) *sccmock.TrustedBackend {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().AddDate(1, 0, 0),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &sccmock.TrustedBackend{
+		Alias:       strings.ToLower(strings.ReplaceAll(cn, " ", "_")),
+		Certificate: certificate,
+	}
+}
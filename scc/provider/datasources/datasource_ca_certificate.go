@@ -7,8 +7,6 @@ import (
 	"regexp"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -43,6 +41,7 @@ __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/ca-certificate-for-principal-propagation-apis#get-description-for-a-ca-certificate-for-principal-propagation>
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/ca-certificate-for-principal-propagation-apis#get-binary-content-of-a-ca-certificate-for-principal-propagation>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.DataSourceInstanceAttribute(),
 			"valid_to": schema.StringAttribute{
 				MarkdownDescription: "Timestamp of the end of the validity period.",
 				Computed:            true,
@@ -152,7 +151,6 @@ func (d *CACertificateDataSource) Configure(ctx context.Context, req datasource.
 
 func (d *CACertificateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data model.CACertificateDataSourceConfig
-	var respObj apiobjects.Certificate
 	diags := req.Config.Get(ctx, &data)
 
 	resp.Diagnostics.Append(diags...)
@@ -160,19 +158,19 @@ func (d *CACertificateDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	endpoint := endpoints.GetCACertificateEndpoint()
+	ctx = api.WithInstance(ctx, data.Instance.ValueString())
 
 	// Get Certificate Metadata
-	diags = helpers.RequestAndUnmarshalCertificateFunc(d.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := d.Client.CACertificate().Get(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
 	// Generate Binary Certificate
-	certBytes, diags := helpers.GetCertificateBinaryFunc(d.Client, endpoint)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	certBytes, err := d.Client.CACertificate().Download(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -187,6 +185,8 @@ func (d *CACertificateDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
+	responseModel.Instance = data.Instance
+
 	responseModel.CertificatePEM = types.StringValue(string(pemBytes))
 
 	diags = resp.State.Set(ctx, &responseModel)
@@ -0,0 +1,118 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSource = &ConnectorVersionDataSource{}
+
+func NewConnectorVersionDataSource() datasource.DataSource {
+	return &ConnectorVersionDataSource{}
+}
+
+type ConnectorVersionDataSource struct {
+	Client *api.RestApiClient
+}
+
+func (d *ConnectorVersionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connector_version"
+}
+
+func (d *ConnectorVersionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Version Data Source.
+
+Reports the version of the Cloud Connector. Use it in ` + "`check`" + ` blocks or preconditions to assert that the Cloud Connector supports the features a configuration relies on.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Subaccount Administrator
+	* Display
+	* Support
+	* Monitoring`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.DataSourceInstanceAttribute(),
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The version of the Cloud Connector, as reported by the Cloud Connector.",
+				Computed:            true,
+			},
+			"major": schema.Int64Attribute{
+				MarkdownDescription: "The major version of the Cloud Connector.",
+				Computed:            true,
+			},
+			"minor": schema.Int64Attribute{
+				MarkdownDescription: "The minor version of the Cloud Connector.",
+				Computed:            true,
+			},
+			"micro": schema.Int64Attribute{
+				MarkdownDescription: "The micro version of the Cloud Connector.",
+				Computed:            true,
+			},
+			"build": schema.StringAttribute{
+				MarkdownDescription: "The build of the Cloud Connector. If the Cloud Connector does not report the build separately, it is the fourth component of `version`, if any.",
+				Computed:            true,
+			},
+			"java_runtime": schema.StringAttribute{
+				MarkdownDescription: "The version of the Java runtime the Cloud Connector runs on. Only set if reported by the Cloud Connector.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ConnectorVersionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.Client = client
+}
+
+func (d *ConnectorVersionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.ConnectorVersionConfig
+	diags := req.Config.Get(ctx, &data)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, data.Instance.ValueString())
+
+	respObj, err := d.Client.Connector().Version(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	responseModel, diags := model.ConnectorVersionValueFrom(ctx, data, respObj)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
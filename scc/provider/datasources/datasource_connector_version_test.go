@@ -0,0 +1,85 @@
+package datasources_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/scc/provider/datasources"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceConnectorVersion_Read(t *testing.T) {
+	t.Run("version with build", func(t *testing.T) {
+		state, diags := readConnectorVersion(t, `{"version":"2.19.0.2"}`)
+		require.False(t, diags.HasError(), diags)
+
+		assert.Equal(t, "2.19.0.2", state.Version.ValueString())
+		assert.Equal(t, int64(2), state.Major.ValueInt64())
+		assert.Equal(t, int64(19), state.Minor.ValueInt64())
+		assert.Equal(t, int64(0), state.Micro.ValueInt64())
+		assert.Equal(t, "2", state.Build.ValueString())
+		assert.True(t, state.JavaRuntime.IsNull())
+	})
+
+	t.Run("build and java runtime reported separately", func(t *testing.T) {
+		state, diags := readConnectorVersion(t, `{"version":"2.18.1","build":"20250301","javaVersion":"21.0.6"}`)
+		require.False(t, diags.HasError(), diags)
+
+		assert.Equal(t, int64(1), state.Micro.ValueInt64())
+		assert.Equal(t, "20250301", state.Build.ValueString())
+		assert.Equal(t, "21.0.6", state.JavaRuntime.ValueString())
+	})
+
+	t.Run("version without build", func(t *testing.T) {
+		state, diags := readConnectorVersion(t, `{"version":"2.17.0"}`)
+		require.False(t, diags.HasError(), diags)
+
+		assert.True(t, state.Build.IsNull())
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		_, diags := readConnectorVersion(t, `{"version":"unknown"}`)
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Unknown Cloud Connector Version", diags.Errors()[0].Summary())
+	})
+}
+
+func readConnectorVersion(t *testing.T, body string) (model.ConnectorVersionConfig, diag.Diagnostics) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/connector/version", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, body)
+	}))
+	defer srv.Close()
+
+	ds := &datasources.ConnectorVersionDataSource{Client: tfutils.NewTestClient(t, srv)}
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+	config := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil),
+	}
+	require.False(t, config.Set(context.Background(), &model.ConnectorVersionConfig{}).HasError())
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: config.Raw.Copy()}}
+	ds.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, resp)
+
+	var state model.ConnectorVersionConfig
+	if !resp.Diagnostics.HasError() {
+		require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	}
+	return state, resp.Diagnostics
+}
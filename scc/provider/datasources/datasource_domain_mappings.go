@@ -3,10 +3,10 @@ package datasources
 import (
 	"context"
 	"fmt"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -40,6 +40,7 @@ __Tips:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/domain-mappings>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.DataSourceInstanceAttribute(),
 			"region_host": schema.StringAttribute{
 				MarkdownDescription: "Region Host Name.",
 				Required:            true,
@@ -99,15 +100,16 @@ func (d *DomainMappingsDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
+	ctx = api.WithInstance(ctx, data.Instance.ValueString())
+
 	regionHost := data.RegionHost.ValueString()
 	subaccount := data.Subaccount.ValueString()
-	endpoint := fmt.Sprintf("/api/v1/configuration/subaccounts/%s/%s/domainMappings", regionHost, subaccount)
-
-	diags = helpers.RequestAndUnmarshal(d.Client, &respObj.DomainMappings, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	domainMappings, err := d.Client.DomainMappings().List(ctx, regionHost, subaccount)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
+	respObj.DomainMappings = domainMappings
 
 	responseModel, diags := model.DomainMappingsValueFrom(ctx, data, respObj)
 	resp.Diagnostics.Append(diags...)
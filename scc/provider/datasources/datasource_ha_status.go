@@ -0,0 +1,172 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSource = &HAStatusDataSource{}
+
+func NewHAStatusDataSource() datasource.DataSource {
+	return &HAStatusDataSource{}
+}
+
+type HAStatusDataSource struct {
+	Client *api.RestApiClient
+}
+
+func (d *HAStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ha_status"
+}
+
+func (d *HAStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector High Availability Status Data Source.
+
+Reports whether the configured Cloud Connector instance acts as master or shadow instance and the state of its high availability setup.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Display
+	* Support
+	* Monitoring
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/install-failover-instance-for-high-availability>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.DataSourceInstanceAttribute(),
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The high availability role of the instance. Possible values are: \n" +
+					helpers.GetFormattedValueAsTableRow("role", "description") +
+					helpers.GetFormattedValueAsTableRow("---", "---") +
+					helpers.GetFormattedValueAsTableRow("`master`", "The instance handles the traffic and may accept a shadow instance.") +
+					helpers.GetFormattedValueAsTableRow("`shadow`", "The instance monitors a master instance and takes over if the master fails."),
+				Computed: true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The high availability state as reported by the instance.",
+				Computed:            true,
+			},
+			"ha_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether a shadow instance is allowed to connect. Only set for master instances.",
+				Computed:            true,
+			},
+			"allowed_shadow_host": schema.StringAttribute{
+				MarkdownDescription: "The host name of the shadow instance that is allowed to connect. Only set for master instances.",
+				Computed:            true,
+			},
+			"master_host": schema.StringAttribute{
+				MarkdownDescription: "Host name of the master instance. Only set for shadow instances.",
+				Computed:            true,
+			},
+			"master_port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the master instance. Only set for shadow instances.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *HAStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.Client = client
+}
+
+func (d *HAStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.HAStatusDataSourceConfig
+	diags := req.Config.Get(ctx, &data)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, data.Instance.ValueString())
+
+	role, err := d.Client.HighAvailability().Role(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	var (
+		masterConfig *apiobjects.HAMasterConfiguration
+		masterState  *apiobjects.HAMasterState
+		shadowConfig *apiobjects.HAShadowConfiguration
+		shadowState  *apiobjects.HAShadowState
+	)
+
+	switch strings.ToLower(role.Role) {
+	case "master":
+		config, err := d.Client.HighAvailability().MasterConfiguration(ctx)
+		if err != nil {
+			resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+			return
+		}
+
+		state, err := d.Client.HighAvailability().MasterState(ctx)
+		if err != nil {
+			resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+			return
+		}
+
+		masterConfig, masterState = &config, &state
+	case "shadow":
+		config, err := d.Client.HighAvailability().ShadowConfiguration(ctx)
+		if err != nil {
+			resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+			return
+		}
+
+		state, err := d.Client.HighAvailability().ShadowState(ctx)
+		if err != nil {
+			resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+			return
+		}
+
+		shadowConfig, shadowState = &config, &state
+	default:
+		resp.Diagnostics.AddError(
+			"Unknown High Availability Role",
+			fmt.Sprintf("The Cloud Connector reported the unknown high availability role %q.", role.Role),
+		)
+		return
+	}
+
+	responseModel, diags := model.HAStatusDataSourceValueFrom(ctx, strings.ToLower(role.Role), masterConfig, masterState, shadowConfig, shadowState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseModel.Instance = data.Instance
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
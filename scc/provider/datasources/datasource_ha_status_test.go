@@ -0,0 +1,106 @@
+package datasources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/scc/provider/datasources"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceHAStatus_Read(t *testing.T) {
+	responses := map[string]map[string]any{
+		"master": {
+			"/api/v1/connector/ha/role":                        apiobjects.HARole{Role: "master"},
+			"/api/v1/configuration/connector/ha/master/config": apiobjects.HAMasterConfiguration{HAEnabled: true, AllowedShadowHost: "shadow.example.com"},
+			"/api/v1/configuration/connector/ha/master/state":  apiobjects.HAMasterState{State: "connected"},
+		},
+		"shadow": {
+			"/api/v1/connector/ha/role":                        apiobjects.HARole{Role: "shadow"},
+			"/api/v1/configuration/connector/ha/shadow/config": apiobjects.HAShadowConfiguration{MasterHost: "master.example.com", MasterPort: 8443},
+			"/api/v1/configuration/connector/ha/shadow/state":  apiobjects.HAShadowState{State: "standby"},
+		},
+	}
+
+	t.Run("master instance", func(t *testing.T) {
+		state, diags := readHAStatus(t, responses["master"])
+		require.False(t, diags.HasError(), diags)
+
+		assert.Equal(t, "master", state.Role.ValueString())
+		assert.Equal(t, "connected", state.State.ValueString())
+		assert.True(t, state.HAEnabled.ValueBool())
+		assert.Equal(t, "shadow.example.com", state.AllowedShadowHost.ValueString())
+		assert.True(t, state.MasterHost.IsNull())
+		assert.True(t, state.MasterPort.IsNull())
+	})
+
+	t.Run("shadow instance", func(t *testing.T) {
+		state, diags := readHAStatus(t, responses["shadow"])
+		require.False(t, diags.HasError(), diags)
+
+		assert.Equal(t, "shadow", state.Role.ValueString())
+		assert.Equal(t, "standby", state.State.ValueString())
+		assert.True(t, state.HAEnabled.IsNull())
+		assert.Equal(t, "master.example.com", state.MasterHost.ValueString())
+		assert.Equal(t, int64(8443), state.MasterPort.ValueInt64())
+	})
+
+	t.Run("unknown role", func(t *testing.T) {
+		_, diags := readHAStatus(t, map[string]any{
+			"/api/v1/connector/ha/role": apiobjects.HARole{Role: "observer"},
+		})
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Unknown High Availability Role", diags.Errors()[0].Summary())
+	})
+
+	t.Run("api error", func(t *testing.T) {
+		_, diags := readHAStatus(t, map[string]any{})
+
+		assert.True(t, diags.HasError())
+	})
+}
+
+func readHAStatus(t *testing.T, responses map[string]any) (model.HAStatusDataSourceConfig, diag.Diagnostics) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	defer srv.Close()
+
+	ds := &datasources.HAStatusDataSource{Client: tfutils.NewTestClient(t, srv)}
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+	config := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil),
+	}
+	require.False(t, config.Set(context.Background(), &model.HAStatusDataSourceConfig{}).HasError())
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: config.Raw.Copy()}}
+	ds.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, resp)
+
+	var state model.HAStatusDataSourceConfig
+	if !resp.Diagnostics.HasError() {
+		require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	}
+	return state, resp.Diagnostics
+}
@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -45,6 +43,7 @@ __Tips:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/proxy-settings>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.DataSourceInstanceAttribute(),
 			"host": schema.StringAttribute{
 				MarkdownDescription: "The name of the proxy host.",
 				Computed:            true,
@@ -90,7 +89,6 @@ func (d *ProxySettingsDataSource) Configure(ctx context.Context, req datasource.
 
 func (d *ProxySettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data model.ProxySettingsDataSourceConfig
-	var respObj apiobjects.ProxySettings
 	diags := req.Config.Get(ctx, &data)
 
 	resp.Diagnostics.Append(diags...)
@@ -98,11 +96,11 @@ func (d *ProxySettingsDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	endpoint := endpoints.GetProxySettingsEndpoint()
+	ctx = api.WithInstance(ctx, data.Instance.ValueString())
 
-	diags = helpers.RequestAndUnmarshal(d.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := d.Client.ProxySettings().Get(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -112,6 +110,8 @@ func (d *ProxySettingsDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
+	responseModel.Instance = data.Instance
+
 	diags = resp.State.Set(ctx, &responseModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -3,11 +3,9 @@ package datasources
 import (
 	"context"
 	"fmt"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
-	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -44,6 +42,7 @@ __Tips:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/subaccount-service-channels>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.DataSourceInstanceAttribute(),
 			"region_host": schema.StringAttribute{
 				MarkdownDescription: "Region Host Name.",
 				Required:            true,
@@ -138,7 +137,6 @@ func (d *SubaccountABAPServiceChannelDataSource) Configure(ctx context.Context,
 
 func (d *SubaccountABAPServiceChannelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data model.SubaccountABAPServiceChannelConfig
-	var respObj apiobjects.SubaccountABAPServiceChannel
 	diags := req.Config.Get(ctx, &data)
 
 	resp.Diagnostics.Append(diags...)
@@ -146,6 +144,8 @@ func (d *SubaccountABAPServiceChannelDataSource) Read(ctx context.Context, req d
 		return
 	}
 
+	ctx = api.WithInstance(ctx, data.Instance.ValueString())
+
 	regionHost := data.RegionHost.ValueString()
 	subaccount := data.Subaccount.ValueString()
 	id := data.ID.ValueInt64()
@@ -157,11 +157,9 @@ func (d *SubaccountABAPServiceChannelDataSource) Read(ctx context.Context, req d
 		serviceChannelType = "ABAPCloud"
 	}
 
-	endpoint := endpoints.GetSubaccountServiceChannelEndpoint(regionHost, subaccount, serviceChannelType, id)
-
-	diags = helpers.RequestAndUnmarshal(d.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := d.Client.ABAPServiceChannels().Get(ctx, regionHost, subaccount, serviceChannelType, id)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
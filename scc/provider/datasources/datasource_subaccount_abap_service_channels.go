@@ -3,11 +3,10 @@ package datasources
 import (
 	"context"
 	"fmt"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
-	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -44,6 +43,7 @@ __Tips:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/subaccount-service-channels>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.DataSourceInstanceAttribute(),
 			"region_host": schema.StringAttribute{
 				MarkdownDescription: "Region Host Name.",
 				Required:            true,
@@ -153,6 +153,8 @@ func (d *SubaccountABAPServiceChannelsDataSource) Read(ctx context.Context, req
 		return
 	}
 
+	ctx = api.WithInstance(ctx, data.Instance.ValueString())
+
 	regionHost := data.RegionHost.ValueString()
 	subaccount := data.Subaccount.ValueString()
 
@@ -163,13 +165,12 @@ func (d *SubaccountABAPServiceChannelsDataSource) Read(ctx context.Context, req
 		serviceChannelType = "ABAPCloud"
 	}
 
-	endpoint := endpoints.GetSubaccountServiceChannelBaseEndpoint(regionHost, subaccount, serviceChannelType)
-
-	diags = helpers.RequestAndUnmarshal(d.Client, &respObj.SubaccountABAPServiceChannels, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	serviceChannels, err := d.Client.ABAPServiceChannels().List(ctx, regionHost, subaccount, serviceChannelType)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
+	respObj.SubaccountABAPServiceChannels = serviceChannels
 
 	responseModel, diags := model.SubaccountABAPServiceChannelsValueFrom(ctx, data, respObj)
 	resp.Diagnostics.Append(diags...)
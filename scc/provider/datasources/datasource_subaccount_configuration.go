@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
@@ -43,6 +41,7 @@ __Tips:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/subaccount>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.DataSourceInstanceAttribute(),
 			"region_host": schema.StringAttribute{
 				MarkdownDescription: "Region Host Name.",
 				Required:            true,
@@ -194,7 +193,6 @@ func (d *SubaccountConfigurationDataSource) Configure(ctx context.Context, req d
 
 func (d *SubaccountConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data model.SubaccountData
-	var respObj apiobjects.Subaccount
 	diags := req.Config.Get(ctx, &data)
 
 	resp.Diagnostics.Append(diags...)
@@ -202,13 +200,13 @@ func (d *SubaccountConfigurationDataSource) Read(ctx context.Context, req dataso
 		return
 	}
 
+	ctx = api.WithInstance(ctx, data.Instance.ValueString())
+
 	regionHost := data.RegionHost.ValueString()
 	subaccount := data.Subaccount.ValueString()
-	endpoint := endpoints.GetSubaccountEndpoint(regionHost, subaccount)
-
-	diags = helpers.RequestAndUnmarshal(d.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := d.Client.Subaccounts().Get(ctx, regionHost, subaccount)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -218,6 +216,8 @@ func (d *SubaccountConfigurationDataSource) Read(ctx context.Context, req dataso
 		return
 	}
 
+	responseModel.Instance = data.Instance
+
 	diags = resp.State.Set(ctx, &responseModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -0,0 +1,177 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ datasource.DataSource = &SubaccountTunnelStatusDataSource{}
+
+func NewSubaccountTunnelStatusDataSource() datasource.DataSource {
+	return &SubaccountTunnelStatusDataSource{}
+}
+
+type SubaccountTunnelStatusDataSource struct {
+	Client *api.RestApiClient
+}
+
+func (d *SubaccountTunnelStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subaccount_tunnel_status"
+}
+
+func (d *SubaccountTunnelStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Subaccount Tunnel Status Data Source.
+
+Reports the state of the tunnel between the Cloud Connector and a subaccount together with its connection statistics. Use it in ` + "`check`" + ` blocks to assert that the tunnel is connected.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Subaccount Administrator
+	* Display
+	* Support
+	* Monitoring
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/monitoring>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.DataSourceInstanceAttribute(),
+			"region_host": schema.StringAttribute{
+				MarkdownDescription: "Region Host Name.",
+				Required:            true,
+			},
+			"subaccount": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "State of the tunnel. Possible values are: \n" +
+					helpers.GetFormattedValueAsTableRow("state", "description") +
+					helpers.GetFormattedValueAsTableRow("---", "---") +
+					helpers.GetFormattedValueAsTableRow("`Connected`", "The tunnel is active and functioning properly.") +
+					helpers.GetFormattedValueAsTableRow("`ConnectFailure`", "The tunnel failed to establish a connection due to an issue.") +
+					helpers.GetFormattedValueAsTableRow("`Disconnected`", "The tunnel was previously connected but is now intentionally or unintentionally disconnected."),
+				Computed: true,
+			},
+			"connected": schema.BoolAttribute{
+				MarkdownDescription: "Whether the tunnel is in state `Connected`.",
+				Computed:            true,
+			},
+			"connected_since": schema.StringAttribute{
+				MarkdownDescription: "Timestamp of the start of the connection.",
+				Computed:            true,
+			},
+			"connections": schema.Int64Attribute{
+				MarkdownDescription: "Number of subaccount connections.",
+				Computed:            true,
+			},
+			"application_connections": schema.ListNestedAttribute{
+				MarkdownDescription: "Connections of the application instances using the tunnel.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"connection_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of active connections to the specified application instance.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the connected application instance.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the connected application instance.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"service_channels": schema.ListNestedAttribute{
+				MarkdownDescription: "Service channels using the tunnel.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the service channel (e.g., HANA, VM, or RFC).",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Current operational state of the service channel.",
+							Computed:            true,
+						},
+						"details": schema.StringAttribute{
+							MarkdownDescription: "Technical details about the service channel.",
+							Computed:            true,
+						},
+						"comment": schema.StringAttribute{
+							MarkdownDescription: "Optional user-provided comment or annotation regarding the service channel.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SubaccountTunnelStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.Client = client
+}
+
+func (d *SubaccountTunnelStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.SubaccountTunnelStatusConfig
+	diags := req.Config.Get(ctx, &data)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, data.Instance.ValueString())
+
+	regionHost := data.RegionHost.ValueString()
+	subaccount := data.Subaccount.ValueString()
+	respObj, err := d.Client.Subaccounts().Get(ctx, regionHost, subaccount)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	responseModel, diags := model.SubaccountTunnelStatusValueFrom(ctx, data, respObj)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,108 @@
+package datasources_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/scc/provider/datasources"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceSubaccountTunnelStatus_Read(t *testing.T) {
+	const endpoint = "/api/v1/configuration/subaccounts/cf.eu12.hana.ondemand.com/11111111-1111-1111-1111-111111111111"
+
+	t.Run("connected tunnel", func(t *testing.T) {
+		state, diags := readSubaccountTunnelStatus(t, map[string]any{
+			endpoint: apiobjects.Subaccount{
+				Tunnel: apiobjects.SubaccountTunnel{
+					State:                   "Connected",
+					ConnectedSinceTimeStamp: 1735689600000,
+					Connections:             5,
+					ApplicationConnections: []apiobjects.SubaccountApplicationConnections{
+						{Name: "orders", Type: "JAVA", ConnectionCount: 3},
+						{Name: "billing", Type: "NODEJS", ConnectionCount: 2},
+					},
+					ServiceChannels: []apiobjects.SubaccountServiceChannels{
+						{Type: "HANA", State: "Connected", Details: "hana-db", Comment: "primary"},
+					},
+				},
+			},
+		})
+		require.False(t, diags.HasError(), diags)
+
+		assert.Equal(t, "Connected", state.State.ValueString())
+		assert.True(t, state.Connected.ValueBool())
+		assert.Equal(t, "2025-01-01 00:00:00 +0000", state.ConnectedSince.ValueString())
+		assert.Equal(t, int64(5), state.Connections.ValueInt64())
+		require.Len(t, state.ApplicationConnections, 2)
+		assert.Equal(t, "orders", state.ApplicationConnections[0].Name.ValueString())
+		assert.Equal(t, int64(3), state.ApplicationConnections[0].ConnectionCount.ValueInt64())
+		require.Len(t, state.ServiceChannels, 1)
+		assert.Equal(t, "HANA", state.ServiceChannels[0].Type.ValueString())
+	})
+
+	t.Run("disconnected tunnel", func(t *testing.T) {
+		state, diags := readSubaccountTunnelStatus(t, map[string]any{
+			endpoint: apiobjects.Subaccount{Tunnel: apiobjects.SubaccountTunnel{State: "Disconnected"}},
+		})
+		require.False(t, diags.HasError(), diags)
+
+		assert.False(t, state.Connected.ValueBool())
+		assert.True(t, state.ConnectedSince.IsNull())
+		assert.Empty(t, state.ApplicationConnections)
+	})
+
+	t.Run("subaccount not found", func(t *testing.T) {
+		_, diags := readSubaccountTunnelStatus(t, map[string]any{})
+
+		assert.True(t, diags.HasError())
+	})
+}
+
+func readSubaccountTunnelStatus(t *testing.T, responses map[string]any) (model.SubaccountTunnelStatusConfig, diag.Diagnostics) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	defer srv.Close()
+
+	ds := &datasources.SubaccountTunnelStatusDataSource{Client: tfutils.NewTestClient(t, srv)}
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+	config := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil),
+	}
+	require.False(t, config.Set(context.Background(), &model.SubaccountTunnelStatusConfig{
+		RegionHost: types.StringValue("cf.eu12.hana.ondemand.com"),
+		Subaccount: types.StringValue("11111111-1111-1111-1111-111111111111"),
+	}).HasError())
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: config.Raw.Copy()}}
+	ds.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, resp)
+
+	var state model.SubaccountTunnelStatusConfig
+	if !resp.Diagnostics.HasError() {
+		require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	}
+	return state, resp.Diagnostics
+}
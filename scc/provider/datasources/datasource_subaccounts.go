@@ -3,11 +3,10 @@ package datasources
 import (
 	"context"
 	"fmt"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
-	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -43,6 +42,7 @@ __Tips:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/subaccount>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.DataSourceInstanceAttribute(),
 			"subaccounts": schema.ListNestedAttribute{
 				MarkdownDescription: "A list of subaccounts associated with the cloud connector. Each entry in the list contains details about a specific subaccount.",
 				Computed:            true,
@@ -99,13 +99,14 @@ func (d *SubaccountsDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	endpoint := endpoints.GetSubaccountBaseEndpoint()
+	ctx = api.WithInstance(ctx, data.Instance.ValueString())
 
-	diags = helpers.RequestAndUnmarshal(d.Client, &respObj.Subaccounts, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	subaccounts, err := d.Client.Subaccounts().List(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
+	respObj.Subaccounts = subaccounts
 
 	responseModel, diags := model.SubaccountsDataSourceValueFrom(respObj)
 	resp.Diagnostics.Append(diags...)
@@ -113,6 +114,8 @@ func (d *SubaccountsDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
+	responseModel.Instance = data.Instance
+
 	diags = resp.State.Set(ctx, &responseModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -0,0 +1,257 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	subjectpatternrules "github.com/SAP/terraform-provider-scc/validation/subjectPatternRules"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ datasource.DataSource = &SubjectPatternPreviewDataSource{}
+
+func NewSubjectPatternPreviewDataSource() datasource.DataSource {
+	return &SubjectPatternPreviewDataSource{}
+}
+
+type SubjectPatternPreviewDataSource struct {
+	Client *api.RestApiClient
+}
+
+func (d *SubjectPatternPreviewDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subject_pattern_preview"
+}
+
+func (d *SubjectPatternPreviewDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Subject Pattern Preview Data Source.
+
+Evaluates the subject pattern rules for a sample principal and returns the subject of the short-lived certificate the Cloud Connector would issue for principal propagation. The rules are evaluated locally in their order and the first rule whose condition the principal fulfills is used, so a change of the rules can be checked before it is applied.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Associate Administrator
+	* Subaccount Administrator
+	* Display
+	* Support
+	* Monitoring
+* If ` + "`rules`" + ` is not set, the subject pattern rules of the Cloud Connector are evaluated.
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/subject-pattern-rules>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.DataSourceInstanceAttribute(),
+			"principal": schema.SingleNestedAttribute{
+				MarkdownDescription: "The sample principal the rules are evaluated for.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the user, available as variable `name`.",
+						Required:            true,
+					},
+					"email": schema.StringAttribute{
+						MarkdownDescription: "The email address of the user, available as variables `email` and `mail`.",
+						Optional:            true,
+					},
+					"user_type": schema.StringAttribute{
+						MarkdownDescription: "The type of the user, available as variable `user_type`. Possible values are `Business` and `Technical`. Defaults to `Business`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("Business", "Technical"),
+						},
+					},
+					"attributes": schema.MapAttribute{
+						MarkdownDescription: "Further attributes of the user, e.g. `display_name` or `login_name`, available as variables of the same name.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "The subject pattern rules to evaluate, in the order of evaluation.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the subject pattern rule.",
+							Optional:            true,
+						},
+						"condition": schema.SingleNestedAttribute{
+							MarkdownDescription: "Condition of the subject pattern rule.",
+							Required:            true,
+							Validators: []validator.Object{
+								subjectpatternrules.SubjectPatternRuleCondition(),
+							},
+							Attributes: map[string]schema.Attribute{
+								"variable": schema.StringAttribute{
+									MarkdownDescription: "Variable of the condition to be evaluated. Not used by operator `always_true`.",
+									Optional:            true,
+								},
+								"operator": schema.StringAttribute{
+									MarkdownDescription: "Operator of the condition. Possible values are `exist`, `exist_not`, `is`, `is_not` and `always_true`.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(
+											"exist",
+											"exist_not",
+											"is",
+											"is_not",
+											"always_true",
+										),
+									},
+								},
+								"value": schema.StringAttribute{
+									MarkdownDescription: "Value of the condition. Required when operator is \"is\" or \"is_not\".",
+									Optional:            true,
+								},
+							},
+						},
+						"subject_pattern": schema.SingleNestedAttribute{
+							MarkdownDescription: "Subject pattern of the subject pattern rule. Variables are referenced as `${variable}`.",
+							Required:            true,
+							Validators: []validator.Object{
+								subjectpatternrules.SubjectPatternAtLeastOneField(),
+							},
+							Attributes: map[string]schema.Attribute{
+								"cn": schema.StringAttribute{
+									MarkdownDescription: "Common Name (CN) of the subject pattern.",
+									Optional:            true,
+								},
+								"email": schema.StringAttribute{
+									MarkdownDescription: "Email (EMAIL) of the subject pattern.",
+									Optional:            true,
+								},
+								"l": schema.StringAttribute{
+									MarkdownDescription: "Locality (L) of the subject pattern.",
+									Optional:            true,
+								},
+								"ou": schema.StringAttribute{
+									MarkdownDescription: "Organization Unit (OU) of the subject pattern.",
+									Optional:            true,
+								},
+								"o": schema.StringAttribute{
+									MarkdownDescription: "Organization (O) of the subject pattern.",
+									Optional:            true,
+								},
+								"st": schema.StringAttribute{
+									MarkdownDescription: "State (ST) of the subject pattern.",
+									Optional:            true,
+								},
+								"c": schema.StringAttribute{
+									MarkdownDescription: "Country (C) of the subject pattern.",
+									Optional:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"matching_rule_index": schema.Int64Attribute{
+				MarkdownDescription: "Index of the first rule whose condition the principal fulfills. Not set if no rule matches.",
+				Computed:            true,
+			},
+			"subject": schema.StringAttribute{
+				MarkdownDescription: "Subject of the certificate issued for the principal, e.g. `CN=jdoe, O=ACME, C=DE`. Not set if no rule matches.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SubjectPatternPreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.Client = client
+}
+
+func (d *SubjectPatternPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.SubjectPatternPreviewConfig
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, data.Instance.ValueString())
+
+	var rules []model.SubjectPatternRule
+	if data.Rules.IsNull() {
+		respObj, err := d.Client.SubjectPatternRules().List(ctx)
+		if err != nil {
+			resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+			return
+		}
+
+		configured, diags := model.SubjectPatternRulesValueFrom(ctx, model.SubjectPatternRulesDataSourceConfig{}, respObj)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		rules = configured.SubjectPatternRules
+	} else {
+		resp.Diagnostics.Append(data.Rules.ElementsAs(ctx, &rules, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var principal model.SubjectPatternPrincipal
+	resp.Diagnostics.Append(data.Principal.As(ctx, &principal, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variables, diags := model.SubjectPatternVariables(ctx, principal)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preview, diags := model.EvaluateSubjectPatternRules(ctx, rules, variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.MatchingRuleIndex = types.Int64Null()
+	data.Subject = types.StringNull()
+	if preview.Index >= 0 {
+		data.MatchingRuleIndex = types.Int64Value(int64(preview.Index))
+		data.Subject = types.StringValue(preview.Subject)
+	}
+
+	if len(preview.Missing) > 0 {
+		slices.Sort(preview.Missing)
+		resp.Diagnostics.AddWarning(
+			"Unresolved Subject Pattern Variables",
+			fmt.Sprintf("The subject pattern of rule %d references variables the principal does not have: %s. They are left empty in the previewed subject.", preview.Index, strings.Join(slices.Compact(preview.Missing), ", ")),
+		)
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,150 @@
+package datasources_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/scc/provider/datasources"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const subjectPatternRulesResponse = `[
+	{"description":"technical users","condition":"user_type is Technical","subjectPattern":{"CN":"${name}","OU":"Technical Users","O":"ACME"}},
+	{"description":"","condition":"always true","subjectPattern":{"CN":"${name}","EMAIL":"${mail}","C":"DE"}}
+]`
+
+func TestDataSourceSubjectPatternPreview_Read(t *testing.T) {
+	t.Run("rules of the Cloud Connector", func(t *testing.T) {
+		state, diags := readSubjectPatternPreview(t, subjectPatternPrincipal("jdoe", types.StringValue("john.doe@example.com"), types.StringNull(), nil), types.ListNull(subjectPatternRuleType))
+		require.False(t, diags.HasError(), diags)
+		assert.Empty(t, diags)
+
+		assert.Equal(t, int64(1), state.MatchingRuleIndex.ValueInt64())
+		assert.Equal(t, "CN=jdoe, EMAIL=john.doe@example.com, C=DE", state.Subject.ValueString())
+	})
+
+	t.Run("technical user", func(t *testing.T) {
+		state, diags := readSubjectPatternPreview(t, subjectPatternPrincipal("batch", types.StringNull(), types.StringValue("Technical"), nil), types.ListNull(subjectPatternRuleType))
+		require.False(t, diags.HasError(), diags)
+
+		assert.Equal(t, int64(0), state.MatchingRuleIndex.ValueInt64())
+		assert.Equal(t, "CN=batch, OU=Technical Users, O=ACME", state.Subject.ValueString())
+	})
+
+	t.Run("unresolved variable", func(t *testing.T) {
+		state, diags := readSubjectPatternPreview(t, subjectPatternPrincipal("jdoe", types.StringNull(), types.StringNull(), nil), types.ListNull(subjectPatternRuleType))
+		require.False(t, diags.HasError(), diags)
+
+		assert.Equal(t, "CN=jdoe, C=DE", state.Subject.ValueString())
+		require.Len(t, diags, 1)
+		assert.Equal(t, "Unresolved Subject Pattern Variables", diags[0].Summary())
+		assert.Contains(t, diags[0].Detail(), "mail")
+	})
+
+	t.Run("given rules", func(t *testing.T) {
+		rules := types.ListValueMust(subjectPatternRuleType, []attr.Value{
+			subjectPatternRule("display_name", "exist_not", "", map[string]string{"cn": "${name}"}),
+			subjectPatternRule("display_name", "is_not", "Admin", map[string]string{"cn": "${display_name}", "o": "ACME"}),
+		})
+		state, diags := readSubjectPatternPreview(t, subjectPatternPrincipal("jdoe", types.StringNull(), types.StringNull(), map[string]string{"display_name": "John Doe"}), rules)
+		require.False(t, diags.HasError(), diags)
+
+		assert.Equal(t, int64(1), state.MatchingRuleIndex.ValueInt64())
+		assert.Equal(t, "CN=John Doe, O=ACME", state.Subject.ValueString())
+	})
+
+	t.Run("no matching rule", func(t *testing.T) {
+		rules := types.ListValueMust(subjectPatternRuleType, []attr.Value{
+			subjectPatternRule("user_type", "is", "Technical", map[string]string{"cn": "${name}"}),
+		})
+		state, diags := readSubjectPatternPreview(t, subjectPatternPrincipal("jdoe", types.StringNull(), types.StringNull(), nil), rules)
+		require.False(t, diags.HasError(), diags)
+
+		assert.True(t, state.MatchingRuleIndex.IsNull())
+		assert.True(t, state.Subject.IsNull())
+	})
+}
+
+var subjectPatternRuleType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"description":     types.StringType,
+	"condition":       types.ObjectType{AttrTypes: model.SubjectPatternConditionType},
+	"subject_pattern": types.ObjectType{AttrTypes: model.SubjectPatternType},
+}}
+
+func subjectPatternPrincipal(name string, email, userType types.String, attributes map[string]string) types.Object {
+	attributesValue := types.MapNull(types.StringType)
+	if attributes != nil {
+		attributesValue, _ = types.MapValueFrom(context.Background(), types.StringType, attributes)
+	}
+	return types.ObjectValueMust(model.SubjectPatternPrincipalType, map[string]attr.Value{
+		"name":       types.StringValue(name),
+		"email":      email,
+		"user_type":  userType,
+		"attributes": attributesValue,
+	})
+}
+
+func subjectPatternRule(variable, operator, value string, subjectPattern map[string]string) attr.Value {
+	fields := map[string]attr.Value{}
+	for field := range model.SubjectPatternType {
+		fields[field] = types.StringNull()
+		if pattern, ok := subjectPattern[field]; ok {
+			fields[field] = types.StringValue(pattern)
+		}
+	}
+	conditionValue := types.StringNull()
+	if value != "" {
+		conditionValue = types.StringValue(value)
+	}
+	return types.ObjectValueMust(subjectPatternRuleType.AttrTypes, map[string]attr.Value{
+		"description": types.StringNull(),
+		"condition": types.ObjectValueMust(model.SubjectPatternConditionType, map[string]attr.Value{
+			"variable": types.StringValue(variable),
+			"operator": types.StringValue(operator),
+			"value":    conditionValue,
+		}),
+		"subject_pattern": types.ObjectValueMust(model.SubjectPatternType, fields),
+	})
+}
+
+func readSubjectPatternPreview(t *testing.T, principal types.Object, rules types.List) (model.SubjectPatternPreviewConfig, diag.Diagnostics) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, rules.IsNull(), "the rules of the Cloud Connector must not be read if rules are given")
+		assert.Equal(t, "/api/v1/configuration/connector/onPremises/subjectPatternRules", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, subjectPatternRulesResponse)
+	}))
+	defer srv.Close()
+
+	ds := &datasources.SubjectPatternPreviewDataSource{Client: tfutils.NewTestClient(t, srv)}
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+	config := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil),
+	}
+	require.False(t, config.Set(context.Background(), &model.SubjectPatternPreviewConfig{Principal: principal, Rules: rules}).HasError())
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: config.Raw.Copy()}}
+	ds.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, resp)
+
+	var state model.SubjectPatternPreviewConfig
+	if !resp.Diagnostics.HasError() {
+		require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	}
+	return state, resp.Diagnostics
+}
@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -43,6 +41,7 @@ __Tips:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/proxy-settings>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.DataSourceInstanceAttribute(),
 			"index": schema.Int64Attribute{
 				MarkdownDescription: "Index of the subject pattern rule to retrieve.",
 				Required:            true,
@@ -128,7 +127,6 @@ func (d *SubjectPatternRuleDataSource) Configure(ctx context.Context, req dataso
 
 func (d *SubjectPatternRuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data model.SubjectPatternRuleConfig
-	var respObj apiobjects.SubjectPatternRules
 	diags := req.Config.Get(ctx, &data)
 
 	resp.Diagnostics.Append(diags...)
@@ -136,11 +134,11 @@ func (d *SubjectPatternRuleDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
-	endpoint := endpoints.GetSubjectPatternRulesBaseEndpoint()
+	ctx = api.WithInstance(ctx, data.Instance.ValueString())
 
-	diags = helpers.RequestAndUnmarshal(d.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := d.Client.SubjectPatternRules().List(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -43,6 +41,7 @@ __Tips:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/proxy-settings>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.DataSourceInstanceAttribute(),
 			"subject_pattern_rules": schema.ListNestedAttribute{
 				MarkdownDescription: "List of subject pattern rules.",
 				Computed:            true,
@@ -132,7 +131,6 @@ func (d *SubjectPatternRulesDataSource) Configure(ctx context.Context, req datas
 
 func (d *SubjectPatternRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data model.SubjectPatternRulesDataSourceConfig
-	var respObj apiobjects.SubjectPatternRules
 	diags := req.Config.Get(ctx, &data)
 
 	resp.Diagnostics.Append(diags...)
@@ -140,11 +138,11 @@ func (d *SubjectPatternRulesDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
-	endpoint := endpoints.GetSubjectPatternRulesBaseEndpoint()
+	ctx = api.WithInstance(ctx, data.Instance.ValueString())
 
-	diags = helpers.RequestAndUnmarshal(d.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := d.Client.SubjectPatternRules().List(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
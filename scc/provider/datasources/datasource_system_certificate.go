@@ -7,8 +7,6 @@ import (
 	"regexp"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -43,6 +41,7 @@ __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/system-certificate-apis#get-description-for-a-system-certificate>
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/system-certificate-apis#get-binary-content-of-a-system-certificate>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.DataSourceInstanceAttribute(),
 			"valid_to": schema.StringAttribute{
 				MarkdownDescription: "Timestamp of the end of the validity period.",
 				Computed:            true,
@@ -130,7 +129,6 @@ func (d *SystemCertificateDataSource) Configure(ctx context.Context, req datasou
 
 func (d *SystemCertificateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data model.SystemCertificateDataSourceConfig
-	var respObj apiobjects.Certificate
 	diags := req.Config.Get(ctx, &data)
 
 	resp.Diagnostics.Append(diags...)
@@ -138,19 +136,19 @@ func (d *SystemCertificateDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
-	endpoint := endpoints.GetSystemCertificateEndpoint()
+	ctx = api.WithInstance(ctx, data.Instance.ValueString())
 
 	// Get Certificate Metadata
-	diags = helpers.RequestAndUnmarshal(d.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := d.Client.SystemCertificate().Get(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
 	// Generate Binary Certificate
-	certBytes, diags := helpers.GetCertificateBinaryFunc(d.Client, endpoint)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	certBytes, err := d.Client.SystemCertificate().Download(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -165,6 +163,8 @@ func (d *SystemCertificateDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
+	responseModel.Instance = data.Instance
+
 	responseModel.CertificatePEM = types.StringValue(string(pemBytes))
 
 	diags = resp.State.Set(ctx, &responseModel)
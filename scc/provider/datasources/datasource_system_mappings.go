@@ -7,7 +7,6 @@ import (
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/SAP/terraform-provider-scc/validation/systemMapping"
@@ -48,6 +47,7 @@ __Tips:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/system-mappings>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.DataSourceInstanceAttribute(),
 			"region_host": schema.StringAttribute{
 				MarkdownDescription: "Region Host Name.",
 				Required:            true,
@@ -307,15 +307,16 @@ func (d *SystemMappingsDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
+	ctx = api.WithInstance(ctx, data.Instance.ValueString())
+
 	regionHost := data.RegionHost.ValueString()
 	subaccount := data.Subaccount.ValueString()
-	endpoint := endpoints.GetSystemMappingBaseEndpoint(regionHost, subaccount)
-
-	diags = helpers.RequestAndUnmarshal(d.Client, &respObj.SystemMappings, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	systemMappings, err := d.Client.SystemMappings().List(ctx, regionHost, subaccount)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
+	respObj.SystemMappings = systemMappings
 
 	responseModel, diags := model.SystemMappingsValueFrom(ctx, data, respObj)
 	resp.Diagnostics.Append(diags...)
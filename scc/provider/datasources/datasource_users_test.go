@@ -0,0 +1,99 @@
+package datasources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataSourceUsers(t *testing.T) {
+	t.Parallel()
+
+	setupUsers := func(t *testing.T) (*sccmock.Server, tfutils.User) {
+		srv, user := tfutils.SetupMock(t)
+		srv.Update(func(state *sccmock.State) {
+			state.Users = append(state.Users,
+				&sccmock.User{Name: "jdoe", Password: "secret", Description: "John Doe", Roles: []string{"sccdisplay", "sccmonitoring"}},
+				&sccmock.User{Name: "operator", Password: "secret", Roles: []string{"sccsupport", "sccdisplay"}},
+			)
+		})
+		return srv, user
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		srv, user := setupUsers(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + DataSourceUsers("scc_users"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.scc_users.scc_users", "users.#", "3"),
+						resource.TestCheckResourceAttr("data.scc_users.scc_users", "users.0.name", sccmock.Username),
+						resource.TestCheckNoResourceAttr("data.scc_users.scc_users", "users.0.description"),
+						resource.TestCheckResourceAttr("data.scc_users.scc_users", "users.0.roles.#", "1"),
+						resource.TestCheckTypeSetElemAttr("data.scc_users.scc_users", "users.0.roles.*", "administrator"),
+						resource.TestCheckResourceAttr("data.scc_users.scc_users", "users.1.name", "jdoe"),
+						resource.TestCheckResourceAttr("data.scc_users.scc_users", "users.1.description", "John Doe"),
+						resource.TestCheckResourceAttr("data.scc_users.scc_users", "users.1.roles.#", "2"),
+						resource.TestCheckTypeSetElemAttr("data.scc_users.scc_users", "users.1.roles.*", "display"),
+						resource.TestCheckTypeSetElemAttr("data.scc_users.scc_users", "users.1.roles.*", "monitoring"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - users with role", func(t *testing.T) {
+		srv, user := setupUsers(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + DataSourceUsersWithRole("scc_users", "display"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.scc_users.scc_users", "users.#", "2"),
+						resource.TestCheckResourceAttr("data.scc_users.scc_users", "users.0.name", "jdoe"),
+						resource.TestCheckResourceAttr("data.scc_users.scc_users", "users.1.name", "operator"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid role", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      DataSourceUsersWithRole("scc_users", "sccdisplay"),
+					ExpectError: regexp.MustCompile(`Attribute role value must be one of`),
+				},
+			},
+		})
+	})
+}
+
+func DataSourceUsers(datasourceName string) string {
+	return fmt.Sprintf(`
+	data "scc_users" "%s" {
+	}
+	`, datasourceName)
+}
+
+func DataSourceUsersWithRole(datasourceName string, role string) string {
+	return fmt.Sprintf(`
+	data "scc_users" "%s" {
+		role = "%s"
+	}
+	`, datasourceName, role)
+}
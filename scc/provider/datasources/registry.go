@@ -22,5 +22,11 @@ func All() []func() datasource.DataSource {
 		NewBackendTrustStoreDataSource,
 		NewSubjectPatternRulesDataSource,
 		NewSubjectPatternRuleDataSource,
+		NewHAStatusDataSource,
+		NewAuditLogEntriesDataSource,
+		NewSubaccountTunnelStatusDataSource,
+		NewConnectorVersionDataSource,
+		NewUsersDataSource,
+		NewSubjectPatternPreviewDataSource,
 	}
 }
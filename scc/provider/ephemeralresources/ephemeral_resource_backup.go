@@ -0,0 +1,110 @@
+package ephemeralresources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &BackupEphemeralResource{}
+
+func NewBackupEphemeralResource() ephemeral.EphemeralResource {
+	return &BackupEphemeralResource{}
+}
+
+type BackupEphemeralResource struct {
+	Client *api.RestApiClient
+}
+
+func (r *BackupEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup"
+}
+
+func (r *BackupEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Backup Ephemeral Resource.
+
+Creates a backup of the cloud connector instance and returns the archive as base64 encoded content. The backup is neither stored in the Terraform state nor written to disk, so it can be passed on to ephemeral or write-only attributes of other providers.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/backup>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.EphemeralResourceInstanceAttribute(),
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password used to protect the backup archive.",
+				Required:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The backup archive (ZIP) as base64 encoded content.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"sha256": schema.StringAttribute{
+				MarkdownDescription: "The hex encoded SHA-256 checksum of the backup archive.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *BackupEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = client
+}
+
+func (r *BackupEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data model.BackupEphemeralResourceConfig
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, data.Instance.ValueString())
+
+	backupBytes, diags := helpers.DownloadBackupFunc(ctx, r.Client, data.Password.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checksum := sha256.Sum256(backupBytes)
+	data.Content = types.StringValue(base64.StdEncoding.EncodeToString(backupBytes))
+	data.SHA256 = types.StringValue(hex.EncodeToString(checksum[:]))
+
+	diags = resp.Result.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,130 @@
+package ephemeralresources_test
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/scc/provider/ephemeralresources"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupEphemeralResource_Metadata(t *testing.T) {
+	r := ephemeralresources.NewBackupEphemeralResource()
+	resp := &ephemeral.MetadataResponse{}
+	r.Metadata(context.Background(), ephemeral.MetadataRequest{ProviderTypeName: "scc"}, resp)
+	assert.Equal(t, "scc_backup", resp.TypeName)
+}
+
+func TestBackupEphemeralResource_Schema_SensitiveAttributes(t *testing.T) {
+	r := ephemeralresources.NewBackupEphemeralResource()
+	resp := &ephemeral.SchemaResponse{}
+	r.Schema(context.Background(), ephemeral.SchemaRequest{}, resp)
+
+	for _, name := range []string{"password", "content"} {
+		attr, ok := resp.Schema.Attributes[name]
+		require.True(t, ok, "attribute %q missing", name)
+		assert.True(t, attr.IsSensitive(), "attribute %q should be sensitive", name)
+	}
+}
+
+func TestBackupEphemeralResource_Configure(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		r := ephemeralresources.NewBackupEphemeralResource().(*ephemeralresources.BackupEphemeralResource)
+		client := &api.RestApiClient{}
+		resp := &ephemeral.ConfigureResponse{}
+		r.Configure(context.Background(), ephemeral.ConfigureRequest{ProviderData: client}, resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.Equal(t, client, r.Client)
+	})
+
+	t.Run("nil provider data", func(t *testing.T) {
+		r := ephemeralresources.NewBackupEphemeralResource().(*ephemeralresources.BackupEphemeralResource)
+		resp := &ephemeral.ConfigureResponse{}
+		r.Configure(context.Background(), ephemeral.ConfigureRequest{ProviderData: nil}, resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.Nil(t, r.Client)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		r := ephemeralresources.NewBackupEphemeralResource().(*ephemeralresources.BackupEphemeralResource)
+		resp := &ephemeral.ConfigureResponse{}
+		r.Configure(context.Background(), ephemeral.ConfigureRequest{ProviderData: "wrong-type"}, resp)
+
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+}
+
+func TestBackupEphemeralResource_Open_Success(t *testing.T) {
+	zipBytes := "PK\x03\x04zip-content"
+	var sentBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/configuration/backup", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		sentBody = string(body)
+		w.Header().Set("Content-Type", "application/zip")
+		_, _ = w.Write([]byte(zipBytes))
+	}))
+	defer server.Close()
+
+	resp := openBackup(t, tfutils.NewTestClient(t, server), "vault-password")
+
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.JSONEq(t, `{"password":"vault-password"}`, sentBody)
+
+	var result model.BackupEphemeralResourceConfig
+	require.False(t, resp.Result.Get(context.Background(), &result).HasError())
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte(zipBytes)), result.Content.ValueString())
+	assert.Len(t, result.SHA256.ValueString(), 64)
+}
+
+func TestBackupEphemeralResource_Open_InvalidContentType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	resp := openBackup(t, tfutils.NewTestClient(t, server), "vault-password")
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Unexpected Content-Type", resp.Diagnostics.Errors()[0].Summary())
+}
+
+func openBackup(t *testing.T, client *api.RestApiClient, password string) *ephemeral.OpenResponse {
+	t.Helper()
+	r := &ephemeralresources.BackupEphemeralResource{Client: client}
+
+	schemaResp := &ephemeral.SchemaResponse{}
+	r.Schema(context.Background(), ephemeral.SchemaRequest{}, schemaResp)
+	schemaType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+			"instance": tftypes.NewValue(tftypes.String, nil),
+			"password": tftypes.NewValue(tftypes.String, password),
+			"content":  tftypes.NewValue(tftypes.String, nil),
+			"sha256":   tftypes.NewValue(tftypes.String, nil),
+		}),
+	}
+
+	resp := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, nil)},
+	}
+	r.Open(context.Background(), ephemeral.OpenRequest{Config: config}, resp)
+	return resp
+}
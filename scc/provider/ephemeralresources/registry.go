@@ -0,0 +1,9 @@
+package ephemeralresources
+
+import "github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+func All() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewBackupEphemeralResource,
+	}
+}
@@ -1,131 +1,18 @@
 package helpers
 
 import (
-	"encoding/json"
-	"fmt"
-	"io"
-	"net/http"
 	"strconv"
 	"time"
 
-	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
 	"github.com/hashicorp/terraform-plugin-framework/action"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-const (
-	ActionGetRequest    = "Read"
-	ActionCreateRequest = "Create"
-	ActionUpdateRequest = "Update"
-	ActionDeleteRequest = "Delete"
-	ActionPatchRequest  = "Patch"
-)
-
 type FormattedTimes struct {
 	UTC          types.String
 	WithTimezone types.String
 }
 
-// Wrappers for testing purposes (allows mocking in tests)
-var RequestAndUnmarshalCertificateFunc = func(
-	client *api.RestApiClient,
-	respObj *apiobjects.Certificate,
-	method string,
-	endpoint string,
-	body map[string]any,
-	expectJSON bool,
-) diag.Diagnostics {
-	return RequestAndUnmarshal(client, respObj, method, endpoint, body, expectJSON)
-}
-
-var SendRequestFunc = sendRequest
-
-func sendRequest(client *api.RestApiClient, planBody map[string]any, endpoint string, action string) (*http.Response, diag.Diagnostics) {
-	var response *http.Response
-	var diags diag.Diagnostics
-	var requestByteBody []byte
-	var err error
-
-	if planBody != nil {
-		requestByteBody, err = json.Marshal(planBody)
-		if err != nil {
-			diags.AddError("Failed to Marshal Request Body", fmt.Sprintf("failed to marshal API request body from plan: %v", err))
-			return nil, diags
-		}
-	}
-
-	switch action {
-	case ActionGetRequest:
-		response, diags = client.GetRequest(endpoint)
-	case ActionCreateRequest:
-		response, diags = client.PostRequest(endpoint, requestByteBody)
-	case ActionUpdateRequest:
-		response, diags = client.PutRequest(endpoint, requestByteBody)
-	case ActionDeleteRequest:
-		response, diags = client.DeleteRequest(endpoint)
-	case ActionPatchRequest:
-		response, diags = client.PatchRequest(endpoint, requestByteBody)
-	default:
-		diags.AddError("Invalid Action", fmt.Sprintf("unsupported action type: %s", action))
-		return nil, diags
-	}
-
-	if diags.HasError() {
-		return nil, diags
-	}
-
-	return response, diags
-}
-
-func RequestAndUnmarshal[T any](client *api.RestApiClient, respObj *T, requestType string, endpoint string, planBody map[string]any, marshalResponse bool) diag.Diagnostics {
-	var response *http.Response
-	var diags diag.Diagnostics
-	switch requestType {
-	case "GET":
-		response, diags = sendRequest(client, nil, endpoint, ActionGetRequest)
-	case "POST":
-		response, diags = sendRequest(client, planBody, endpoint, ActionCreateRequest)
-	case "PUT":
-		response, diags = sendRequest(client, planBody, endpoint, ActionUpdateRequest)
-	case "DELETE":
-		response, diags = sendRequest(client, nil, endpoint, ActionDeleteRequest)
-	case "PATCH":
-		response, diags = sendRequest(client, planBody, endpoint, ActionPatchRequest)
-	default:
-		diags.AddError("Invalid Request Type", fmt.Sprintf("unsupported request type: %s", requestType))
-		return diags
-	}
-
-	if diags.HasError() {
-		return diags
-	}
-
-	defer func() {
-		if err := response.Body.Close(); err != nil {
-			diags.AddError("Failed to Close Response Body", fmt.Sprintf("failed to close response body: %v", err))
-		}
-	}()
-
-	if marshalResponse {
-		body, err := io.ReadAll(response.Body)
-		if err != nil {
-			diags.AddError("Failed to Read Response Body", fmt.Sprintf("failed to read API response body: %v", err))
-			return diags
-		}
-
-		err = json.Unmarshal(body, respObj)
-		if err != nil {
-			diags.AddError("Failed to Unmarshal Response Body", fmt.Sprintf("failed to unmarshal API response body: %v", err))
-			return diags
-		}
-	}
-
-	return diags
-
-}
-
 func ConvertMillisToTimes(millis any) FormattedTimes {
 	var ms int64
 	switch v := millis.(type) {
@@ -173,3 +60,12 @@ func StringValueOrNull(s string) types.String {
 	}
 	return types.StringValue(s)
 }
+
+// BoolPointerOrNil returns nil for null or unknown values, so that optional
+// attributes are omitted from request bodies.
+func BoolPointerOrNil(b types.Bool) *bool {
+	if b.IsNull() || b.IsUnknown() {
+		return nil
+	}
+	return b.ValueBoolPointer()
+}
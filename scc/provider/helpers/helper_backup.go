@@ -0,0 +1,231 @@
+package helpers
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Wrappers for testing purposes (allows mocking in tests)
+var DownloadBackupFunc = downloadBackup
+var ReadBackupArchiveFunc = readBackupArchive
+var UploadBackupFunc = uploadBackup
+
+// BackupSink stores backup archives created by the scc_create_backup action.
+type BackupSink interface {
+	// Create opens the backup file at path for writing, replacing an existing file.
+	Create(path string, mode os.FileMode) (io.WriteCloser, error)
+	// List returns the paths of all backup files matching the glob pattern.
+	List(pattern string) ([]string, error)
+	// Remove deletes the backup file at path.
+	Remove(path string) error
+}
+
+// LocalBackupSink writes backup archives to the local filesystem.
+type LocalBackupSink struct{}
+
+var _ BackupSink = LocalBackupSink{}
+
+func (LocalBackupSink) Create(path string, mode os.FileMode) (io.WriteCloser, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	// OpenFile applies the umask and keeps the mode of an existing file.
+	if err := f.Chmod(mode); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (LocalBackupSink) List(pattern string) ([]string, error) {
+	return filepath.Glob(pattern)
+}
+
+func (LocalBackupSink) Remove(path string) error {
+	return os.Remove(path)
+}
+
+// BackupTimestampPlaceholder is replaced with the creation time of a backup in
+// the name of a backup file, formatted with BackupTimestampLayout.
+const (
+	BackupTimestampPlaceholder = "{timestamp}"
+	BackupTimestampLayout      = "20060102_150405"
+)
+
+// PruneBackups removes all but the newest keep backup files in directory that
+// are named after fileName. Only files with a creation time in place of
+// BackupTimestampPlaceholder are considered, other files are never removed.
+func PruneBackups(sink BackupSink, directory string, fileName string, keep int) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parts := strings.Split(fileName, BackupTimestampPlaceholder)
+	if len(parts) == 1 {
+		// Without a timestamp every backup replaces the previous one.
+		return nil, diags
+	}
+
+	quoted := make([]string, len(parts))
+	escaped := make([]string, len(parts))
+	for i, part := range parts {
+		quoted[i] = regexp.QuoteMeta(part)
+		escaped[i] = escapeGlob(part)
+	}
+	namePattern := regexp.MustCompile("^" + strings.Join(quoted, `(\d{8}_\d{6})`) + "$")
+	pattern := filepath.Join(escapeGlob(directory), strings.Join(escaped, "*"))
+
+	files, err := sink.List(pattern)
+	if err != nil {
+		diags.AddError(
+			"Failed to List Backup Files",
+			fmt.Sprintf("An error occurred while listing backup files matching %q: %v", pattern, err),
+		)
+		return nil, diags
+	}
+
+	type backupFile struct {
+		path    string
+		created time.Time
+	}
+
+	var backups []backupFile
+	for _, file := range files {
+		if created, ok := backupCreationTime(namePattern, filepath.Base(file)); ok {
+			backups = append(backups, backupFile{path: file, created: created})
+		}
+	}
+
+	if len(backups) <= keep {
+		return nil, diags
+	}
+
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i].created.Before(backups[j].created)
+	})
+
+	var removed []string
+	for _, backup := range backups[:len(backups)-keep] {
+		if err := sink.Remove(backup.path); err != nil {
+			diags.AddWarning(
+				"Failed to Remove Old Backup File",
+				fmt.Sprintf("An error occurred while removing the backup file %q: %v", backup.path, err),
+			)
+			continue
+		}
+		removed = append(removed, backup.path)
+	}
+
+	return removed, diags
+}
+
+// backupCreationTime returns the creation time of a backup file named after
+// namePattern. All timestamps in the name must be valid and identical.
+func backupCreationTime(namePattern *regexp.Regexp, name string) (time.Time, bool) {
+	match := namePattern.FindStringSubmatch(name)
+	if match == nil {
+		return time.Time{}, false
+	}
+
+	for _, timestamp := range match[2:] {
+		if timestamp != match[1] {
+			return time.Time{}, false
+		}
+	}
+
+	created, err := time.Parse(BackupTimestampLayout, match[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return created, true
+}
+
+// escapeGlob escapes the metacharacters of filepath.Match in s. Character
+// classes are used instead of backslashes, which are path separators on Windows.
+func escapeGlob(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '*' || r == '?' || r == '[':
+			b.WriteString("[" + string(r) + "]")
+		case r == '\\' && runtime.GOOS != "windows":
+			b.WriteString(`[\\]`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// downloadBackup creates a backup of the Cloud Connector configuration protected
+// with password and returns the ZIP archive.
+func downloadBackup(ctx context.Context, client *api.RestApiClient, password string) ([]byte, diag.Diagnostics) {
+	backupBytes, err := client.Backup().Create(ctx, password)
+	return backupBytes, api.ErrorDiagnostics(err)
+}
+
+// readBackupArchive reads the backup file from disk and verifies that it is a
+// readable, non-empty ZIP archive before it is sent to the Cloud Connector.
+func readBackupArchive(path string) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	backupBytes, err := os.ReadFile(path)
+	if err != nil {
+		diags.AddError(
+			"Failed to Read Backup File",
+			fmt.Sprintf("An error occurred while reading the backup file %q: %v", path, err),
+		)
+		return nil, diags
+	}
+
+	if len(backupBytes) == 0 {
+		diags.AddError(
+			"Empty Backup File",
+			fmt.Sprintf("The backup file %q is empty.", path),
+		)
+		return nil, diags
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(backupBytes), int64(len(backupBytes)))
+	if err != nil {
+		diags.AddError(
+			"Invalid Backup Archive",
+			fmt.Sprintf("The backup file %q is not a valid ZIP archive: %v", path, err),
+		)
+		return nil, diags
+	}
+
+	if len(archive.File) == 0 {
+		diags.AddError(
+			"Invalid Backup Archive",
+			fmt.Sprintf("The backup file %q does not contain any entries.", path),
+		)
+		return nil, diags
+	}
+
+	return backupBytes, diags
+}
+
+// uploadBackup restores the Cloud Connector configuration from the backup
+// archive read from fileName, protected with password.
+func uploadBackup(ctx context.Context, client *api.RestApiClient, fileName string, backupBytes []byte, password string) diag.Diagnostics {
+	return api.ErrorDiagnostics(client.Backup().Restore(ctx, fileName, backupBytes, password))
+}
@@ -0,0 +1,254 @@
+package helpers_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestBackupArchive(t *testing.T) string {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("backup.properties")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("content"))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	path := filepath.Join(t.TempDir(), "scc_backup.zip")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0600))
+	return path
+}
+
+func TestReadBackupArchive(t *testing.T) {
+	t.Run("valid archive", func(t *testing.T) {
+		path := writeTestBackupArchive(t)
+
+		backupBytes, diags := helpers.ReadBackupArchiveFunc(path)
+
+		assert.False(t, diags.HasError())
+		assert.NotEmpty(t, backupBytes)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, diags := helpers.ReadBackupArchiveFunc(filepath.Join(t.TempDir(), "missing.zip"))
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Failed to Read Backup File", diags.Errors()[0].Summary())
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "empty.zip")
+		require.NoError(t, os.WriteFile(path, nil, 0600))
+
+		_, diags := helpers.ReadBackupArchiveFunc(path)
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Empty Backup File", diags.Errors()[0].Summary())
+	})
+
+	t.Run("not a zip archive", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "backup.zip")
+		require.NoError(t, os.WriteFile(path, []byte("not a zip"), 0600))
+
+		_, diags := helpers.ReadBackupArchiveFunc(path)
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Invalid Backup Archive", diags.Errors()[0].Summary())
+	})
+}
+
+func TestUploadBackup_Success(t *testing.T) {
+	expectedBytes := []byte("PK\x03\x04backup")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v1/configuration/backup", r.URL.Path)
+
+		err := r.ParseMultipartForm(10 << 20)
+		require.NoError(t, err)
+
+		assert.Equal(t, "backup-pass", r.FormValue("password"))
+
+		file, header, err := r.FormFile("backup")
+		require.NoError(t, err)
+		defer func() {
+			if err := file.Close(); err != nil {
+				t.Errorf("failed to close file: %v", err)
+			}
+		}()
+
+		body, err := io.ReadAll(file)
+		require.NoError(t, err)
+
+		assert.Equal(t, "scc_backup.zip", header.Filename)
+		assert.Equal(t, expectedBytes, body)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := tfutils.NewTestClient(t, server)
+
+	diags := helpers.UploadBackupFunc(
+		context.Background(),
+		client,
+		"/tmp/backups/scc_backup.zip",
+		expectedBytes,
+		"backup-pass",
+	)
+
+	assert.False(t, diags.HasError())
+}
+
+func TestDownloadBackup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/configuration/backup", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"password":"backup-pass"}`, string(body))
+
+		w.Header().Set("Content-Type", "application/zip")
+		_, _ = w.Write([]byte("zip"))
+	}))
+	defer server.Close()
+
+	backup, diags := helpers.DownloadBackupFunc(context.Background(), tfutils.NewTestClient(t, server), "backup-pass")
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []byte("zip"), backup)
+}
+
+func TestDownloadBackup_UnexpectedContentType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	backup, diags := helpers.DownloadBackupFunc(context.Background(), tfutils.NewTestClient(t, server), "backup-pass")
+
+	assert.Nil(t, backup)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Unexpected Content-Type", diags.Errors()[0].Summary())
+}
+
+func TestUploadBackup_HTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		if _, err := w.Write([]byte("WRONG_PASSWORD")); err != nil {
+			t.Fatalf("write failed: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := tfutils.NewTestClient(t, server)
+
+	diags := helpers.UploadBackupFunc(
+		context.Background(),
+		client,
+		"scc_backup.zip",
+		[]byte("data"),
+		"pass",
+	)
+
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "WRONG_PASSWORD")
+}
+
+func TestLocalBackupSink(t *testing.T) {
+	sink := helpers.LocalBackupSink{}
+	dir := filepath.Join(t.TempDir(), "nested", "backups")
+	path := filepath.Join(dir, "scc_backup.zip")
+
+	// An existing world-readable file must be tightened to the requested mode.
+	require.NoError(t, os.MkdirAll(dir, 0o750))
+	require.NoError(t, os.WriteFile(path, []byte("old"), 0o644))
+
+	w, err := sink.Create(path, 0o600)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("new"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(data))
+
+	files, err := sink.List(filepath.Join(dir, "*.zip"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{path}, files)
+
+	require.NoError(t, sink.Remove(path))
+	assert.NoFileExists(t, path)
+}
+
+func TestPruneBackups(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{
+		"scc_backup_20240103_000000.zip",
+		"scc_backup_20240101_000000.zip",
+		"scc_backup_20240102_000000.zip",
+		"scc_backup_important.zip",
+		"scc_backup_20241399_000000.zip",
+		"other.zip",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("zip"), 0o600))
+	}
+
+	removed, diags := helpers.PruneBackups(helpers.LocalBackupSink{}, dir, "scc_backup_{timestamp}.zip", 1)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []string{
+		filepath.Join(dir, "scc_backup_20240101_000000.zip"),
+		filepath.Join(dir, "scc_backup_20240102_000000.zip"),
+	}, removed)
+	assert.FileExists(t, filepath.Join(dir, "scc_backup_20240103_000000.zip"))
+	assert.FileExists(t, filepath.Join(dir, "scc_backup_important.zip"))
+	assert.FileExists(t, filepath.Join(dir, "scc_backup_20241399_000000.zip"))
+	assert.FileExists(t, filepath.Join(dir, "other.zip"))
+
+	removed, diags = helpers.PruneBackups(helpers.LocalBackupSink{}, dir, "scc_backup_{timestamp}.zip", 5)
+	assert.False(t, diags.HasError())
+	assert.Empty(t, removed)
+
+	removed, diags = helpers.PruneBackups(helpers.LocalBackupSink{}, dir, "scc_backup.zip", 0)
+	assert.False(t, diags.HasError())
+	assert.Empty(t, removed)
+}
+
+func TestPruneBackups_GlobMetacharacters(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "[backups]")
+	require.NoError(t, os.MkdirAll(dir, 0o750))
+	for _, name := range []string{
+		"scc*_20240101_000000.zip",
+		"scc*_20240102_000000.zip",
+		"scc1_20240101_000000.zip",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("zip"), 0o600))
+	}
+
+	removed, diags := helpers.PruneBackups(helpers.LocalBackupSink{}, dir, "scc*_{timestamp}.zip", 1)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []string{filepath.Join(dir, "scc*_20240101_000000.zip")}, removed)
+	assert.FileExists(t, filepath.Join(dir, "scc1_20240101_000000.zip"))
+}
@@ -1,18 +1,13 @@
 package helpers
 
 import (
-	"bytes"
 	"context"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/pem"
 	"fmt"
-	"io"
-	"mime/multipart"
-	"net/http"
 	"strings"
 
-	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -21,11 +16,7 @@ import (
 )
 
 // Wrappers for testing purposes (allows mocking in tests)
-var GetCertificateBinaryFunc = getCertificateBinary
 var ValidatePEMChainFunc = validatePEMChain
-var UploadSignedChainFunc = uploadSignedChain
-var UploadBackendTrustStoreCertificateFunc = uploadBackendTrustStoreCertificate
-var UploadPKCS12CertificateFunc = uploadPKCS12Certificate
 var ExpandSubjectDNFunc = expandSubjectDN
 var BuildSubjectDNFunc = buildSubjectDN
 var ParseSubjectDNFunc = parseSubjectDN
@@ -197,183 +188,6 @@ func buildSubjectDNObject(dn *CertificateSubjectDNConfig) types.Object {
 	return obj
 }
 
-func uploadCertificate(c *api.RestApiClient, method, endpoint, formField, fileName, cert string) diag.Diagnostics {
-	var diags diag.Diagnostics
-	var body bytes.Buffer
-	writer := multipart.NewWriter(&body)
-
-	part, err := writer.CreateFormFile(formField, fileName)
-	if err != nil {
-		diags.AddError(
-			"Failed to Create Multipart Form",
-			fmt.Sprintf("error creating multipart form: %v", err),
-		)
-		return diags
-	}
-
-	_, err = part.Write([]byte(cert))
-	if err != nil {
-		diags.AddError(
-			"Failed to Write Certificate to Multipart Form",
-			fmt.Sprintf("error writing certificate to multipart form: %v", err),
-		)
-		return diags
-	}
-
-	if err := writer.Close(); err != nil {
-		diags.AddError(
-			"Failed to Finalize Multipart Form",
-			fmt.Sprintf("error closing multipart writer: %v", err),
-		)
-		return diags
-	}
-
-	resp, diags := c.DoRequest(method, endpoint, body.Bytes(), "", writer.FormDataContentType())
-	if diags.HasError() {
-		return diags
-	}
-
-	defer func() {
-		if cerr := resp.Body.Close(); cerr != nil {
-			diags.AddWarning(
-				"Response Body Close Failed",
-				fmt.Sprintf("error closing response body: %v", cerr),
-			)
-		}
-	}()
-
-	if resp.StatusCode >= 400 {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		diags.AddError(
-			"Failed to Upload Certificate",
-			fmt.Sprintf("status code: %d, response: %s", resp.StatusCode, string(bodyBytes)),
-		)
-	}
-
-	return diags
-}
-
-func uploadSignedChain(c *api.RestApiClient, endpoint, cert string) diag.Diagnostics {
-	return uploadCertificate(
-		c,
-		http.MethodPatch,
-		endpoint,
-		"signedCertificate",
-		"signed_chain.pem",
-		cert,
-	)
-}
-
-func uploadBackendTrustStoreCertificate(c *api.RestApiClient, endpoint, cert string) diag.Diagnostics {
-	return uploadCertificate(
-		c,
-		http.MethodPost,
-		endpoint,
-		"certificate",
-		"certificate.pem",
-		cert,
-	)
-}
-
-func uploadPKCS12Certificate(c *api.RestApiClient, endpoint string, pkcs12Bytes []byte, password, keyPassword string) diag.Diagnostics {
-	var diags diag.Diagnostics
-	var body bytes.Buffer
-	writer := multipart.NewWriter(&body)
-
-	if err := writer.WriteField("password", password); err != nil {
-		diags.AddError(
-			"Failed to Write Password Field",
-			fmt.Sprintf("error writing password field: %v", err),
-		)
-		return diags
-	}
-
-	if keyPassword != "" {
-		if err := writer.WriteField("keyPassword", keyPassword); err != nil {
-			diags.AddError(
-				"Failed to Write Key Password Field",
-				fmt.Sprintf("error writing key password field: %v", err),
-			)
-			return diags
-		}
-	}
-
-	part, err := writer.CreateFormFile("pkcs12", "certificate.p12")
-	if err != nil {
-		diags.AddError(
-			"Failed to Create Multipart Form",
-			fmt.Sprintf("error creating multipart form: %v", err),
-		)
-		return diags
-	}
-
-	_, err = part.Write(pkcs12Bytes)
-	if err != nil {
-		diags.AddError(
-			"Failed to Write Certificate to Multipart Form",
-			fmt.Sprintf("error writing certificate to multipart form: %v", err),
-		)
-		return diags
-	}
-
-	if err := writer.Close(); err != nil {
-		diags.AddError(
-			"Failed to Finalize Multipart Form",
-			fmt.Sprintf("error closing multipart writer: %v", err),
-		)
-		return diags
-	}
-
-	resp, diags := c.DoRequest(http.MethodPut, endpoint, body.Bytes(), "", writer.FormDataContentType())
-	if diags.HasError() {
-		return diags
-	}
-
-	defer func() {
-		if cerr := resp.Body.Close(); cerr != nil {
-			diags.AddWarning(
-				"Response Body Close Failed",
-				fmt.Sprintf("error closing response body: %v", cerr),
-			)
-		}
-	}()
-
-	if resp.StatusCode >= 400 {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		diags.AddError(
-			"Failed to Upload PKCS#12 Certificate",
-			fmt.Sprintf("status code: %d, response: %s", resp.StatusCode, string(bodyBytes)),
-		)
-
-		return diags
-	}
-
-	return diags
-}
-
-func getCertificateBinary(client *api.RestApiClient, endpoint string) ([]byte, diag.Diagnostics) {
-	response, diags := client.DoRequest(http.MethodGet, endpoint, nil, "application/pkix-cert", "")
-	if diags.HasError() {
-		return nil, diags
-	}
-
-	defer func() {
-		if err := response.Body.Close(); err != nil {
-			diags.AddWarning(
-				"Failed to Close Response Body",
-				fmt.Sprintf("error closing response body: %v", err),
-			)
-		}
-	}()
-
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
-		diags.AddError("Failed to Read Response Body", fmt.Sprintf("failed to read response body: %v", err))
-		return nil, diags
-	}
-	return body, diags
-}
-
 var validatePEMData = func(data string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -3,14 +3,9 @@ package helpers_test
 import (
 	"context"
 	"encoding/base64"
-	"io"
-	"net/http"
-	"net/http/httptest"
-	"net/url"
 	"testing"
 	"time"
 
-	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
@@ -19,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
 )
 
 // Tests for parseSubjectDN function
@@ -381,257 +375,6 @@ abcd
 	assert.True(t, diags.HasError())
 }
 
-// Tests for uploadSignedChain function
-func TestUploadSignedChain_Success(t *testing.T) {
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		assert.Equal(t, http.MethodPatch, r.Method)
-
-		err := r.ParseMultipartForm(10 << 20)
-		require.NoError(t, err)
-
-		file, _, err := r.FormFile("signedCertificate")
-		require.NoError(t, err)
-		defer func() {
-			if err := file.Close(); err != nil {
-				t.Errorf("failed to close file: %v", err)
-			}
-		}()
-
-		content, err := io.ReadAll(file)
-		require.NoError(t, err)
-
-		assert.Contains(t, string(content), "CERTIFICATE")
-
-		w.WriteHeader(http.StatusNoContent)
-	}))
-	defer server.Close()
-
-	client := tfutils.NewTestClient(t, server)
-
-	diags := helpers.UploadSignedChainFunc(client, "", "-----BEGIN CERTIFICATE-----test")
-	assert.False(t, diags.HasError())
-}
-
-func TestUploadSignedChain_HTTPError(t *testing.T) {
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusBadRequest)
-		if _, err := w.Write([]byte("INVALID_REQUEST")); err != nil {
-			t.Fatalf("write failed: %v", err)
-		}
-	}))
-	defer server.Close()
-
-	client := tfutils.NewTestClient(t, server)
-
-	diags := helpers.UploadSignedChainFunc(client, "", "test")
-	assert.True(t, diags.HasError())
-}
-
-// Tests for getCertificateBinary function
-func TestGetCertificateBinary_Success(t *testing.T) {
-	expected := []byte("binary-cert")
-
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		assert.Equal(t, "application/pkix-cert", r.Header.Get("Accept"))
-		w.WriteHeader(http.StatusOK)
-		_, err := w.Write(expected)
-		require.NoError(t, err)
-	}))
-	defer server.Close()
-
-	client := tfutils.NewTestClient(t, server)
-
-	body, diags := helpers.GetCertificateBinaryFunc(client, "")
-	assert.False(t, diags.HasError())
-	assert.Equal(t, expected, body)
-}
-
-func TestGetCertificateBinary_ReadError(t *testing.T) {
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-	}))
-	defer server.Close()
-
-	client := tfutils.NewTestClient(t, server)
-
-	body, diags := helpers.GetCertificateBinaryFunc(client, "")
-	assert.False(t, diags.HasError())
-	assert.NotNil(t, body)
-}
-
-// Tests for uploadPKCS12Certificate function
-func TestUploadPKCS12Certificate_Success_WithKeyPassword(t *testing.T) {
-	expectedBytes := []byte("dummy-p12-content")
-
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		assert.Equal(t, http.MethodPut, r.Method)
-		assert.Contains(t, r.Header.Get("Content-Type"), "multipart/form-data")
-
-		err := r.ParseMultipartForm(10 << 20)
-		require.NoError(t, err)
-
-		// Validate password fields
-		assert.Equal(t, "storepass", r.FormValue("password"))
-		assert.Equal(t, "keypass", r.FormValue("keyPassword"))
-
-		// Validate file field
-		file, _, err := r.FormFile("pkcs12")
-		require.NoError(t, err)
-		defer func() {
-			if err := file.Close(); err != nil {
-				t.Errorf("failed to close file: %v", err)
-			}
-		}()
-
-		body, err := io.ReadAll(file)
-		require.NoError(t, err)
-
-		assert.Equal(t, expectedBytes, body)
-
-		w.WriteHeader(http.StatusNoContent)
-	}))
-	defer server.Close()
-
-	client := tfutils.NewTestClient(t, server)
-
-	diags := helpers.UploadPKCS12CertificateFunc(
-		client,
-		"",
-		expectedBytes,
-		"storepass",
-		"keypass",
-	)
-
-	assert.False(t, diags.HasError())
-}
-
-func TestUploadPKCS12Certificate_Success_WithoutKeyPassword(t *testing.T) {
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		err := r.ParseMultipartForm(10 << 20)
-		require.NoError(t, err)
-
-		assert.Equal(t, "storepass", r.FormValue("password"))
-		assert.Equal(t, "", r.FormValue("keyPassword"))
-
-		w.WriteHeader(http.StatusNoContent)
-	}))
-	defer server.Close()
-
-	client := tfutils.NewTestClient(t, server)
-
-	diags := helpers.UploadPKCS12CertificateFunc(
-		client,
-		"",
-		[]byte("data"),
-		"storepass",
-		"",
-	)
-
-	assert.False(t, diags.HasError())
-}
-
-func TestUploadPKCS12Certificate_HTTP400(t *testing.T) {
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusBadRequest)
-		if _, err := w.Write([]byte("INVALID_REQUEST")); err != nil {
-			t.Fatalf("write failed: %v", err)
-		}
-	}))
-	defer server.Close()
-
-	client := tfutils.NewTestClient(t, server)
-
-	diags := helpers.UploadPKCS12CertificateFunc(
-		client,
-		"",
-		[]byte("data"),
-		"pass",
-		"",
-	)
-
-	assert.True(t, diags.HasError())
-	assert.Contains(t, diags.Errors()[0].Detail(), "INVALID_REQUEST")
-}
-
-func TestUploadPKCS12Certificate_HTTP500(t *testing.T) {
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusInternalServerError)
-		if _, err := w.Write([]byte("SERVER_ERROR")); err != nil {
-			t.Fatalf("write failed: %v", err)
-		}
-	}))
-	defer server.Close()
-
-	client := tfutils.NewTestClient(t, server)
-
-	diags := helpers.UploadPKCS12CertificateFunc(
-		client,
-		"",
-		[]byte("data"),
-		"pass",
-		"",
-	)
-
-	assert.True(t, diags.HasError())
-	assert.Contains(t, diags.Errors()[0].Detail(), "SERVER_ERROR")
-}
-
-func TestUploadPKCS12Certificate_DoRequestFailure(t *testing.T) {
-	// Invalid server to force client error
-	client := &api.RestApiClient{
-		BaseURL: &url.URL{
-			Scheme: "http",
-			Host:   "invalid-host",
-		},
-		Client: &http.Client{},
-	}
-
-	diags := helpers.UploadPKCS12CertificateFunc(
-		client,
-		"",
-		[]byte("data"),
-		"pass",
-		"",
-	)
-
-	assert.True(t, diags.HasError())
-}
-
-func TestUploadPKCS12Certificate_EmptyPKCS12Bytes(t *testing.T) {
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		err := r.ParseMultipartForm(10 << 20)
-		require.NoError(t, err)
-
-		file, _, err := r.FormFile("pkcs12")
-		require.NoError(t, err)
-		defer func() {
-			if err := file.Close(); err != nil {
-				t.Errorf("failed to close file: %v", err)
-			}
-		}()
-
-		body, err := io.ReadAll(file)
-		require.NoError(t, err)
-
-		assert.Equal(t, []byte{}, body)
-
-		w.WriteHeader(http.StatusNoContent)
-	}))
-	defer server.Close()
-
-	client := tfutils.NewTestClient(t, server)
-
-	diags := helpers.UploadPKCS12CertificateFunc(
-		client,
-		"",
-		[]byte{},
-		"pass",
-		"",
-	)
-
-	assert.False(t, diags.HasError())
-}
-
 // Tests for validatePKCS12Inputs function
 func TestValidatePKCS12Inputs_Base64Input(t *testing.T) {
 	raw := []byte("dummy-p12")
@@ -0,0 +1,89 @@
+package helpers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const instanceDescription = "The name of the Cloud Connector instance to use, as configured in the `instances` attribute of the provider. Defaults to the instance configured by `instance_url`."
+
+func instanceValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthAtLeast(1),
+	}
+}
+
+// ResourceInstanceAttribute returns the `instance` attribute of resources.
+func ResourceInstanceAttribute() resourceschema.StringAttribute {
+	return resourceschema.StringAttribute{
+		MarkdownDescription: instanceDescription + " Changing this value forces the resource to be recreated on the new instance.",
+		Optional:            true,
+		Validators:          instanceValidators(),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// DataSourceInstanceAttribute returns the `instance` attribute of data sources.
+func DataSourceInstanceAttribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		MarkdownDescription: instanceDescription,
+		Optional:            true,
+		Validators:          instanceValidators(),
+	}
+}
+
+// ListResourceInstanceAttribute returns the `instance` attribute of list resource configs.
+func ListResourceInstanceAttribute() listschema.StringAttribute {
+	return listschema.StringAttribute{
+		MarkdownDescription: instanceDescription,
+		Optional:            true,
+		Validators:          instanceValidators(),
+	}
+}
+
+// ActionInstanceAttribute returns the `instance` attribute of actions.
+func ActionInstanceAttribute() actionschema.StringAttribute {
+	return actionschema.StringAttribute{
+		MarkdownDescription: instanceDescription,
+		Optional:            true,
+		Validators:          instanceValidators(),
+	}
+}
+
+// EphemeralResourceInstanceAttribute returns the `instance` attribute of ephemeral resources.
+func EphemeralResourceInstanceAttribute() ephemeralschema.StringAttribute {
+	return ephemeralschema.StringAttribute{
+		MarkdownDescription: instanceDescription,
+		Optional:            true,
+		Validators:          instanceValidators(),
+	}
+}
+
+// ImportInstanceID supports import identifiers of the form `<id>@<instance>`.
+// If the part after the last "@" names an instance configured in the provider,
+// it is stored in the `instance` attribute and the remaining identifier is
+// returned; otherwise id is returned unchanged.
+func ImportInstanceID(ctx context.Context, client *api.RestApiClient, id string, resp *resource.ImportStateResponse) string {
+	idx := strings.LastIndex(id, "@")
+	if idx < 0 || client == nil || client.Instances == nil || !client.Instances.Has(id[idx+1:]) {
+		return id
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance"), id[idx+1:])...)
+	return id[:idx]
+}
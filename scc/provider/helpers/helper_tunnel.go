@@ -0,0 +1,119 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultTunnelTimeout is the time to wait for the tunnel of a subaccount if
+// wait_for_connection is set and no timeout is configured.
+const defaultTunnelTimeout = 10 * time.Minute
+
+// Polling intervals used while waiting for a subaccount tunnel.
+// Variables for testing purposes (allows shortening the intervals in tests)
+var TunnelPollInitialInterval = 2 * time.Second
+var TunnelPollMaxInterval = 30 * time.Second
+
+// WaitForPlannedTunnel waits until the tunnel reaches the planned connected
+// state if wait_for_connection is set, within the create or update timeout of
+// the timeouts block depending on operation, and refreshes respObj afterwards.
+func WaitForPlannedTunnel(ctx context.Context, client *api.RestApiClient, regionHost, subaccount string, waitForConnection, connected types.Bool, planTimeouts timeouts.Value, operation string, respObj *apiobjects.Subaccount) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !waitForConnection.ValueBool() || connected.IsNull() || connected.IsUnknown() {
+		return diags
+	}
+
+	timeout, diags := planTimeouts.Create(ctx, defaultTunnelTimeout)
+	if operation == "update" {
+		timeout, diags = planTimeouts.Update(ctx, defaultTunnelTimeout)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(WaitForTunnelState(ctx, client, regionHost, subaccount, connected.ValueBool(), timeout)...)
+
+	// Refresh even if waiting failed, so that the last reported tunnel state is stored.
+	refreshed, err := client.Subaccounts().Get(ctx, regionHost, subaccount)
+	if err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
+		return diags
+	}
+	*respObj = refreshed
+
+	return diags
+}
+
+// WaitForTunnelState polls the subaccount with exponential backoff
+// until its tunnel is connected (or disconnected) or the timeout elapses.
+// The timeout also bounds the requests sent while polling.
+// While waiting for a connection, a tunnel in state ConnectFailure is
+// reconnected on every poll instead of giving up.
+func WaitForTunnelState(ctx context.Context, client *api.RestApiClient, regionHost, subaccount string, connected bool, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	desiredState := "Disconnected"
+	if connected {
+		desiredState = "Connected"
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	state := ""
+	stopped := func() diag.Diagnostics {
+		if ctx.Err() == nil && errors.Is(waitCtx.Err(), context.DeadlineExceeded) {
+			diags.AddError(
+				"Timeout Waiting for Tunnel",
+				fmt.Sprintf("The tunnel did not become %s within %s. Last reported state: %q.", desiredState, timeout, state),
+			)
+			return diags
+		}
+		diags.AddError("Waiting for Tunnel Cancelled", fmt.Sprintf("Stopped waiting for the tunnel to become %s: %s", desiredState, ctx.Err()))
+		return diags
+	}
+
+	interval := TunnelPollInitialInterval
+	for {
+		respObj, err := client.Subaccounts().Get(waitCtx, regionHost, subaccount)
+		if err != nil {
+			if waitCtx.Err() != nil {
+				return stopped()
+			}
+			return api.ErrorDiagnostics(err)
+		}
+
+		state = respObj.Tunnel.State
+		if state == desiredState {
+			return diags
+		}
+
+		if connected && state == "ConnectFailure" {
+			tflog.Debug(ctx, "Tunnel connection failed, reconnecting", map[string]any{"region_host": regionHost, "subaccount": subaccount})
+
+			if err := client.Subaccounts().SetTunnelState(waitCtx, regionHost, subaccount, true); err != nil {
+				if waitCtx.Err() != nil {
+					return stopped()
+				}
+				return api.ErrorDiagnostics(err)
+			}
+		}
+
+		select {
+		case <-waitCtx.Done():
+			return stopped()
+		case <-time.After(interval):
+		}
+
+		interval = min(interval*2, TunnelPollMaxInterval)
+	}
+}
@@ -0,0 +1,124 @@
+package helpers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tunnelEndpoint = "/api/v1/configuration/subaccounts/cf.eu12.hana.ondemand.com/123"
+
+func shortenTunnelPolling(t *testing.T) {
+	t.Helper()
+	initial, maxInterval := helpers.TunnelPollInitialInterval, helpers.TunnelPollMaxInterval
+	helpers.TunnelPollInitialInterval = time.Millisecond
+	helpers.TunnelPollMaxInterval = 5 * time.Millisecond
+	t.Cleanup(func() {
+		helpers.TunnelPollInitialInterval, helpers.TunnelPollMaxInterval = initial, maxInterval
+	})
+}
+
+func newTunnelServer(t *testing.T, states ...string) (*httptest.Server, *[]string) {
+	t.Helper()
+	var mu sync.Mutex
+	var reconnects []string
+	polls := 0
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == tunnelEndpoint:
+			state := states[min(polls, len(states)-1)]
+			polls++
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"regionHost": "cf.eu12.hana.ondemand.com",
+				"subaccount": "123",
+				"tunnel":     map[string]any{"state": state},
+			})
+		case r.Method == http.MethodPut && r.URL.Path == tunnelEndpoint+"/state":
+			reconnects = append(reconnects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &reconnects
+}
+
+func TestWaitForTunnelState(t *testing.T) {
+	shortenTunnelPolling(t)
+
+	t.Run("connected after reconnect", func(t *testing.T) {
+		srv, reconnects := newTunnelServer(t, "Disconnected", "ConnectFailure", "Connected")
+		client := tfutils.NewTestClient(t, srv)
+
+		diags := helpers.WaitForTunnelState(context.Background(), client, "cf.eu12.hana.ondemand.com", "123", true, time.Second)
+
+		require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+		assert.Len(t, *reconnects, 1)
+	})
+
+	t.Run("disconnected", func(t *testing.T) {
+		srv, reconnects := newTunnelServer(t, "Connected", "Disconnected")
+		client := tfutils.NewTestClient(t, srv)
+
+		diags := helpers.WaitForTunnelState(context.Background(), client, "cf.eu12.hana.ondemand.com", "123", false, time.Second)
+
+		require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+		assert.Empty(t, *reconnects)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		srv, _ := newTunnelServer(t, "Disconnected")
+		client := tfutils.NewTestClient(t, srv)
+
+		diags := helpers.WaitForTunnelState(context.Background(), client, "cf.eu12.hana.ondemand.com", "123", true, 20*time.Millisecond)
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Timeout Waiting for Tunnel", diags.Errors()[0].Summary())
+	})
+	t.Run("timeout bounds a running request", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+		}))
+		t.Cleanup(srv.Close)
+		client := tfutils.NewTestClient(t, srv)
+
+		start := time.Now()
+		diags := helpers.WaitForTunnelState(context.Background(), client, "cf.eu12.hana.ondemand.com", "123", true, 50*time.Millisecond)
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Timeout Waiting for Tunnel", diags.Errors()[0].Summary())
+		assert.Less(t, time.Since(start), 2*time.Second)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		srv, _ := newTunnelServer(t, "Disconnected")
+		client := tfutils.NewTestClient(t, srv)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		diags := helpers.WaitForTunnelState(ctx, client, "cf.eu12.hana.ondemand.com", "123", true, time.Second)
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Waiting for Tunnel Cancelled", diags.Errors()[0].Summary())
+	})
+}
@@ -0,0 +1,25 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// RequireVersion reports an error for the attribute at attrPath if the Cloud
+// Connector instance selected by ctx is older than minimum.
+func RequireVersion(ctx context.Context, client *api.RestApiClient, minimum api.Version, attrPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := client.RequireVersion(ctx, minimum, fmt.Sprintf("The attribute %s", attrPath))
+	var clientErr *api.Error
+	if errors.As(err, &clientErr) {
+		diags.AddAttributeError(attrPath, clientErr.Summary, clientErr.Detail)
+	}
+
+	return diags
+}
@@ -67,6 +67,13 @@ var listResources = []testListResource{
 			return r.(*listresources.SystemMappingResourceListResource).Client
 		},
 	},
+	{
+		name:         "UserListResource",
+		listresource: &listresources.UserListResource{},
+		getClient: func(r list.ListResource) *api.RestApiClient {
+			return r.(*listresources.UserListResource).Client
+		},
+	},
 }
 
 func TestAllListResourceConfigure(t *testing.T) {
@@ -3,10 +3,9 @@ package listresources
 import (
 	"context"
 	"fmt"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
@@ -64,6 +63,7 @@ This list resource retrieves all domain mappings for a specific region_host and
 accessible via the configured SAP Cloud Connector instance. 
 `,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ListResourceInstanceAttribute(),
 			"region_host": schema.StringAttribute{
 				MarkdownDescription: "The host URL of the region (e.g., cf.us10.hana.ondemand.com).",
 				Required:            true,
@@ -82,27 +82,24 @@ func (r *DomainMappingListResource) List(
 	req list.ListRequest,
 	stream *list.ListResultsStream,
 ) {
-	var (
-		respObj apiobjects.DomainMappings
-		filter  model.DomainMappingListFilterModel
-	)
+	var filter model.DomainMappingListFilterModel
 
 	if diags := req.Config.Get(ctx, &filter); diags.HasError() {
 		stream.Results = list.ListResultsStreamDiagnostics(diags)
 		return
 	}
 
-	endpoint := fmt.Sprintf("/api/v1/configuration/subaccounts/%s/%s/domainMappings", filter.RegionHost.ValueString(), filter.Subaccount.ValueString())
+	ctx = api.WithInstance(ctx, filter.Instance.ValueString())
 
-	diags := helpers.RequestAndUnmarshal(r.Client, &respObj.DomainMappings, "GET", endpoint, nil, true)
-	if diags.HasError() {
-		stream.Results = list.ListResultsStreamDiagnostics(diags)
+	domainMappings, err := r.Client.DomainMappings().List(ctx, filter.RegionHost.ValueString(), filter.Subaccount.ValueString())
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(api.ErrorDiagnostics(err))
 		return
 	}
 
 	stream.Results = func(push func(list.ListResult) bool) {
 
-		for _, dm := range respObj.DomainMappings {
+		for _, dm := range domainMappings {
 
 			result := req.NewListResult(ctx)
 
@@ -111,6 +108,7 @@ func (r *DomainMappingListResource) List(
 			_ = result.Identity.SetAttribute(ctx, path.Root("internal_domain"), types.StringValue(dm.InternalDomain))
 
 			resDm := &model.DomainMappingConfig{
+				Instance:       filter.Instance,
 				Subaccount:     filter.Subaccount,
 				RegionHost:     filter.RegionHost,
 				InternalDomain: types.StringValue(dm.InternalDomain),
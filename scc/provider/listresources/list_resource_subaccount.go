@@ -3,11 +3,10 @@ package listresources
 import (
 	"context"
 	"fmt"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
-	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
@@ -62,8 +61,13 @@ SAP Cloud Connector **Subaccounts** list resource.
 
 This list resource retrieves all subaccounts accessible via the configured
 SAP Cloud Connector instance.
+
+If ` + "`include_tunnel`" + ` is set or the tunnel state is filtered, the tunnel
+state and connection statistics of each subaccount are read as well and
+returned with the resource when ` + "`include_resource`" + ` is enabled.
 `,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ListResourceInstanceAttribute(),
 			"region_host": schema.StringAttribute{
 				Optional: true,
 				MarkdownDescription: `
@@ -71,6 +75,20 @@ Filter subaccounts by region host.
 
 **Note:** If this attribute is omitted or set to an empty value,
 subaccounts from all regions are returned.
+`,
+			},
+			"tunnel_state": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: `
+Filter subaccounts by the state of their tunnel, e.g. ` + "`Connected`" + `,
+` + "`ConnectFailure`" + ` or ` + "`Disconnected`" + `.
+`,
+			},
+			"include_tunnel": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: `
+Read the tunnel state and connection statistics of each subaccount.
+This requires one additional request per subaccount.
 `,
 			},
 		},
@@ -95,13 +113,16 @@ func (r *SubaccountListResource) List(
 		}
 	}
 
-	endpoint := endpoints.GetSubaccountBaseEndpoint()
+	ctx = api.WithInstance(ctx, filter.Instance.ValueString())
 
-	diags := helpers.RequestAndUnmarshal(r.Client, &respObj.Subaccounts, "GET", endpoint, nil, true)
-	if diags.HasError() {
-		stream.Results = list.ListResultsStreamDiagnostics(diags)
+	subaccounts, err := r.Client.Subaccounts().List(ctx)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(api.ErrorDiagnostics(err))
 		return
 	}
+	respObj.Subaccounts = subaccounts
+
+	withTunnel := filter.IncludeTunnel.ValueBool() || (!filter.TunnelState.IsNull() && filter.TunnelState.ValueString() != "")
 
 	stream.Results = func(push func(list.ListResult) bool) {
 		warned := false
@@ -119,10 +140,34 @@ func (r *SubaccountListResource) List(
 			_ = result.Identity.SetAttribute(ctx, path.Root("subaccount"), types.StringValue(sa.Subaccount))
 			_ = result.Identity.SetAttribute(ctx, path.Root("region_host"), types.StringValue(sa.RegionHost))
 
-			if !warned && req.IncludeResource {
+			if withTunnel {
+				// The subaccount list does not contain the tunnel, so the
+				// details of each subaccount have to be read separately.
+				details, err := r.Client.Subaccounts().Get(ctx, sa.RegionHost, sa.Subaccount)
+				result.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+
+				if !result.Diagnostics.HasError() {
+					if !filter.TunnelState.IsNull() && filter.TunnelState.ValueString() != "" {
+						if details.Tunnel.State != filter.TunnelState.ValueString() {
+							continue
+						}
+					}
+
+					result.DisplayName = fmt.Sprintf("%s (%s)", sa.Subaccount, details.Tunnel.State)
+
+					if req.IncludeResource {
+						resSa, diags := model.SubaccountResourceValueFrom(ctx, model.SubaccountConfig{Instance: filter.Instance}, details)
+						result.Diagnostics.Append(diags...)
+						if !result.Diagnostics.HasError() {
+							// Set the resource information on the result
+							result.Diagnostics.Append(result.Resource.Set(ctx, resSa)...)
+						}
+					}
+				}
+			} else if !warned && req.IncludeResource {
 				result.Diagnostics.AddWarning(
 					"include_resource Not Supported",
-					"The include_resource option is not supported for this list resource.",
+					"The include_resource option is only supported for this list resource if include_tunnel is set or the tunnel state is filtered.",
 				)
 
 				warned = true
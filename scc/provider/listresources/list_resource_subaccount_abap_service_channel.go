@@ -3,11 +3,9 @@ package listresources
 import (
 	"context"
 	"fmt"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
-	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
@@ -63,6 +61,7 @@ SAP Cloud Connector **Subaccount ABAP service channel** list resource.
 This list resource retrieves Subaccount ABAP service channel for a specific region host and subaccount.
 `,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ListResourceInstanceAttribute(),
 			"region_host": schema.StringAttribute{
 				MarkdownDescription: "The host URL of the region (e.g., `cf.eu12.hana.ondemand.com`).",
 				Required:            true,
@@ -84,35 +83,31 @@ func (r *SubaccountABAPServiceChannelListResource) List(
 	req list.ListRequest,
 	stream *list.ListResultsStream,
 ) {
-	var (
-		respObj  apiobjects.SubaccountABAPServiceChannels
-		filter   model.SubaccountABAPServiceChannelListResourceFilterModel
-		endpoint string
-	)
+	var filter model.SubaccountABAPServiceChannelListResourceFilterModel
 
 	if diags := req.Config.Get(ctx, &filter); diags.HasError() {
 		stream.Results = list.ListResultsStreamDiagnostics(diags)
 		return
 	}
 
+	ctx = api.WithInstance(ctx, filter.Instance.ValueString())
+
 	var serviceChannelType string
 	if filter.SNCEncrypted.ValueBool() {
-		serviceChannelType = "ABAPCloudSNC"
+		serviceChannelType = api.ServiceChannelTypeABAPCloudSNC
 	} else {
-		serviceChannelType = "ABAPCloud"
+		serviceChannelType = api.ServiceChannelTypeABAPCloud
 	}
 
-	endpoint = endpoints.GetSubaccountServiceChannelBaseEndpoint(filter.RegionHost.ValueString(), filter.Subaccount.ValueString(), serviceChannelType)
-
-	diags := helpers.RequestAndUnmarshal(r.Client, &respObj.SubaccountABAPServiceChannels, "GET", endpoint, nil, true)
-	if diags.HasError() {
-		stream.Results = list.ListResultsStreamDiagnostics(diags)
+	serviceChannels, err := r.Client.ABAPServiceChannels().List(ctx, filter.RegionHost.ValueString(), filter.Subaccount.ValueString(), serviceChannelType)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(api.ErrorDiagnostics(err))
 		return
 	}
 
 	// 4. Stream Results
 	stream.Results = func(push func(list.ListResult) bool) {
-		for _, sm := range respObj.SubaccountABAPServiceChannels {
+		for _, sm := range serviceChannels {
 			result := req.NewListResult(ctx)
 
 			result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("subaccount"), filter.Subaccount)...)
@@ -121,7 +116,7 @@ func (r *SubaccountABAPServiceChannelListResource) List(
 
 			serviceChannelType := "ABAPCloud"
 			if filter.SNCEncrypted.ValueBool() {
-				serviceChannelType = "ABAPCloudSNC"
+				serviceChannelType = api.ServiceChannelTypeABAPCloudSNC
 			}
 			result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("type"), types.StringValue(serviceChannelType))...)
 
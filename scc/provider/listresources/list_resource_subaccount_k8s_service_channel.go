@@ -3,11 +3,9 @@ package listresources
 import (
 	"context"
 	"fmt"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
-	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
@@ -63,6 +61,7 @@ SAP Cloud Connector **Subaccount K8S Service Channel** list resource.
 This list resource retrieves Subaccount K8S Service Channel for a specific region host and subaccount.
 `,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ListResourceInstanceAttribute(),
 			"region_host": schema.StringAttribute{
 				MarkdownDescription: "The host URL of the region (e.g., `cf.eu12.hana.ondemand.com`).",
 				Required:            true,
@@ -80,32 +79,24 @@ func (r *SubaccountK8SServiceChannelListResource) List(
 	req list.ListRequest,
 	stream *list.ListResultsStream,
 ) {
-	var (
-		respObj  apiobjects.SubaccountK8SServiceChannels
-		filter   model.SubaccountK8SServiceChannelListResourceFilterModel
-		endpoint string
-	)
+	var filter model.SubaccountK8SServiceChannelListResourceFilterModel
 
 	if diags := req.Config.Get(ctx, &filter); diags.HasError() {
 		stream.Results = list.ListResultsStreamDiagnostics(diags)
 		return
 	}
 
-	endpoint = endpoints.GetSubaccountServiceChannelBaseEndpoint(
-		filter.RegionHost.ValueString(),
-		filter.Subaccount.ValueString(),
-		"K8S",
-	)
+	ctx = api.WithInstance(ctx, filter.Instance.ValueString())
 
-	diags := helpers.RequestAndUnmarshal(r.Client, &respObj.SubaccountK8SServiceChannels, "GET", endpoint, nil, true)
-	if diags.HasError() {
-		stream.Results = list.ListResultsStreamDiagnostics(diags)
+	serviceChannels, err := r.Client.K8SServiceChannels().List(ctx, filter.RegionHost.ValueString(), filter.Subaccount.ValueString())
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(api.ErrorDiagnostics(err))
 		return
 	}
 
 	// 4. Stream Results
 	stream.Results = func(push func(list.ListResult) bool) {
-		for _, sm := range respObj.SubaccountK8SServiceChannels {
+		for _, sm := range serviceChannels {
 			result := req.NewListResult(ctx)
 
 			result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("subaccount"), filter.Subaccount)...)
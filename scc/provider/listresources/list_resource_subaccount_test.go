@@ -1,14 +1,30 @@
 package listresources_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/scc/provider/listresources"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/SAP/terraform-provider-scc/scc/provider/resources"
 	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/querycheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestListSubaccount(t *testing.T) {
@@ -95,3 +111,118 @@ func listSubaccountQueryConfigWithFilter(label, providerName, regionHost string)
 			   }
              }`, label, providerName, regionHost)
 }
+
+func TestListSubaccount_TunnelStatus(t *testing.T) {
+	responses := map[string]any{
+		"/api/v1/configuration/subaccounts": []apiobjects.Subaccount{
+			{RegionHost: "cf.eu12.hana.ondemand.com", Subaccount: "11111111-1111-1111-1111-111111111111"},
+			{RegionHost: "cf.eu12.hana.ondemand.com", Subaccount: "22222222-2222-2222-2222-222222222222"},
+		},
+		"/api/v1/configuration/subaccounts/cf.eu12.hana.ondemand.com/11111111-1111-1111-1111-111111111111": apiobjects.Subaccount{
+			RegionHost: "cf.eu12.hana.ondemand.com",
+			Subaccount: "11111111-1111-1111-1111-111111111111",
+			Tunnel: apiobjects.SubaccountTunnel{
+				State:       "Connected",
+				Connections: 3,
+				ApplicationConnections: []apiobjects.SubaccountApplicationConnections{
+					{Name: "app", Type: "JAVA", ConnectionCount: 3},
+				},
+			},
+		},
+		"/api/v1/configuration/subaccounts/cf.eu12.hana.ondemand.com/22222222-2222-2222-2222-222222222222": apiobjects.Subaccount{
+			RegionHost: "cf.eu12.hana.ondemand.com",
+			Subaccount: "22222222-2222-2222-2222-222222222222",
+			Tunnel:     apiobjects.SubaccountTunnel{State: "Disconnected"},
+		},
+	}
+
+	t.Run("include tunnel", func(t *testing.T) {
+		results := listSubaccounts(t, responses, model.SubaccountListFilterModel{
+			IncludeTunnel: types.BoolValue(true),
+		}, true)
+
+		require.Len(t, results, 2)
+		assert.Equal(t, "11111111-1111-1111-1111-111111111111 (Connected)", results[0].DisplayName)
+		assert.Equal(t, "22222222-2222-2222-2222-222222222222 (Disconnected)", results[1].DisplayName)
+
+		var sa model.SubaccountConfig
+		require.False(t, results[0].Resource.Get(context.Background(), &sa).HasError())
+		assert.True(t, sa.Connected.ValueBool())
+
+		var tunnel model.SubaccountTunnelData
+		require.False(t, sa.Tunnel.As(context.Background(), &tunnel, basetypes.ObjectAsOptions{}).HasError())
+		assert.Equal(t, int64(3), tunnel.Connections.ValueInt64())
+
+		var connections []model.SubaccountApplicationConnectionsData
+		require.False(t, tunnel.ApplicationConnections.ElementsAs(context.Background(), &connections, false).HasError())
+		require.Len(t, connections, 1)
+		assert.Equal(t, "app", connections[0].Name.ValueString())
+		assert.Equal(t, int64(3), connections[0].ConnectionCount.ValueInt64())
+	})
+
+	t.Run("tunnel state filter", func(t *testing.T) {
+		results := listSubaccounts(t, responses, model.SubaccountListFilterModel{
+			TunnelState: types.StringValue("Disconnected"),
+		}, false)
+
+		require.Len(t, results, 1)
+		assert.Equal(t, "22222222-2222-2222-2222-222222222222 (Disconnected)", results[0].DisplayName)
+	})
+
+	t.Run("without tunnel", func(t *testing.T) {
+		results := listSubaccounts(t, responses, model.SubaccountListFilterModel{}, true)
+
+		require.Len(t, results, 2)
+		assert.Empty(t, results[0].DisplayName)
+		assert.Len(t, results[0].Diagnostics.Warnings(), 1)
+	})
+}
+
+func listSubaccounts(t *testing.T, responses map[string]any, filter model.SubaccountListFilterModel, includeResource bool) []list.ListResult {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	lr := &listresources.SubaccountListResource{Client: tfutils.NewTestClient(t, srv)}
+
+	schemaResp := &list.ListResourceSchemaResponse{}
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, schemaResp)
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	state := tfsdk.State{Schema: config.Schema, Raw: config.Raw}
+	require.False(t, state.Set(ctx, &filter).HasError())
+	config.Raw = state.Raw
+
+	r := resources.NewSubaccountResource().(*resources.SubaccountResource)
+	resourceSchema := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, resourceSchema)
+	identitySchema := &fwresource.IdentitySchemaResponse{}
+	r.IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, identitySchema)
+
+	stream := &list.ListResultsStream{}
+	lr.List(ctx, list.ListRequest{
+		Config:                 config,
+		IncludeResource:        includeResource,
+		ResourceSchema:         resourceSchema.Schema,
+		ResourceIdentitySchema: identitySchema.IdentitySchema,
+	}, stream)
+
+	results := []list.ListResult{}
+	stream.Results(func(result list.ListResult) bool {
+		require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+		results = append(results, result)
+		return true
+	})
+	return results
+}
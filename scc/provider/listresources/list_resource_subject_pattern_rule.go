@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework/list"
@@ -64,6 +62,7 @@ func (r *SubjectPatternRuleListResource) ListResourceConfigSchema(
 Retrieves all subject pattern rules configured in the SAP Cloud Connector instance.
 Optionally filter to a single rule by its positional index.`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ListResourceInstanceAttribute(),
 			"index": schema.Int64Attribute{
 				MarkdownDescription: "Filter results to the rule at this positional index. Omit to return all rules.",
 				Optional:            true,
@@ -73,7 +72,8 @@ Optionally filter to a single rule by its positional index.`,
 }
 
 type subjectPatternRuleListFilterModel struct {
-	Index types.Int64 `tfsdk:"index"`
+	Instance types.String `tfsdk:"instance"`
+	Index    types.Int64  `tfsdk:"index"`
 }
 
 // List streams subject pattern rules from the API to the results stream.
@@ -83,19 +83,18 @@ func (r *SubjectPatternRuleListResource) List(
 	req list.ListRequest,
 	stream *list.ListResultsStream,
 ) {
-	var (
-		respObj apiobjects.SubjectPatternRules
-		filter  subjectPatternRuleListFilterModel
-	)
+	var filter subjectPatternRuleListFilterModel
 
 	if diags := req.Config.Get(ctx, &filter); diags.HasError() {
 		stream.Results = list.ListResultsStreamDiagnostics(diags)
 		return
 	}
 
-	diags := helpers.RequestAndUnmarshal(r.Client, &respObj, "GET", endpoints.GetSubjectPatternRulesBaseEndpoint(), nil, true)
-	if diags.HasError() {
-		stream.Results = list.ListResultsStreamDiagnostics(diags)
+	ctx = api.WithInstance(ctx, filter.Instance.ValueString())
+
+	respObj, err := r.Client.SubjectPatternRules().List(ctx)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(api.ErrorDiagnostics(err))
 		return
 	}
 
@@ -134,7 +133,8 @@ func (r *SubjectPatternRuleListResource) List(
 
 				if !result.Diagnostics.HasError() {
 					resSpr := &model.SubjectPatternRuleConfig{
-						Index: types.Int64Value(int64(i)),
+						Instance: filter.Instance,
+						Index:    types.Int64Value(int64(i)),
 						SubjectPatternRule: model.SubjectPatternRule{
 							Description:    types.StringValue(spr.Description),
 							SubjectPattern: subjectPattern,
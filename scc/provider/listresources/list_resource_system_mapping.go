@@ -3,11 +3,9 @@ package listresources
 import (
 	"context"
 	"fmt"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
-	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
@@ -62,6 +60,7 @@ SAP Cloud Connector **System Mapping** list resource.
 
 This list resource retrieves system mappings for a specific region host and subaccount.`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ListResourceInstanceAttribute(),
 			"region_host": schema.StringAttribute{
 				MarkdownDescription: "The host URL of the region (e.g., `cf.eu12.hana.ondemand.com`).",
 				Required:            true,
@@ -79,31 +78,24 @@ func (r *SystemMappingListResource) List(
 	req list.ListRequest,
 	stream *list.ListResultsStream,
 ) {
-	var (
-		respObj  apiobjects.SystemMappings
-		filter   model.SystemMappingListResourceFilterModel
-		endpoint string
-	)
+	var filter model.SystemMappingListResourceFilterModel
 
 	if diags := req.Config.Get(ctx, &filter); diags.HasError() {
 		stream.Results = list.ListResultsStreamDiagnostics(diags)
 		return
 	}
 
-	endpoint = endpoints.GetSystemMappingBaseEndpoint(
-		filter.RegionHost.ValueString(),
-		filter.Subaccount.ValueString(),
-	)
+	ctx = api.WithInstance(ctx, filter.Instance.ValueString())
 
-	diags := helpers.RequestAndUnmarshal(r.Client, &respObj.SystemMappings, "GET", endpoint, nil, true)
-	if diags.HasError() {
-		stream.Results = list.ListResultsStreamDiagnostics(diags)
+	systemMappings, err := r.Client.SystemMappings().List(ctx, filter.RegionHost.ValueString(), filter.Subaccount.ValueString())
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(api.ErrorDiagnostics(err))
 		return
 	}
 
 	// 4. Stream Results
 	stream.Results = func(push func(list.ListResult) bool) {
-		for _, sm := range respObj.SystemMappings {
+		for _, sm := range systemMappings {
 			result := req.NewListResult(ctx)
 
 			result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("subaccount"), filter.Subaccount)...)
@@ -3,11 +3,9 @@ package listresources
 import (
 	"context"
 	"fmt"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
-	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
@@ -63,6 +61,7 @@ SAP Cloud Connector **System Mapping Resource** list resource.
 This list resource retrieves system mappings resource for a specific region host, subaccount, virtual_host and virtual_port.
 `,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ListResourceInstanceAttribute(),
 			"region_host": schema.StringAttribute{
 				MarkdownDescription: "The host URL of the region (e.g., `cf.eu12.hana.ondemand.com`).",
 				Required:            true,
@@ -88,33 +87,30 @@ func (r *SystemMappingResourceListResource) List(
 	req list.ListRequest,
 	stream *list.ListResultsStream,
 ) {
-	var (
-		respObj  apiobjects.SystemMappingResources
-		filter   model.SystemMappingResourceListResourceFilterModel
-		endpoint string
-	)
+	var filter model.SystemMappingResourceListResourceFilterModel
 
 	if diags := req.Config.Get(ctx, &filter); diags.HasError() {
 		stream.Results = list.ListResultsStreamDiagnostics(diags)
 		return
 	}
 
-	endpoint = endpoints.GetSystemMappingResourceBaseEndpoint(
+	ctx = api.WithInstance(ctx, filter.Instance.ValueString())
+
+	systemMappingResources, err := r.Client.SystemMappingResources().List(
+		ctx,
 		filter.RegionHost.ValueString(),
 		filter.Subaccount.ValueString(),
 		filter.VirtualHost.ValueString(),
 		filter.VirtualPort.ValueString(),
 	)
-
-	diags := helpers.RequestAndUnmarshal(r.Client, &respObj.SystemMappingResources, "GET", endpoint, nil, true)
-	if diags.HasError() {
-		stream.Results = list.ListResultsStreamDiagnostics(diags)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(api.ErrorDiagnostics(err))
 		return
 	}
 
 	// 4. Stream Results
 	stream.Results = func(push func(list.ListResult) bool) {
-		for _, sm := range respObj.SystemMappingResources {
+		for _, sm := range systemMappingResources {
 			result := req.NewListResult(ctx)
 
 			result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("subaccount"), filter.Subaccount)...)
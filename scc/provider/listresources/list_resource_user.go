@@ -0,0 +1,135 @@
+package listresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &UserListResource{}
+
+type UserListResource struct {
+	Client *api.RestApiClient
+}
+
+func NewUserListResource() list.ListResource {
+	return &UserListResource{}
+}
+
+func (r *UserListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user" // must match managed resource
+}
+
+func (r *UserListResource) Configure(ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = client
+}
+
+// ListResourceConfigSchema defines the schema for the 'config' block in a list query.
+func (r *UserListResource) ListResourceConfigSchema(
+	ctx context.Context,
+	req list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+SAP Cloud Connector **Users** list resource.
+
+This list resource retrieves all local users of the configured SAP Cloud
+Connector instance, e.g. to bring existing users under management with
+` + "`scc_user`" + `.
+`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ListResourceInstanceAttribute(),
+			"role": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: `
+Filter users by role, e.g. ` + "`administrator`" + ` or ` + "`display`" + `.
+
+**Note:** If this attribute is omitted, all users are returned.
+`,
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.Roles...),
+				},
+			},
+		},
+	}
+}
+
+// List streams all local users from the API to the results stream.
+func (r *UserListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var filter model.UserListFilterModel
+
+	if req.Config.Raw.IsFullyKnown() && !req.Config.Raw.IsNull() {
+		if diags := req.Config.Get(ctx, &filter); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	ctx = api.WithInstance(ctx, filter.Instance.ValueString())
+
+	users, err := r.Client.Users().List(ctx)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(api.ErrorDiagnostics(err))
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+
+		for _, user := range users {
+
+			if !filter.Role.IsNull() && !model.UserHasRole(user, filter.Role.ValueString()) {
+				continue
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = user.User
+
+			_ = result.Identity.SetAttribute(ctx, path.Root("name"), types.StringValue(user.User))
+
+			if req.IncludeResource {
+				resUser, diags := model.UserResourceValueFrom(ctx, model.UserConfig{Instance: filter.Instance}, user)
+				result.Diagnostics.Append(diags...)
+				if !result.Diagnostics.HasError() {
+					// Set the resource information on the result
+					result.Diagnostics.Append(result.Resource.Set(ctx, resUser)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
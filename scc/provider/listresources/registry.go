@@ -11,5 +11,6 @@ func All() []func() list.ListResource {
 		NewSubaccountABAPServiceChannelListResource,
 		NewSubaccountK8SServiceChannelListResource,
 		NewSubjectPatternRuleListResource,
+		NewUserListResource,
 	}
 }
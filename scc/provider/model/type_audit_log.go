@@ -0,0 +1,68 @@
+package model
+
+import (
+	"context"
+	"strings"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const AuditLogSettingsID = "audit-log-settings"
+
+type AuditLogSettingsResourceConfig struct {
+	Instance types.String `tfsdk:"instance"`
+	// INPUT
+	Level types.String `tfsdk:"level"`
+	// OUTPUT
+	ID types.String `tfsdk:"id"` // Always `audit-log-settings`.
+}
+
+type AuditLogEntryData struct {
+	Timestamp types.String `tfsdk:"timestamp"`
+	User      types.String `tfsdk:"user"`
+	Category  types.String `tfsdk:"category"`
+	Message   types.String `tfsdk:"message"`
+}
+
+type AuditLogEntriesConfig struct {
+	Instance types.String        `tfsdk:"instance"`
+	From     types.String        `tfsdk:"from"`
+	To       types.String        `tfsdk:"to"`
+	User     types.String        `tfsdk:"user"`
+	Entries  []AuditLogEntryData `tfsdk:"entries"`
+}
+
+func AuditLogSettingsResourceValueFrom(ctx context.Context, value apiobjects.AuditLogLevel) (AuditLogSettingsResourceConfig, diag.Diagnostics) {
+	model := &AuditLogSettingsResourceConfig{
+		ID:    types.StringValue(AuditLogSettingsID),
+		Level: types.StringValue(strings.ToLower(value.Level)),
+	}
+
+	return *model, diag.Diagnostics{}
+}
+
+func AuditLogEntriesValueFrom(ctx context.Context, plan AuditLogEntriesConfig, value apiobjects.AuditLogEntries) (AuditLogEntriesConfig, diag.Diagnostics) {
+	entries := []AuditLogEntryData{}
+	for _, entry := range value.Entries {
+		e := AuditLogEntryData{
+			Timestamp: helpers.ConvertMillisToTimes(entry.Timestamp).UTC,
+			User:      valueOrNullString(entry.User),
+			Category:  valueOrNullString(entry.Category),
+			Message:   types.StringValue(entry.Message),
+		}
+		entries = append(entries, e)
+	}
+
+	model := &AuditLogEntriesConfig{
+		Instance: plan.Instance,
+		From:     plan.From,
+		To:       plan.To,
+		User:     plan.User,
+		Entries:  entries,
+	}
+
+	return *model, diag.Diagnostics{}
+}
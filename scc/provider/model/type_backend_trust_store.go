@@ -11,12 +11,14 @@ import (
 )
 
 type BackendTrustStoreActionConfig struct {
-	TrustAllBackends types.Bool `tfsdk:"trust_all_backends"`
+	Instance         types.String `tfsdk:"instance"`
+	TrustAllBackends types.Bool   `tfsdk:"trust_all_backends"`
 }
 
 type BackendTrustStoreDataSourceConfig struct {
-	TrustAllBackends types.Bool `tfsdk:"trust_all_backends"`
-	TrustedBackends  types.List `tfsdk:"trusted_backends"`
+	Instance         types.String `tfsdk:"instance"`
+	TrustAllBackends types.Bool   `tfsdk:"trust_all_backends"`
+	TrustedBackends  types.List   `tfsdk:"trusted_backends"`
 }
 
 type TrustedBackendsData struct {
@@ -27,6 +29,7 @@ type TrustedBackendsData struct {
 }
 
 type BackendTrustStoreResourceConfig struct {
+	Instance    types.String `tfsdk:"instance"`
 	Certificate types.String `tfsdk:"certificate"`
 	Alias       types.String `tfsdk:"alias"`
 	SubjectDN   types.Object `tfsdk:"subject_dn"`
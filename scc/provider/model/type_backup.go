@@ -3,5 +3,25 @@ package model
 import "github.com/hashicorp/terraform-plugin-framework/types"
 
 type BackupActionConfig struct {
+	Instance        types.String `tfsdk:"instance"`
+	Password        types.String `tfsdk:"password"`
+	OutputDirectory types.String `tfsdk:"output_directory"`
+	FileName        types.String `tfsdk:"file_name"`
+	FileMode        types.String `tfsdk:"file_mode"`
+	KeepLastN       types.Int64  `tfsdk:"keep_last_n"`
+}
+
+type RestoreBackupActionConfig struct {
+	Instance   types.String `tfsdk:"instance"`
+	BackupFile types.String `tfsdk:"backup_file"`
+	Password   types.String `tfsdk:"password"`
+}
+
+type BackupEphemeralResourceConfig struct {
+	Instance types.String `tfsdk:"instance"`
+	// INPUT
 	Password types.String `tfsdk:"password"`
+	// OUTPUT
+	Content types.String `tfsdk:"content"`
+	SHA256  types.String `tfsdk:"sha256"`
 }
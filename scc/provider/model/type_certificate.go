@@ -25,28 +25,33 @@ var SignedChainUICertificateResourceValueFromFunc = signedChainUICertificateReso
 var PKCS12UICertificateResourceValueFromFunc = pkcs12UICertificateResourceValueFrom
 
 type CACertificateDataSourceConfig struct {
+	Instance       types.String `tfsdk:"instance"`
 	CertificatePEM types.String `tfsdk:"certificate_pem"`
 	helpers.CertificateWithSANConfig
 }
 
 type SystemCertificateDataSourceConfig struct {
+	Instance       types.String `tfsdk:"instance"`
 	CertificatePEM types.String `tfsdk:"certificate_pem"`
 	helpers.CertificateConfig
 }
 
 type SelfSignedSystemCertificateResourceConfig struct {
+	Instance       types.String `tfsdk:"instance"`
 	KeySize        types.Int64  `tfsdk:"key_size"`
 	CertificatePEM types.String `tfsdk:"certificate_pem"`
 	helpers.CertificateConfig
 }
 
 type SignedChainSystemCertificateResourceConfig struct {
+	Instance       types.String `tfsdk:"instance"`
 	SignedChain    types.String `tfsdk:"signed_chain"`
 	CertificatePEM types.String `tfsdk:"certificate_pem"`
 	helpers.CertificateConfig
 }
 
 type PKCS12SystemCertificateResourceConfig struct {
+	Instance          types.String `tfsdk:"instance"`
 	PKCS12Certificate types.String `tfsdk:"pkcs12_certificate"`
 	Password          types.String `tfsdk:"password"`
 	KeyPassword       types.String `tfsdk:"key_password"`
@@ -55,18 +60,21 @@ type PKCS12SystemCertificateResourceConfig struct {
 }
 
 type SelfSignedCACertificateResourceConfig struct {
+	Instance       types.String `tfsdk:"instance"`
 	KeySize        types.Int64  `tfsdk:"key_size"`
 	CertificatePEM types.String `tfsdk:"certificate_pem"`
 	helpers.CertificateWithSANConfig
 }
 
 type SignedChainCACertificateResourceConfig struct {
+	Instance       types.String `tfsdk:"instance"`
 	SignedChain    types.String `tfsdk:"signed_chain"`
 	CertificatePEM types.String `tfsdk:"certificate_pem"`
 	helpers.CertificateWithSANConfig
 }
 
 type PKCS12CACertificateResourceConfig struct {
+	Instance          types.String `tfsdk:"instance"`
 	PKCS12Certificate types.String `tfsdk:"pkcs12_certificate"`
 	Password          types.String `tfsdk:"password"`
 	KeyPassword       types.String `tfsdk:"key_password"`
@@ -75,16 +83,19 @@ type PKCS12CACertificateResourceConfig struct {
 }
 
 type SelfSignedUICertificateResourceConfig struct {
-	KeySize types.Int64 `tfsdk:"key_size"`
+	Instance types.String `tfsdk:"instance"`
+	KeySize  types.Int64  `tfsdk:"key_size"`
 	helpers.CertificateWithSANConfig
 }
 
 type SignedChainUICertificateResourceConfig struct {
+	Instance    types.String `tfsdk:"instance"`
 	SignedChain types.String `tfsdk:"signed_chain"`
 	helpers.CertificateWithSANConfig
 }
 
 type PKCS12UICertificateResourceConfig struct {
+	Instance          types.String `tfsdk:"instance"`
 	PKCS12Certificate types.String `tfsdk:"pkcs12_certificate"`
 	Password          types.String `tfsdk:"password"`
 	KeyPassword       types.String `tfsdk:"key_password"`
@@ -92,6 +103,7 @@ type PKCS12UICertificateResourceConfig struct {
 }
 
 type CSRActionConfig struct {
+	Instance                types.String `tfsdk:"instance"`
 	Type                    types.String `tfsdk:"type"`
 	KeySize                 types.Int64  `tfsdk:"key_size"`
 	SubjectDN               types.Object `tfsdk:"subject_dn"`
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ConnectorVersionConfig struct {
+	Instance    types.String `tfsdk:"instance"`
+	Version     types.String `tfsdk:"version"`
+	Major       types.Int64  `tfsdk:"major"`
+	Minor       types.Int64  `tfsdk:"minor"`
+	Micro       types.Int64  `tfsdk:"micro"`
+	Build       types.String `tfsdk:"build"`
+	JavaRuntime types.String `tfsdk:"java_runtime"`
+}
+
+func ConnectorVersionValueFrom(ctx context.Context, plan ConnectorVersionConfig, value apiobjects.ConnectorVersion) (ConnectorVersionConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	version, err := api.ParseVersion(value.Version)
+	if err != nil {
+		diags.AddError("Unknown Cloud Connector Version", fmt.Sprintf("The Cloud Connector reported a version that cannot be parsed: %v", err))
+		return ConnectorVersionConfig{}, diags
+	}
+
+	// Cloud Connector versions that do not report the build separately include
+	// it as fourth component of the version.
+	build := valueOrNullString(value.Build)
+	if build.IsNull() && version.Build > 0 {
+		build = types.StringValue(strconv.Itoa(version.Build))
+	}
+
+	model := &ConnectorVersionConfig{
+		Instance:    plan.Instance,
+		Version:     types.StringValue(value.Version),
+		Major:       types.Int64Value(int64(version.Major)),
+		Minor:       types.Int64Value(int64(version.Minor)),
+		Micro:       types.Int64Value(int64(version.Micro)),
+		Build:       build,
+		JavaRuntime: valueOrNullString(value.JavaVersion),
+	}
+
+	return *model, diags
+}
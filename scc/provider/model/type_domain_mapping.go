@@ -9,6 +9,7 @@ import (
 )
 
 type DomainMappingConfig struct {
+	Instance       types.String `tfsdk:"instance"`
 	RegionHost     types.String `tfsdk:"region_host"`
 	Subaccount     types.String `tfsdk:"subaccount"`
 	VirtualDomain  types.String `tfsdk:"virtual_domain"`
@@ -21,12 +22,14 @@ type DomainMapping struct {
 }
 
 type DomainMappingsConfig struct {
+	Instance       types.String    `tfsdk:"instance"`
 	RegionHost     types.String    `tfsdk:"region_host"`
 	Subaccount     types.String    `tfsdk:"subaccount"`
 	DomainMappings []DomainMapping `tfsdk:"domain_mappings"`
 }
 
 type DomainMappingListFilterModel struct {
+	Instance   types.String `tfsdk:"instance"`
 	RegionHost types.String `tfsdk:"region_host"`
 	Subaccount types.String `tfsdk:"subaccount"`
 }
@@ -42,6 +45,7 @@ func DomainMappingsValueFrom(ctx context.Context, plan DomainMappingsConfig, val
 	}
 
 	model := &DomainMappingsConfig{
+		Instance:       plan.Instance,
 		RegionHost:     plan.RegionHost,
 		Subaccount:     plan.Subaccount,
 		DomainMappings: domain_mappings,
@@ -52,6 +56,7 @@ func DomainMappingsValueFrom(ctx context.Context, plan DomainMappingsConfig, val
 
 func DomainMappingValueFrom(ctx context.Context, plan DomainMappingConfig, value apiobjects.DomainMapping) (DomainMappingConfig, diag.Diagnostics) {
 	model := &DomainMappingConfig{
+		Instance:       plan.Instance,
 		RegionHost:     plan.RegionHost,
 		Subaccount:     plan.Subaccount,
 		VirtualDomain:  types.StringValue(value.VirtualDomain),
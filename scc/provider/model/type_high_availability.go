@@ -0,0 +1,114 @@
+package model
+
+import (
+	"context"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	HAMasterSettingsID   = "ha-master-settings"
+	HAShadowConnectionID = "ha-shadow-connection"
+)
+
+type HAMasterSettingsResourceConfig struct {
+	Instance types.String `tfsdk:"instance"`
+	// INPUT
+	HAEnabled         types.Bool   `tfsdk:"ha_enabled"`
+	AllowedShadowHost types.String `tfsdk:"allowed_shadow_host"`
+	// OUTPUT
+	ID types.String `tfsdk:"id"` // Always `ha-master-settings`.
+}
+
+type HAShadowConnectionResourceConfig struct {
+	Instance types.String `tfsdk:"instance"`
+	// INPUT
+	MasterHost     types.String `tfsdk:"master_host"`
+	MasterPort     types.Int64  `tfsdk:"master_port"`
+	OwnHost        types.String `tfsdk:"own_host"`
+	CheckInterval  types.Int64  `tfsdk:"check_interval"`
+	TakeoverDelay  types.Int64  `tfsdk:"takeover_delay"`
+	ConnectTimeout types.Int64  `tfsdk:"connect_timeout"`
+	RequestTimeout types.Int64  `tfsdk:"request_timeout"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	// OUTPUT
+	State        types.String `tfsdk:"state"`
+	StateMessage types.String `tfsdk:"state_message"`
+	ID           types.String `tfsdk:"id"` // Always `ha-shadow-connection`.
+}
+
+type HAStatusDataSourceConfig struct {
+	Instance          types.String `tfsdk:"instance"`
+	Role              types.String `tfsdk:"role"`
+	State             types.String `tfsdk:"state"`
+	HAEnabled         types.Bool   `tfsdk:"ha_enabled"`
+	AllowedShadowHost types.String `tfsdk:"allowed_shadow_host"`
+	MasterHost        types.String `tfsdk:"master_host"`
+	MasterPort        types.Int64  `tfsdk:"master_port"`
+}
+
+func HAMasterSettingsResourceValueFrom(ctx context.Context, value apiobjects.HAMasterConfiguration) (HAMasterSettingsResourceConfig, diag.Diagnostics) {
+	model := &HAMasterSettingsResourceConfig{
+		ID:                types.StringValue(HAMasterSettingsID),
+		HAEnabled:         types.BoolValue(value.HAEnabled),
+		AllowedShadowHost: valueOrNullString(value.AllowedShadowHost),
+	}
+
+	return *model, diag.Diagnostics{}
+}
+
+func HAShadowConnectionResourceValueFrom(ctx context.Context, plan HAShadowConnectionResourceConfig, config apiobjects.HAShadowConfiguration, state apiobjects.HAShadowState) (HAShadowConnectionResourceConfig, diag.Diagnostics) {
+	model := &HAShadowConnectionResourceConfig{
+		Instance:       plan.Instance,
+		ID:             types.StringValue(HAShadowConnectionID),
+		MasterHost:     types.StringValue(config.MasterHost),
+		MasterPort:     types.Int64Value(config.MasterPort),
+		OwnHost:        valueOrNullString(config.OwnHost),
+		CheckInterval:  types.Int64Value(config.CheckIntervalInSeconds),
+		TakeoverDelay:  types.Int64Value(config.TakeoverDelayInSeconds),
+		ConnectTimeout: types.Int64Value(config.ConnectTimeoutInMillis),
+		RequestTimeout: types.Int64Value(config.RequestTimeoutInMillis),
+		// The master credentials are only used to establish the connection and
+		// cannot be read back from the Cloud Connector.
+		Username:     plan.Username,
+		Password:     plan.Password,
+		State:        valueOrNullString(state.State),
+		StateMessage: valueOrNullString(state.StateMessage),
+	}
+
+	return *model, diag.Diagnostics{}
+}
+
+func HAStatusDataSourceValueFrom(ctx context.Context, role string, masterConfig *apiobjects.HAMasterConfiguration, masterState *apiobjects.HAMasterState, shadowConfig *apiobjects.HAShadowConfiguration, shadowState *apiobjects.HAShadowState) (HAStatusDataSourceConfig, diag.Diagnostics) {
+	model := &HAStatusDataSourceConfig{
+		Role:              types.StringValue(role),
+		State:             types.StringNull(),
+		HAEnabled:         types.BoolNull(),
+		AllowedShadowHost: types.StringNull(),
+		MasterHost:        types.StringNull(),
+		MasterPort:        types.Int64Null(),
+	}
+
+	if masterConfig != nil {
+		model.HAEnabled = types.BoolValue(masterConfig.HAEnabled)
+		model.AllowedShadowHost = valueOrNullString(masterConfig.AllowedShadowHost)
+	}
+
+	if masterState != nil {
+		model.State = valueOrNullString(masterState.State)
+	}
+
+	if shadowConfig != nil {
+		model.MasterHost = valueOrNullString(shadowConfig.MasterHost)
+		model.MasterPort = types.Int64Value(shadowConfig.MasterPort)
+	}
+
+	if shadowState != nil {
+		model.State = valueOrNullString(shadowState.State)
+	}
+
+	return *model, diag.Diagnostics{}
+}
@@ -0,0 +1,125 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	KerberosSettingsID = "kerberos-settings"
+
+	defaultKDCPort = 88
+)
+
+type KerberosSettingsConfig struct {
+	Instance types.String `tfsdk:"instance"`
+	// INPUT
+	Realm         types.String `tfsdk:"realm"`
+	KDCHosts      types.List   `tfsdk:"kdc_hosts"`
+	ServiceUser   types.String `tfsdk:"service_user"`
+	Keytab        types.String `tfsdk:"keytab_wo"`
+	KeytabVersion types.Int64  `tfsdk:"keytab_wo_version"`
+	// OUTPUT
+	ID types.String `tfsdk:"id"` // Always `kerberos-settings`.
+}
+
+// KerberosConfigured reports whether the Cloud Connector can use Kerberos
+// for principal propagation.
+func KerberosConfigured(value apiobjects.KerberosSettings) bool {
+	return value.Realm != "" && len(value.KDCs) > 0
+}
+
+// KerberosSettingsRequest converts the planned Kerberos configuration into the
+// request body of the Cloud Connector. An empty keytab keeps the stored one.
+func KerberosSettingsRequest(ctx context.Context, plan KerberosSettingsConfig, keytab string) (apiobjects.KerberosSettings, diag.Diagnostics) {
+	var hosts []string
+	diags := plan.KDCHosts.ElementsAs(ctx, &hosts, false)
+	if diags.HasError() {
+		return apiobjects.KerberosSettings{}, diags
+	}
+
+	request := apiobjects.KerberosSettings{
+		Realm:       plan.Realm.ValueString(),
+		ServiceUser: plan.ServiceUser.ValueString(),
+		Keytab:      keytab,
+	}
+
+	for _, host := range hosts {
+		kdc, err := parseKDCHost(host)
+		if err != nil {
+			diags.AddError("Invalid KDC Host", err.Error())
+			return apiobjects.KerberosSettings{}, diags
+		}
+		request.KDCs = append(request.KDCs, kdc)
+	}
+
+	return request, diags
+}
+
+func KerberosSettingsResourceValueFrom(ctx context.Context, plan KerberosSettingsConfig, value apiobjects.KerberosSettings) (KerberosSettingsConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var planned []string
+	if !plan.KDCHosts.IsNull() && !plan.KDCHosts.IsUnknown() {
+		diags.Append(plan.KDCHosts.ElementsAs(ctx, &planned, false)...)
+	}
+
+	hosts := []string{}
+	for i, kdc := range value.KDCs {
+		// Keep the host as configured, e.g. without the default port, as
+		// long as it denotes the same KDC.
+		if i < len(planned) {
+			if plannedKDC, err := parseKDCHost(planned[i]); err == nil && strings.EqualFold(plannedKDC.Host, kdc.Host) && plannedKDC.Port == kdc.Port {
+				hosts = append(hosts, planned[i])
+				continue
+			}
+		}
+		hosts = append(hosts, formatKDCHost(kdc))
+	}
+
+	hostsValue, d := types.ListValueFrom(ctx, types.StringType, hosts)
+	diags.Append(d...)
+
+	model := &KerberosSettingsConfig{
+		Instance:      plan.Instance,
+		ID:            types.StringValue(KerberosSettingsID),
+		Realm:         types.StringValue(value.Realm),
+		KDCHosts:      hostsValue,
+		ServiceUser:   types.StringValue(value.ServiceUser),
+		KeytabVersion: plan.KeytabVersion,
+	}
+
+	return *model, diags
+}
+
+// parseKDCHost converts host[:port] into a KDC of the Cloud Connector. Hosts
+// without port use the default Kerberos port 88.
+func parseKDCHost(value string) (apiobjects.KerberosKDC, error) {
+	host, port, err := net.SplitHostPort(value)
+	if err != nil {
+		if value == "" || strings.Contains(value, ":") {
+			return apiobjects.KerberosKDC{}, fmt.Errorf("%q is not a valid KDC host", value)
+		}
+		return apiobjects.KerberosKDC{Host: value, Port: defaultKDCPort}, nil
+	}
+
+	kdc := apiobjects.KerberosKDC{Host: host}
+	if kdc.Port, err = strconv.ParseInt(port, 10, 64); err != nil || host == "" {
+		return apiobjects.KerberosKDC{}, fmt.Errorf("%q is not a valid KDC host", value)
+	}
+	return kdc, nil
+}
+
+func formatKDCHost(kdc apiobjects.KerberosKDC) string {
+	if kdc.Port == 0 {
+		return kdc.Host
+	}
+	return net.JoinHostPort(kdc.Host, strconv.FormatInt(kdc.Port, 10))
+}
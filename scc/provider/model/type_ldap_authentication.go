@@ -0,0 +1,174 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const LDAPAuthenticationID = "ldap-authentication"
+
+type LDAPAuthenticationConfig struct {
+	Instance types.String `tfsdk:"instance"`
+	// INPUT
+	Hosts              types.List   `tfsdk:"hosts"`
+	Secure             types.Bool   `tfsdk:"secure"`
+	User               types.String `tfsdk:"user"`
+	Password           types.String `tfsdk:"password"`
+	UserSearchBase     types.String `tfsdk:"user_search_base"`
+	UserSearchFilter   types.String `tfsdk:"user_search_filter"`
+	GroupSearchBase    types.String `tfsdk:"group_search_base"`
+	GroupSearchFilter  types.String `tfsdk:"group_search_filter"`
+	GroupNameAttribute types.String `tfsdk:"group_name_attribute"`
+	RoleMapping        types.Map    `tfsdk:"role_mapping"`
+	// OUTPUT
+	Active types.Bool   `tfsdk:"active"`
+	ID     types.String `tfsdk:"id"` // Always `ldap-authentication`.
+}
+
+type SwitchToLDAPAuthenticationActionConfig struct {
+	Instance types.String `tfsdk:"instance"`
+}
+
+// LDAPAuthenticationRequest converts the planned LDAP configuration into the
+// request body of the Cloud Connector.
+func LDAPAuthenticationRequest(ctx context.Context, plan LDAPAuthenticationConfig) (apiobjects.LDAPAuthentication, diag.Diagnostics) {
+	var hosts []string
+	diags := plan.Hosts.ElementsAs(ctx, &hosts, false)
+	if diags.HasError() {
+		return apiobjects.LDAPAuthentication{}, diags
+	}
+
+	roles := map[string]string{}
+	if !plan.RoleMapping.IsNull() && !plan.RoleMapping.IsUnknown() {
+		diags.Append(plan.RoleMapping.ElementsAs(ctx, &roles, false)...)
+		if diags.HasError() {
+			return apiobjects.LDAPAuthentication{}, diags
+		}
+	}
+
+	request := apiobjects.LDAPAuthentication{
+		User:                      plan.User.ValueString(),
+		Password:                  plan.Password.ValueString(),
+		UserBase:                  plan.UserSearchBase.ValueString(),
+		UserSearch:                plan.UserSearchFilter.ValueString(),
+		RoleBase:                  plan.GroupSearchBase.ValueString(),
+		RoleSearch:                plan.GroupSearchFilter.ValueString(),
+		RoleName:                  plan.GroupNameAttribute.ValueString(),
+		CustomAdminRole:           roles[RoleAdministrator],
+		CustomSubaccountAdminRole: roles[RoleSubaccountAdministrator],
+		CustomDisplayRole:         roles[RoleDisplay],
+		CustomSupportRole:         roles[RoleSupport],
+		CustomMonitoringRole:      roles[RoleMonitoring],
+	}
+
+	for _, host := range hosts {
+		ldapHost, err := parseLDAPURL(host)
+		if err != nil {
+			diags.AddError("Invalid LDAP URL", err.Error())
+			return apiobjects.LDAPAuthentication{}, diags
+		}
+		request.Hosts = append(request.Hosts, ldapHost)
+	}
+
+	return request, diags
+}
+
+func LDAPAuthenticationResourceValueFrom(ctx context.Context, plan LDAPAuthenticationConfig, value apiobjects.LDAPAuthentication, authenticationType string) (LDAPAuthenticationConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var planned []string
+	if !plan.Hosts.IsNull() && !plan.Hosts.IsUnknown() {
+		diags.Append(plan.Hosts.ElementsAs(ctx, &planned, false)...)
+	}
+
+	hosts := []string{}
+	secure := false
+	for i, host := range value.Hosts {
+		secure = secure || host.IsSecure
+		// Keep the URL as configured, as long as it denotes the same host.
+		if i < len(planned) {
+			if plannedHost, err := parseLDAPURL(planned[i]); err == nil && strings.EqualFold(plannedHost.Host, host.Host) && plannedHost.Port == host.Port && plannedHost.IsSecure == host.IsSecure {
+				hosts = append(hosts, planned[i])
+				continue
+			}
+		}
+		hosts = append(hosts, formatLDAPURL(host))
+	}
+
+	hostsValue, d := types.ListValueFrom(ctx, types.StringType, hosts)
+	diags.Append(d...)
+
+	roles := map[string]string{}
+	for role, group := range map[string]string{
+		RoleAdministrator:           value.CustomAdminRole,
+		RoleSubaccountAdministrator: value.CustomSubaccountAdminRole,
+		RoleDisplay:                 value.CustomDisplayRole,
+		RoleSupport:                 value.CustomSupportRole,
+		RoleMonitoring:              value.CustomMonitoringRole,
+	} {
+		if group != "" {
+			roles[role] = group
+		}
+	}
+
+	roleMapping, d := types.MapValueFrom(ctx, types.StringType, roles)
+	diags.Append(d...)
+
+	model := &LDAPAuthenticationConfig{
+		Instance:           plan.Instance,
+		ID:                 types.StringValue(LDAPAuthenticationID),
+		Hosts:              hostsValue,
+		Secure:             types.BoolValue(secure),
+		User:               valueOrNullString(value.User),
+		Password:           plan.Password, // The Cloud Connector does not return the password.
+		UserSearchBase:     types.StringValue(value.UserBase),
+		UserSearchFilter:   valueOrNullString(value.UserSearch),
+		GroupSearchBase:    types.StringValue(value.RoleBase),
+		GroupSearchFilter:  valueOrNullString(value.RoleSearch),
+		GroupNameAttribute: valueOrNullString(value.RoleName),
+		RoleMapping:        roleMapping,
+		Active:             types.BoolValue(authenticationType == apiobjects.AuthenticationTypeLDAP),
+	}
+
+	return *model, diags
+}
+
+// parseLDAPURL converts an ldap:// or ldaps:// URL into an LDAP host of the
+// Cloud Connector. URLs without port use the default port of their scheme.
+func parseLDAPURL(value string) (apiobjects.LDAPHost, error) {
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "ldap" && u.Scheme != "ldaps") || u.Hostname() == "" {
+		return apiobjects.LDAPHost{}, fmt.Errorf("%q is not a valid LDAP URL", value)
+	}
+
+	host := apiobjects.LDAPHost{Host: u.Hostname(), Port: 389, IsSecure: u.Scheme == "ldaps"}
+	if host.IsSecure {
+		host.Port = 636
+	}
+	if port := u.Port(); port != "" {
+		if host.Port, err = strconv.ParseInt(port, 10, 64); err != nil {
+			return apiobjects.LDAPHost{}, fmt.Errorf("%q is not a valid LDAP URL", value)
+		}
+	}
+
+	return host, nil
+}
+
+func formatLDAPURL(host apiobjects.LDAPHost) string {
+	scheme := "ldap"
+	if host.IsSecure {
+		scheme = "ldaps"
+	}
+	if host.Port == 0 {
+		return scheme + "://" + host.Host
+	}
+	return scheme + "://" + net.JoinHostPort(host.Host, strconv.FormatInt(host.Port, 10))
+}
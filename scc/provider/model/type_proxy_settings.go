@@ -11,6 +11,7 @@ import (
 )
 
 type ProxySettingsDataSourceConfig struct {
+	Instance types.String `tfsdk:"instance"`
 	Host     types.String `tfsdk:"host"`
 	Port     types.Int64  `tfsdk:"port"`
 	User     types.String `tfsdk:"user"`
@@ -18,6 +19,7 @@ type ProxySettingsDataSourceConfig struct {
 }
 
 type ProxySettingsResourceConfig struct {
+	Instance types.String `tfsdk:"instance"`
 	// INPUT
 	Host     types.String `tfsdk:"host"`
 	Port     types.Int64  `tfsdk:"port"`
@@ -97,6 +99,7 @@ func ProxySettingsResourceValueFrom(ctx context.Context, plan ProxySettingsResou
 	}
 
 	model := &ProxySettingsResourceConfig{
+		Instance: plan.Instance,
 		ID:       types.StringValue("proxy-settings"),
 		Host:     valueOrNullString(value.Host),
 		Port:     portValue,
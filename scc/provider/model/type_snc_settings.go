@@ -0,0 +1,39 @@
+package model
+
+import (
+	"context"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const SNCSettingsID = "snc-settings"
+
+type SNCSettingsConfig struct {
+	Instance types.String `tfsdk:"instance"`
+	// INPUT
+	LibraryPath         types.String `tfsdk:"library_path"`
+	OwnName             types.String `tfsdk:"own_name"`
+	QualityOfProtection types.Int64  `tfsdk:"quality_of_protection"`
+	// OUTPUT
+	ID types.String `tfsdk:"id"` // Always `snc-settings`.
+}
+
+// SNCConfigured reports whether the Cloud Connector can use SNC for RFC
+// connections.
+func SNCConfigured(value apiobjects.SNCSettings) bool {
+	return value.LibraryPath != "" && value.MyName != ""
+}
+
+func SNCSettingsResourceValueFrom(ctx context.Context, plan SNCSettingsConfig, value apiobjects.SNCSettings) (SNCSettingsConfig, diag.Diagnostics) {
+	model := &SNCSettingsConfig{
+		Instance:            plan.Instance,
+		ID:                  types.StringValue(SNCSettingsID),
+		LibraryPath:         types.StringValue(value.LibraryPath),
+		OwnName:             types.StringValue(value.MyName),
+		QualityOfProtection: types.Int64Value(value.QoP),
+	}
+
+	return *model, diag.Diagnostics{}
+}
@@ -5,12 +5,14 @@ import (
 
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type SubaccountData struct {
+	Instance               types.String `tfsdk:"instance"`
 	RegionHost             types.String `tfsdk:"region_host"`
 	Subaccount             types.String `tfsdk:"subaccount"`
 	LocationID             types.String `tfsdk:"location_id"`
@@ -100,41 +102,51 @@ type SubaccountsData struct {
 }
 
 type SubaccountsConfig struct {
+	Instance    types.String      `tfsdk:"instance"`
 	Subaccounts []SubaccountsData `tfsdk:"subaccounts"`
 }
 
 type SubaccountConfig struct {
-	RegionHost             types.String `tfsdk:"region_host"`
-	Subaccount             types.String `tfsdk:"subaccount"`
-	CloudUser              types.String `tfsdk:"cloud_user"`
-	CloudPassword          types.String `tfsdk:"cloud_password"`
-	LocationID             types.String `tfsdk:"location_id"`
-	DisplayName            types.String `tfsdk:"display_name"`
-	Description            types.String `tfsdk:"description"`
-	Tunnel                 types.Object `tfsdk:"tunnel"`
-	Connected              types.Bool   `tfsdk:"connected"`
-	AutoRenewBeforeDays    types.Int64  `tfsdk:"auto_renew_before_days"`
-	IsManaged              types.Bool   `tfsdk:"is_managed"`
-	AutoCertificateRenewal types.Bool   `tfsdk:"auto_certificate_renewal"`
-	AutoTrustSync          types.Bool   `tfsdk:"auto_trust_sync"`
+	Instance               types.String   `tfsdk:"instance"`
+	RegionHost             types.String   `tfsdk:"region_host"`
+	Subaccount             types.String   `tfsdk:"subaccount"`
+	CloudUser              types.String   `tfsdk:"cloud_user"`
+	CloudPassword          types.String   `tfsdk:"cloud_password"`
+	LocationID             types.String   `tfsdk:"location_id"`
+	DisplayName            types.String   `tfsdk:"display_name"`
+	Description            types.String   `tfsdk:"description"`
+	Tunnel                 types.Object   `tfsdk:"tunnel"`
+	Connected              types.Bool     `tfsdk:"connected"`
+	AutoRenewBeforeDays    types.Int64    `tfsdk:"auto_renew_before_days"`
+	IsManaged              types.Bool     `tfsdk:"is_managed"`
+	AutoCertificateRenewal types.Bool     `tfsdk:"auto_certificate_renewal"`
+	AutoTrustSync          types.Bool     `tfsdk:"auto_trust_sync"`
+	WaitForConnection      types.Bool     `tfsdk:"wait_for_connection"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
 type SubaccountUsingAuthConfig struct {
-	RegionHost             types.String `tfsdk:"region_host"`
-	Subaccount             types.String `tfsdk:"subaccount"`
-	AuthenticationData     types.String `tfsdk:"authentication_data"`
-	LocationID             types.String `tfsdk:"location_id"`
-	DisplayName            types.String `tfsdk:"display_name"`
-	Description            types.String `tfsdk:"description"`
-	Tunnel                 types.Object `tfsdk:"tunnel"`
-	Connected              types.Bool   `tfsdk:"connected"`
-	IsManaged              types.Bool   `tfsdk:"is_managed"`
-	AutoCertificateRenewal types.Bool   `tfsdk:"auto_certificate_renewal"`
-	AutoTrustSync          types.Bool   `tfsdk:"auto_trust_sync"`
+	Instance               types.String   `tfsdk:"instance"`
+	RegionHost             types.String   `tfsdk:"region_host"`
+	Subaccount             types.String   `tfsdk:"subaccount"`
+	AuthenticationData     types.String   `tfsdk:"authentication_data"`
+	LocationID             types.String   `tfsdk:"location_id"`
+	DisplayName            types.String   `tfsdk:"display_name"`
+	Description            types.String   `tfsdk:"description"`
+	Tunnel                 types.Object   `tfsdk:"tunnel"`
+	Connected              types.Bool     `tfsdk:"connected"`
+	IsManaged              types.Bool     `tfsdk:"is_managed"`
+	AutoCertificateRenewal types.Bool     `tfsdk:"auto_certificate_renewal"`
+	AutoTrustSync          types.Bool     `tfsdk:"auto_trust_sync"`
+	WaitForConnection      types.Bool     `tfsdk:"wait_for_connection"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
 type SubaccountListFilterModel struct {
-	RegionHost types.String `tfsdk:"region_host"`
+	Instance      types.String `tfsdk:"instance"`
+	RegionHost    types.String `tfsdk:"region_host"`
+	TunnelState   types.String `tfsdk:"tunnel_state"`
+	IncludeTunnel types.Bool   `tfsdk:"include_tunnel"`
 }
 
 func SubaccountsDataSourceValueFrom(value apiobjects.SubaccountsDataSource) (SubaccountsConfig, diag.Diagnostics) {
@@ -238,7 +250,7 @@ func SubaccountDataSourceValueFrom(ctx context.Context, value apiobjects.Subacco
 	return *model, diag.Diagnostics{}
 }
 
-func SubaccountResourceValueFrom(ctx context.Context, plan SubaccountConfig, value apiobjects.SubaccountResource) (SubaccountConfig, diag.Diagnostics) {
+func SubaccountResourceValueFrom(ctx context.Context, plan SubaccountConfig, value apiobjects.Subaccount) (SubaccountConfig, diag.Diagnostics) {
 	certificateObj := SubaccountCertificateData{
 		ValidTo:      helpers.ConvertMillisToTimes(value.Tunnel.SubaccountCertificate.NotAfterTimeStamp).WithTimezone,
 		ValidFrom:    helpers.ConvertMillisToTimes(value.Tunnel.SubaccountCertificate.NotBeforeTimeStamp).WithTimezone,
@@ -315,6 +327,7 @@ func SubaccountResourceValueFrom(ctx context.Context, plan SubaccountConfig, val
 	}
 
 	model := &SubaccountConfig{
+		Instance:               plan.Instance,
 		RegionHost:             types.StringValue(value.RegionHost),
 		Subaccount:             types.StringValue(value.Subaccount),
 		LocationID:             types.StringValue(value.LocationID),
@@ -324,11 +337,13 @@ func SubaccountResourceValueFrom(ctx context.Context, plan SubaccountConfig, val
 		Connected:              types.BoolValue(value.Tunnel.State == "Connected"),
 		IsManaged:              isManaged,
 		AutoCertificateRenewal: autoCertRenewal,
+		WaitForConnection:      waitForConnectionValue(plan.WaitForConnection),
+		Timeouts:               timeoutsValue(plan.Timeouts),
 	}
 	return *model, diag.Diagnostics{}
 }
 
-func SubaccountUsingAuthResourceValueFrom(ctx context.Context, plan SubaccountUsingAuthConfig, value apiobjects.SubaccountUsingAuthResource) (SubaccountUsingAuthConfig, diag.Diagnostics) {
+func SubaccountUsingAuthResourceValueFrom(ctx context.Context, plan SubaccountUsingAuthConfig, value apiobjects.Subaccount) (SubaccountUsingAuthConfig, diag.Diagnostics) {
 	certificateObj := SubaccountCertificateData{
 		ValidTo:      helpers.ConvertMillisToTimes(value.Tunnel.SubaccountCertificate.NotAfterTimeStamp).WithTimezone,
 		ValidFrom:    helpers.ConvertMillisToTimes(value.Tunnel.SubaccountCertificate.NotBeforeTimeStamp).WithTimezone,
@@ -405,6 +420,7 @@ func SubaccountUsingAuthResourceValueFrom(ctx context.Context, plan SubaccountUs
 	}
 
 	model := &SubaccountUsingAuthConfig{
+		Instance:               plan.Instance,
 		RegionHost:             types.StringValue(value.RegionHost),
 		Subaccount:             types.StringValue(value.Subaccount),
 		LocationID:             types.StringValue(value.LocationID),
@@ -414,6 +430,26 @@ func SubaccountUsingAuthResourceValueFrom(ctx context.Context, plan SubaccountUs
 		Connected:              types.BoolValue(value.Tunnel.State == "Connected"),
 		IsManaged:              isManaged,
 		AutoCertificateRenewal: autoCertRenewal,
+		WaitForConnection:      waitForConnectionValue(plan.WaitForConnection),
+		Timeouts:               timeoutsValue(plan.Timeouts),
 	}
 	return *model, diag.Diagnostics{}
 }
+
+// The wait options are not returned by the API, so the configured values are kept.
+func waitForConnectionValue(planned types.Bool) types.Bool {
+	if planned.IsNull() || planned.IsUnknown() {
+		return types.BoolValue(false)
+	}
+	return planned
+}
+
+func timeoutsValue(planned timeouts.Value) timeouts.Value {
+	if planned.IsNull() || planned.IsUnknown() {
+		return timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"update": types.StringType,
+		})}
+	}
+	return planned
+}
@@ -34,6 +34,7 @@ var SubaccountABAPServiceChannelStateType = map[string]attr.Type{
 }
 
 type SubaccountABAPServiceChannelConfig struct {
+	Instance            types.String `tfsdk:"instance"`
 	RegionHost          types.String `tfsdk:"region_host"`
 	Subaccount          types.String `tfsdk:"subaccount"`
 	SNCEncrypted        types.Bool   `tfsdk:"snc_encrypted"`
@@ -49,6 +50,7 @@ type SubaccountABAPServiceChannelConfig struct {
 }
 
 type SubaccountABAPServiceChannelsConfig struct {
+	Instance                      types.String                   `tfsdk:"instance"`
 	RegionHost                    types.String                   `tfsdk:"region_host"`
 	Subaccount                    types.String                   `tfsdk:"subaccount"`
 	SNCEncrypted                  types.Bool                     `tfsdk:"snc_encrypted"`
@@ -56,6 +58,7 @@ type SubaccountABAPServiceChannelsConfig struct {
 }
 
 type SubaccountABAPServiceChannelListResourceFilterModel struct {
+	Instance     types.String `tfsdk:"instance"`
 	RegionHost   types.String `tfsdk:"region_host"`
 	Subaccount   types.String `tfsdk:"subaccount"`
 	SNCEncrypted types.Bool   `tfsdk:"snc_encrypted"`
@@ -74,6 +77,7 @@ func SubaccountABAPServiceChannelValueFrom(ctx context.Context, plan SubaccountA
 	}
 
 	model := &SubaccountABAPServiceChannelConfig{
+		Instance:            plan.Instance,
 		RegionHost:          plan.RegionHost,
 		Subaccount:          plan.Subaccount,
 		SNCEncrypted:        plan.SNCEncrypted,
@@ -120,6 +124,7 @@ func SubaccountABAPServiceChannelsValueFrom(ctx context.Context, plan Subaccount
 	}
 
 	model := &SubaccountABAPServiceChannelsConfig{
+		Instance:                      plan.Instance,
 		RegionHost:                    plan.RegionHost,
 		Subaccount:                    plan.Subaccount,
 		SNCEncrypted:                  plan.SNCEncrypted,
@@ -142,6 +147,7 @@ func SubaccountABAPServiceChannelListValueFrom(ctx context.Context, filter Subac
 	}
 
 	listRes := &SubaccountABAPServiceChannelConfig{
+		Instance:            filter.Instance,
 		RegionHost:          filter.RegionHost,
 		Subaccount:          filter.Subaccount,
 		SNCEncrypted:        filter.SNCEncrypted,
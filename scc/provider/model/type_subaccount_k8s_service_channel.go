@@ -34,6 +34,7 @@ var SubaccountK8SServiceChannelStateType = map[string]attr.Type{
 }
 
 type SubaccountK8SServiceChannelConfig struct {
+	Instance       types.String `tfsdk:"instance"`
 	RegionHost     types.String `tfsdk:"region_host"`
 	Subaccount     types.String `tfsdk:"subaccount"`
 	K8SClusterHost types.String `tfsdk:"k8s_cluster_host"`
@@ -48,12 +49,14 @@ type SubaccountK8SServiceChannelConfig struct {
 }
 
 type SubaccountK8SServiceChannelsConfig struct {
+	Instance                     types.String                  `tfsdk:"instance"`
 	RegionHost                   types.String                  `tfsdk:"region_host"`
 	Subaccount                   types.String                  `tfsdk:"subaccount"`
 	SubaccountK8SServiceChannels []SubaccountK8SServiceChannel `tfsdk:"subaccount_k8s_service_channels"`
 }
 
 type SubaccountK8SServiceChannelListResourceFilterModel struct {
+	Instance   types.String `tfsdk:"instance"`
 	RegionHost types.String `tfsdk:"region_host"`
 	Subaccount types.String `tfsdk:"subaccount"`
 }
@@ -71,6 +74,7 @@ func SubaccountK8SServiceChannelValueFrom(ctx context.Context, plan SubaccountK8
 	}
 
 	model := &SubaccountK8SServiceChannelConfig{
+		Instance:       plan.Instance,
 		RegionHost:     plan.RegionHost,
 		Subaccount:     plan.Subaccount,
 		K8SClusterHost: types.StringValue(value.K8SClusterHost),
@@ -116,6 +120,7 @@ func SubaccountK8SServiceChannelsValueFrom(ctx context.Context, plan SubaccountK
 	}
 
 	model := &SubaccountK8SServiceChannelsConfig{
+		Instance:                     plan.Instance,
 		RegionHost:                   plan.RegionHost,
 		Subaccount:                   plan.Subaccount,
 		SubaccountK8SServiceChannels: serviceChannels,
@@ -137,6 +142,7 @@ func SubaccountK8SServiceChannelListValueFrom(ctx context.Context, filter Subacc
 	}
 
 	listRes := &SubaccountK8SServiceChannelConfig{
+		Instance:       filter.Instance,
 		RegionHost:     filter.RegionHost,
 		Subaccount:     filter.Subaccount,
 		K8SClusterHost: types.StringValue(value.K8SClusterHost),
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"context"
+	"slices"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SubaccountTrustConfigurationConfig struct {
+	Instance   types.String `tfsdk:"instance"`
+	RegionHost types.String `tfsdk:"region_host"`
+	Subaccount types.String `tfsdk:"subaccount"`
+	// INPUT
+	TrustedIdentityProviders types.Set `tfsdk:"trusted_identity_providers"`
+	TrustedApplications      types.Set `tfsdk:"trusted_applications"`
+	// OUTPUT
+	IdentityProviders types.Set  `tfsdk:"identity_providers"`
+	Applications      types.Set  `tfsdk:"applications"`
+	AutoTrustSync     types.Bool `tfsdk:"auto_trust_sync"`
+}
+
+type SyncSubaccountTrustConfigurationActionConfig struct {
+	Instance   types.String `tfsdk:"instance"`
+	RegionHost types.String `tfsdk:"region_host"`
+	Subaccount types.String `tfsdk:"subaccount"`
+}
+
+// SubaccountTrustedRequest marks the synchronized entities whose names are in
+// trusted as trusted and all others as not trusted. It also returns the
+// trusted names that are not part of the synchronized entities.
+func SubaccountTrustedRequest(entities []apiobjects.SubaccountTrusted, trusted []string) ([]apiobjects.SubaccountTrusted, []string) {
+	request := make([]apiobjects.SubaccountTrusted, 0, len(entities))
+	var unknown []string
+
+	for _, entity := range entities {
+		entity.Trusted = slices.Contains(trusted, entity.Name)
+		request = append(request, entity)
+	}
+
+	for _, name := range trusted {
+		if !slices.ContainsFunc(entities, func(entity apiobjects.SubaccountTrusted) bool { return entity.Name == name }) {
+			unknown = append(unknown, name)
+		}
+	}
+
+	return request, unknown
+}
+
+func SubaccountTrustConfigurationValueFrom(ctx context.Context, plan SubaccountTrustConfigurationConfig, value apiobjects.SubaccountTrustConfiguration) (SubaccountTrustConfigurationConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	trustedIdentityProviders, identityProviders, d := subaccountTrustedValueFrom(ctx, value.IdentityProviders)
+	diags.Append(d...)
+	trustedApplications, applications, d := subaccountTrustedValueFrom(ctx, value.Applications)
+	diags.Append(d...)
+
+	model := &SubaccountTrustConfigurationConfig{
+		Instance:                 plan.Instance,
+		RegionHost:               plan.RegionHost,
+		Subaccount:               plan.Subaccount,
+		TrustedIdentityProviders: trustedIdentityProviders,
+		TrustedApplications:      trustedApplications,
+		IdentityProviders:        identityProviders,
+		Applications:             applications,
+		AutoTrustSync:            types.BoolValue(value.AutoSyncTrustEnabled),
+	}
+
+	return *model, diags
+}
+
+// subaccountTrustedValueFrom returns the names of the trusted entities and
+// the names of all entities.
+func subaccountTrustedValueFrom(ctx context.Context, entities []apiobjects.SubaccountTrusted) (types.Set, types.Set, diag.Diagnostics) {
+	trusted := []string{}
+	all := []string{}
+	for _, entity := range entities {
+		all = append(all, entity.Name)
+		if entity.Trusted {
+			trusted = append(trusted, entity.Name)
+		}
+	}
+
+	trustedValue, diags := types.SetValueFrom(ctx, types.StringType, trusted)
+	allValue, d := types.SetValueFrom(ctx, types.StringType, all)
+	diags.Append(d...)
+
+	return trustedValue, allValue, diags
+}
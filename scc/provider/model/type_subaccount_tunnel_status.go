@@ -0,0 +1,59 @@
+package model
+
+import (
+	"context"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SubaccountTunnelStatusConfig struct {
+	Instance               types.String                           `tfsdk:"instance"`
+	RegionHost             types.String                           `tfsdk:"region_host"`
+	Subaccount             types.String                           `tfsdk:"subaccount"`
+	State                  types.String                           `tfsdk:"state"`
+	Connected              types.Bool                             `tfsdk:"connected"`
+	ConnectedSince         types.String                           `tfsdk:"connected_since"`
+	Connections            types.Int64                            `tfsdk:"connections"`
+	ApplicationConnections []SubaccountApplicationConnectionsData `tfsdk:"application_connections"`
+	ServiceChannels        []SubaccountServiceChannelsData        `tfsdk:"service_channels"`
+}
+
+func SubaccountTunnelStatusValueFrom(ctx context.Context, plan SubaccountTunnelStatusConfig, value apiobjects.Subaccount) (SubaccountTunnelStatusConfig, diag.Diagnostics) {
+	applicationConnections := []SubaccountApplicationConnectionsData{}
+	for _, connection := range value.Tunnel.ApplicationConnections {
+		ac := SubaccountApplicationConnectionsData{
+			ConnectionCount: types.Int64Value(connection.ConnectionCount),
+			Name:            types.StringValue(connection.Name),
+			Type:            types.StringValue(connection.Type),
+		}
+		applicationConnections = append(applicationConnections, ac)
+	}
+
+	serviceChannels := []SubaccountServiceChannelsData{}
+	for _, channel := range value.Tunnel.ServiceChannels {
+		sc := SubaccountServiceChannelsData{
+			Type:    types.StringValue(channel.Type),
+			State:   types.StringValue(channel.State),
+			Details: types.StringValue(channel.Details),
+			Comment: types.StringValue(channel.Comment),
+		}
+		serviceChannels = append(serviceChannels, sc)
+	}
+
+	model := &SubaccountTunnelStatusConfig{
+		Instance:               plan.Instance,
+		RegionHost:             plan.RegionHost,
+		Subaccount:             plan.Subaccount,
+		State:                  types.StringValue(value.Tunnel.State),
+		Connected:              types.BoolValue(value.Tunnel.State == "Connected"),
+		ConnectedSince:         helpers.ConvertMillisToTimes(value.Tunnel.ConnectedSinceTimeStamp).WithTimezone,
+		Connections:            types.Int64Value(value.Tunnel.Connections),
+		ApplicationConnections: applicationConnections,
+		ServiceChannels:        serviceChannels,
+	}
+
+	return *model, diag.Diagnostics{}
+}
@@ -0,0 +1,119 @@
+package model
+
+import (
+	"context"
+	"strings"
+
+	subjectpatternrules "github.com/SAP/terraform-provider-scc/validation/subjectPatternRules"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type SubjectPatternPreviewConfig struct {
+	Instance          types.String `tfsdk:"instance"`
+	Principal         types.Object `tfsdk:"principal"`
+	Rules             types.List   `tfsdk:"rules"`
+	MatchingRuleIndex types.Int64  `tfsdk:"matching_rule_index"`
+	Subject           types.String `tfsdk:"subject"`
+}
+
+type SubjectPatternPrincipal struct {
+	Name       types.String `tfsdk:"name"`
+	Email      types.String `tfsdk:"email"`
+	UserType   types.String `tfsdk:"user_type"`
+	Attributes types.Map    `tfsdk:"attributes"`
+}
+
+var SubjectPatternPrincipalType = map[string]attr.Type{
+	"name":       types.StringType,
+	"email":      types.StringType,
+	"user_type":  types.StringType,
+	"attributes": types.MapType{ElemType: types.StringType},
+}
+
+// SubjectPatternPreview is the outcome of evaluating subject pattern rules for
+// a principal. Index is -1 if no rule matches.
+type SubjectPatternPreview struct {
+	Index   int
+	Subject string
+	Missing []string
+}
+
+// SubjectPatternVariables returns the variables of a principal as they are
+// referenced by the conditions and subject patterns. The user type defaults to
+// Business, and the attributes cannot override the named fields.
+func SubjectPatternVariables(ctx context.Context, principal SubjectPatternPrincipal) (map[string]string, diag.Diagnostics) {
+	variables := map[string]string{}
+	if !principal.Attributes.IsNull() {
+		diags := principal.Attributes.ElementsAs(ctx, &variables, false)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	variables["name"] = principal.Name.ValueString()
+	if !principal.Email.IsNull() {
+		variables["email"] = principal.Email.ValueString()
+		variables["mail"] = principal.Email.ValueString()
+	}
+	variables["user_type"] = "Business"
+	if !principal.UserType.IsNull() {
+		variables["user_type"] = principal.UserType.ValueString()
+	}
+
+	return variables, nil
+}
+
+// EvaluateSubjectPatternRules returns the subject of the first rule whose
+// condition the principal fulfills, like the Cloud Connector does when it
+// issues a short-lived certificate for principal propagation.
+func EvaluateSubjectPatternRules(ctx context.Context, rules []SubjectPatternRule, variables map[string]string) (SubjectPatternPreview, diag.Diagnostics) {
+	for i, rule := range rules {
+		var condition SubjectPatternCondition
+		diags := rule.Condition.As(ctx, &condition, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return SubjectPatternPreview{}, diags
+		}
+
+		if !subjectpatternrules.MatchesCondition(condition.Variable.ValueString(), condition.Operator.ValueString(), condition.Value.ValueString(), variables) {
+			continue
+		}
+
+		var subjectPattern SubjectPattern
+		diags = rule.SubjectPattern.As(ctx, &subjectPattern, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return SubjectPatternPreview{}, diags
+		}
+
+		preview := SubjectPatternPreview{Index: i}
+		var rdns []string
+		for _, field := range []struct {
+			key     string
+			pattern types.String
+		}{
+			{"CN", subjectPattern.CommonName},
+			{"EMAIL", subjectPattern.Email},
+			{"L", subjectPattern.Locality},
+			{"OU", subjectPattern.OrganizationUnit},
+			{"O", subjectPattern.Organization},
+			{"ST", subjectPattern.State},
+			{"C", subjectPattern.Country},
+		} {
+			if field.pattern.ValueString() == "" {
+				continue
+			}
+			value, missing := subjectpatternrules.ExpandSubjectPattern(field.pattern.ValueString(), variables)
+			preview.Missing = append(preview.Missing, missing...)
+			if value != "" {
+				rdns = append(rdns, field.key+"="+value)
+			}
+		}
+		preview.Subject = strings.Join(rdns, ", ")
+
+		return preview, nil
+	}
+
+	return SubjectPatternPreview{Index: -1}, nil
+}
@@ -2,6 +2,9 @@ package model
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -10,9 +13,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
+const SubjectPatternRulesID = "subject-pattern-rules"
+
 type SubjectPatternRulesDataSourceConfig struct {
+	Instance            types.String         `tfsdk:"instance"`
 	SubjectPatternRules []SubjectPatternRule `tfsdk:"subject_pattern_rules"`
 }
 
@@ -23,7 +30,8 @@ type SubjectPatternRule struct {
 }
 
 type SubjectPatternRuleConfig struct {
-	Index types.Int64 `tfsdk:"index"`
+	Instance types.String `tfsdk:"instance"`
+	Index    types.Int64  `tfsdk:"index"`
 	SubjectPatternRule
 }
 
@@ -59,6 +67,28 @@ var SubjectPatternType = map[string]attr.Type{
 	"c":     types.StringType,
 }
 
+type SubjectPatternRulesConfig struct {
+	Instance types.String `tfsdk:"instance"`
+	// INPUT
+	Rules types.List `tfsdk:"rules"`
+	// OUTPUT
+	ID types.String `tfsdk:"id"` // Always `subject-pattern-rules`.
+}
+
+type SubjectPatternRulesItem struct {
+	ID             types.String `tfsdk:"id"`
+	Description    types.String `tfsdk:"description"`
+	Condition      types.Object `tfsdk:"condition"`
+	SubjectPattern types.Object `tfsdk:"subject_pattern"`
+}
+
+var SubjectPatternRulesItemType = map[string]attr.Type{
+	"id":              types.StringType,
+	"description":     types.StringType,
+	"condition":       types.ObjectType{AttrTypes: SubjectPatternConditionType},
+	"subject_pattern": types.ObjectType{AttrTypes: SubjectPatternType},
+}
+
 type SubjectPatternRuleListFilterModel struct {
 	SubjectPatternRuleConfig
 }
@@ -84,7 +114,8 @@ func SubjectPatternRuleValueFrom(ctx context.Context, plan SubjectPatternRuleCon
 	}
 
 	sprModel := SubjectPatternRuleConfig{
-		Index: plan.Index,
+		Instance: plan.Instance,
+		Index:    plan.Index,
 		SubjectPatternRule: SubjectPatternRule{
 			Description:    types.StringValue(value.Description),
 			SubjectPattern: subjectPattern,
@@ -125,6 +156,7 @@ func SubjectPatternRulesValueFrom(ctx context.Context, plan SubjectPatternRulesD
 		subjectPatternRules = append(subjectPatternRules, sprModel)
 	}
 	model := SubjectPatternRulesDataSourceConfig{
+		Instance:            plan.Instance,
 		SubjectPatternRules: subjectPatternRules,
 	}
 
@@ -201,3 +233,107 @@ func ParseSubjectPatternRuleCondition(ctx context.Context, condition string) (ty
 
 	return types.ObjectValueFrom(ctx, SubjectPatternConditionType, c)
 }
+
+// IsDefaultSubjectPatternRule reports whether a rule is the default rule of
+// the Cloud Connector, which has the condition "always true" and is evaluated
+// after all other rules.
+func IsDefaultSubjectPatternRule(rule apiobjects.SubjectPatternRule) bool {
+	return strings.TrimSpace(rule.Condition) == "always true"
+}
+
+// SubjectPatternRuleRequest converts a rule of scc_subject_pattern_rules into
+// the request body of the Cloud Connector.
+func SubjectPatternRuleRequest(ctx context.Context, item SubjectPatternRulesItem) (apiobjects.SubjectPatternRuleRequest, diag.Diagnostics) {
+	var condition SubjectPatternCondition
+	diags := item.Condition.As(ctx, &condition, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return apiobjects.SubjectPatternRuleRequest{}, diags
+	}
+
+	var subjectPattern SubjectPattern
+	diags = item.SubjectPattern.As(ctx, &subjectPattern, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return apiobjects.SubjectPatternRuleRequest{}, diags
+	}
+
+	return apiobjects.SubjectPatternRuleRequest{
+		Description: item.Description.ValueString(),
+		Condition: apiobjects.SubjectPatternCondition{
+			Variable: condition.Variable.ValueString(),
+			Operator: condition.Operator.ValueString(),
+			Value:    condition.Value.ValueString(),
+		},
+		SubjectPattern: apiobjects.SubjectPattern{
+			CommonName:       subjectPattern.CommonName.ValueString(),
+			Email:            subjectPattern.Email.ValueString(),
+			Locality:         subjectPattern.Locality.ValueString(),
+			OrganizationUnit: subjectPattern.OrganizationUnit.ValueString(),
+			Organization:     subjectPattern.Organization.ValueString(),
+			State:            subjectPattern.State.ValueString(),
+			Country:          subjectPattern.Country.ValueString(),
+		},
+	}, nil
+}
+
+// SubjectPatternRuleHash identifies a rule by its content, so a rule keeps its
+// ID when other rules are inserted, deleted or reordered.
+func SubjectPatternRuleHash(rule apiobjects.SubjectPatternRuleRequest) string {
+	content, _ := json.Marshal(rule)
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:8])
+}
+
+// SubjectPatternRulesResourceValueFrom converts the rules of the Cloud
+// Connector into the state of scc_subject_pattern_rules. The default rule is
+// not managed and therefore skipped.
+func SubjectPatternRulesResourceValueFrom(ctx context.Context, plan SubjectPatternRulesConfig, value apiobjects.SubjectPatternRules) (SubjectPatternRulesConfig, diag.Diagnostics) {
+	items := []SubjectPatternRulesItem{}
+	for _, rule := range value {
+		if IsDefaultSubjectPatternRule(rule) {
+			continue
+		}
+
+		sp := SubjectPattern{
+			CommonName:       helpers.StringValueOrNull(rule.SubjectPattern.CommonName),
+			Email:            helpers.StringValueOrNull(rule.SubjectPattern.Email),
+			Locality:         helpers.StringValueOrNull(rule.SubjectPattern.Locality),
+			OrganizationUnit: helpers.StringValueOrNull(rule.SubjectPattern.OrganizationUnit),
+			Organization:     helpers.StringValueOrNull(rule.SubjectPattern.Organization),
+			State:            helpers.StringValueOrNull(rule.SubjectPattern.State),
+			Country:          helpers.StringValueOrNull(rule.SubjectPattern.Country),
+		}
+		subjectPattern, diags := types.ObjectValueFrom(ctx, SubjectPatternType, sp)
+		if diags.HasError() {
+			return SubjectPatternRulesConfig{}, diags
+		}
+
+		condition, diags := ParseSubjectPatternRuleCondition(ctx, rule.Condition)
+		if diags.HasError() {
+			return SubjectPatternRulesConfig{}, diags
+		}
+
+		item := SubjectPatternRulesItem{
+			Description:    types.StringValue(rule.Description),
+			Condition:      condition,
+			SubjectPattern: subjectPattern,
+		}
+		request, diags := SubjectPatternRuleRequest(ctx, item)
+		if diags.HasError() {
+			return SubjectPatternRulesConfig{}, diags
+		}
+		item.ID = types.StringValue(SubjectPatternRuleHash(request))
+
+		items = append(items, item)
+	}
+
+	rules, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: SubjectPatternRulesItemType}, items)
+	if diags.HasError() {
+		return SubjectPatternRulesConfig{}, diags
+	}
+
+	return SubjectPatternRulesConfig{
+		Instance: plan.Instance,
+		Rules:    rules,
+		ID:       types.StringValue(SubjectPatternRulesID),
+	}, nil
+}
@@ -12,6 +12,7 @@ import (
 )
 
 type SystemMappingConfig struct {
+	Instance              types.String `tfsdk:"instance"`
 	RegionHost            types.String `tfsdk:"region_host"`
 	Subaccount            types.String `tfsdk:"subaccount"`
 	VirtualHost           types.String `tfsdk:"virtual_host"`
@@ -34,6 +35,7 @@ type SystemMappingConfig struct {
 }
 
 type SystemMappingsConfig struct {
+	Instance       types.String    `tfsdk:"instance"`
 	RegionHost     types.String    `tfsdk:"region_host"`
 	Subaccount     types.String    `tfsdk:"subaccount"`
 	SystemMappings []SystemMapping `tfsdk:"system_mappings"`
@@ -72,6 +74,7 @@ var SystemMappingBlacklistedUsersType = types.ObjectType{
 }
 
 type SystemMappingListResourceFilterModel struct {
+	Instance   types.String `tfsdk:"instance"`
 	RegionHost types.String `tfsdk:"region_host"`
 	Subaccount types.String `tfsdk:"subaccount"`
 }
@@ -120,6 +123,7 @@ func SystemMappingsValueFrom(ctx context.Context, plan SystemMappingsConfig, val
 	}
 
 	model := &SystemMappingsConfig{
+		Instance:       plan.Instance,
 		RegionHost:     plan.RegionHost,
 		Subaccount:     plan.Subaccount,
 		SystemMappings: system_mappings,
@@ -154,6 +158,7 @@ func SystemMappingValueFrom(ctx context.Context, plan SystemMappingConfig, value
 	}
 
 	model := &SystemMappingConfig{
+		Instance:              plan.Instance,
 		RegionHost:            plan.RegionHost,
 		Subaccount:            plan.Subaccount,
 		VirtualHost:           types.StringValue(value.VirtualHost),
@@ -198,6 +203,7 @@ func MapToSystemMappingListModel(ctx context.Context, filter SystemMappingListRe
 	}
 
 	model := &SystemMappingConfig{
+		Instance:              filter.Instance,
 		RegionHost:            filter.RegionHost,
 		Subaccount:            filter.Subaccount,
 		VirtualHost:           types.StringValue(value.VirtualHost),
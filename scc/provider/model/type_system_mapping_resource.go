@@ -20,6 +20,7 @@ type SystemMappingResourceData struct {
 }
 
 type SystemMappingResourceConfig struct {
+	Instance                types.String `tfsdk:"instance"`
 	RegionHost              types.String `tfsdk:"region_host"`
 	Subaccount              types.String `tfsdk:"subaccount"`
 	VirtualHost             types.String `tfsdk:"virtual_host"`
@@ -33,6 +34,7 @@ type SystemMappingResourceConfig struct {
 }
 
 type SystemMappingResourcesConfig struct {
+	Instance               types.String                `tfsdk:"instance"`
 	RegionHost             types.String                `tfsdk:"region_host"`
 	Subaccount             types.String                `tfsdk:"subaccount"`
 	VirtualHost            types.String                `tfsdk:"virtual_host"`
@@ -41,6 +43,7 @@ type SystemMappingResourcesConfig struct {
 }
 
 type SystemMappingResourceListResourceFilterModel struct {
+	Instance    types.String `tfsdk:"instance"`
 	RegionHost  types.String `tfsdk:"region_host"`
 	Subaccount  types.String `tfsdk:"subaccount"`
 	VirtualHost types.String `tfsdk:"virtual_host"`
@@ -49,6 +52,7 @@ type SystemMappingResourceListResourceFilterModel struct {
 
 func SystemMappingResourceValueFrom(ctx context.Context, plan SystemMappingResourceConfig, value apiobjects.SystemMappingResource) (SystemMappingResourceConfig, diag.Diagnostics) {
 	model := &SystemMappingResourceConfig{
+		Instance:                plan.Instance,
 		RegionHost:              plan.RegionHost,
 		Subaccount:              plan.Subaccount,
 		VirtualHost:             plan.VirtualHost,
@@ -79,6 +83,7 @@ func SystemMappingResourcesValueFrom(ctx context.Context, plan SystemMappingReso
 	}
 
 	model := &SystemMappingResourcesConfig{
+		Instance:               plan.Instance,
 		RegionHost:             plan.RegionHost,
 		Subaccount:             plan.Subaccount,
 		VirtualHost:            plan.VirtualHost,
@@ -91,6 +96,7 @@ func SystemMappingResourcesValueFrom(ctx context.Context, plan SystemMappingReso
 
 func MapToSystemMappingResourceListModel(ctx context.Context, filter SystemMappingResourceListResourceFilterModel, value apiobjects.SystemMappingResource) (*SystemMappingResourceConfig, diag.Diagnostics) {
 	sysMapRes := &SystemMappingResourceConfig{
+		Instance:                filter.Instance,
 		RegionHost:              filter.RegionHost,
 		Subaccount:              filter.Subaccount,
 		VirtualHost:             filter.VirtualHost,
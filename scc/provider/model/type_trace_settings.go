@@ -0,0 +1,36 @@
+package model
+
+import (
+	"context"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const TraceSettingsID = "trace-settings"
+
+type TraceSettingsResourceConfig struct {
+	Instance types.String `tfsdk:"instance"`
+	// INPUT
+	CloudConnectorLogLevel types.String `tfsdk:"cloud_connector_log_level"`
+	OtherLogLevel          types.String `tfsdk:"other_log_level"`
+	CPICTraceLevel         types.Int64  `tfsdk:"cpic_trace_level"`
+	PayloadTraceEnabled    types.Bool   `tfsdk:"payload_trace_enabled"`
+	FourEyesPrinciple      types.Bool   `tfsdk:"four_eyes_principle"`
+	// OUTPUT
+	ID types.String `tfsdk:"id"` // Always `trace-settings`.
+}
+
+func TraceSettingsResourceValueFrom(ctx context.Context, value apiobjects.TraceSettings) (TraceSettingsResourceConfig, diag.Diagnostics) {
+	model := &TraceSettingsResourceConfig{
+		ID:                     types.StringValue(TraceSettingsID),
+		CloudConnectorLogLevel: types.StringValue(value.CloudConnectorLogLevel),
+		OtherLogLevel:          types.StringValue(value.OtherLogLevel),
+		CPICTraceLevel:         types.Int64Value(value.CPICTraceLevel),
+		PayloadTraceEnabled:    types.BoolValue(value.PayloadTraceEnabled),
+		FourEyesPrinciple:      types.BoolValue(value.FourEyesPrinciple),
+	}
+
+	return *model, diag.Diagnostics{}
+}
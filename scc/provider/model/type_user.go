@@ -0,0 +1,161 @@
+package model
+
+import (
+	"context"
+	"slices"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Roles of the Cloud Connector, as assigned to local users and as keys of the
+// role_mapping attribute of the LDAP authentication.
+const (
+	RoleAdministrator           = "administrator"
+	RoleSubaccountAdministrator = "subaccount_administrator"
+	RoleDisplay                 = "display"
+	RoleSupport                 = "support"
+	RoleMonitoring              = "monitoring"
+)
+
+var Roles = []string{RoleAdministrator, RoleSubaccountAdministrator, RoleDisplay, RoleSupport, RoleMonitoring}
+
+// roleNames maps the roles to their names in the Cloud Connector API.
+var roleNames = map[string]string{
+	RoleAdministrator:           apiobjects.RoleAdministrator,
+	RoleSubaccountAdministrator: apiobjects.RoleSubaccountAdministrator,
+	RoleDisplay:                 apiobjects.RoleDisplay,
+	RoleSupport:                 apiobjects.RoleSupport,
+	RoleMonitoring:              apiobjects.RoleMonitoring,
+}
+
+type UserConfig struct {
+	Instance        types.String `tfsdk:"instance"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Password        types.String `tfsdk:"password_wo"`
+	PasswordVersion types.Int64  `tfsdk:"password_wo_version"`
+	Roles           types.Set    `tfsdk:"roles"`
+}
+
+type UserRoleAssignmentConfig struct {
+	Instance types.String `tfsdk:"instance"`
+	User     types.String `tfsdk:"user"`
+	Role     types.String `tfsdk:"role"`
+}
+
+type User struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Roles       types.Set    `tfsdk:"roles"`
+}
+
+type UsersConfig struct {
+	Instance types.String `tfsdk:"instance"`
+	Role     types.String `tfsdk:"role"`
+	Users    []User       `tfsdk:"users"`
+}
+
+type UserListFilterModel struct {
+	Instance types.String `tfsdk:"instance"`
+	Role     types.String `tfsdk:"role"`
+}
+
+// RoleName returns the name of role in the Cloud Connector API.
+func RoleName(role string) string {
+	if name, ok := roleNames[role]; ok {
+		return name
+	}
+	return role
+}
+
+// roleFromName returns the role of a name in the Cloud Connector API. Roles
+// unknown to the provider are returned unchanged.
+func roleFromName(name string) string {
+	for role, roleName := range roleNames {
+		if roleName == name {
+			return role
+		}
+	}
+	return name
+}
+
+// UserHasRole reports whether role is assigned to the user.
+func UserHasRole(value apiobjects.User, role string) bool {
+	return slices.Contains(value.Roles, RoleName(role))
+}
+
+// UserRolesRequest converts the planned roles of a user into their names in
+// the Cloud Connector API.
+func UserRolesRequest(ctx context.Context, roles types.Set) ([]string, diag.Diagnostics) {
+	var planned []string
+	diags := roles.ElementsAs(ctx, &planned, false)
+
+	names := make([]string, 0, len(planned))
+	for _, role := range planned {
+		names = append(names, RoleName(role))
+	}
+	slices.Sort(names)
+
+	return names, diags
+}
+
+func userRolesValueFrom(ctx context.Context, value apiobjects.User) (types.Set, diag.Diagnostics) {
+	roles := make([]string, 0, len(value.Roles))
+	for _, name := range value.Roles {
+		roles = append(roles, roleFromName(name))
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, roles)
+}
+
+func UserResourceValueFrom(ctx context.Context, plan UserConfig, value apiobjects.User) (UserConfig, diag.Diagnostics) {
+	roles, diags := userRolesValueFrom(ctx, value)
+
+	model := &UserConfig{
+		Instance:        plan.Instance,
+		Name:            types.StringValue(value.User),
+		Description:     valueOrNullString(value.Description),
+		Password:        types.StringNull(), // Write-only values must not be stored in the state.
+		PasswordVersion: plan.PasswordVersion,
+		Roles:           roles,
+	}
+
+	return *model, diags
+}
+
+func UserValueFrom(ctx context.Context, value apiobjects.User) (User, diag.Diagnostics) {
+	roles, diags := userRolesValueFrom(ctx, value)
+
+	return User{
+		Name:        types.StringValue(value.User),
+		Description: valueOrNullString(value.Description),
+		Roles:       roles,
+	}, diags
+}
+
+// UsersValueFrom lists the users, restricted to the users with the planned role
+// if a role is set.
+func UsersValueFrom(ctx context.Context, plan UsersConfig, value []apiobjects.User) (UsersConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	users := []User{}
+	for _, user := range value {
+		if !plan.Role.IsNull() && !UserHasRole(user, plan.Role.ValueString()) {
+			continue
+		}
+
+		u, d := UserValueFrom(ctx, user)
+		diags.Append(d...)
+		users = append(users, u)
+	}
+
+	model := &UsersConfig{
+		Instance: plan.Instance,
+		Role:     plan.Role,
+		Users:    users,
+	}
+
+	return *model, diags
+}
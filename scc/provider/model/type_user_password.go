@@ -0,0 +1,14 @@
+package model
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+type UserPasswordConfig struct {
+	Instance types.String `tfsdk:"instance"`
+	// INPUT
+	User            types.String `tfsdk:"user"`
+	Password        types.String `tfsdk:"password_wo"`
+	PasswordVersion types.Int64  `tfsdk:"password_wo_version"`
+	CurrentPassword types.String `tfsdk:"current_password_wo"`
+	// OUTPUT
+	ID types.String `tfsdk:"id"` // The name of the user.
+}
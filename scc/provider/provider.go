@@ -2,22 +2,36 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"os"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/actions"
 	"github.com/SAP/terraform-provider-scc/scc/provider/datasources"
+	"github.com/SAP/terraform-provider-scc/scc/provider/ephemeralresources"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/listresources"
 	"github.com/SAP/terraform-provider-scc/scc/provider/resources"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -28,7 +42,8 @@ import (
 )
 
 var (
-	_ provider.ProviderWithListResources = &CloudConnectorProvider{}
+	_ provider.ProviderWithListResources      = &CloudConnectorProvider{}
+	_ provider.ProviderWithEphemeralResources = &CloudConnectorProvider{}
 )
 
 func New() provider.Provider {
@@ -46,6 +61,26 @@ type CloudConnectorProvider struct {
 }
 
 type CloudConnectorProviderData struct {
+	InstanceURL         types.String `tfsdk:"instance_url"`
+	Username            types.String `tfsdk:"username"`
+	Password            types.String `tfsdk:"password"`
+	CaCertificate       types.String `tfsdk:"ca_certificate"`
+	ClientCertificate   types.String `tfsdk:"client_certificate"`
+	ClientKey           types.String `tfsdk:"client_key"`
+	SkipSSLValidation   types.Bool   `tfsdk:"skip_ssl_validation"`
+	Token               types.String `tfsdk:"token"`
+	CredentialHelper    types.List   `tfsdk:"credential_helper"`
+	PKCS12Certificate   types.String `tfsdk:"client_certificate_pkcs12"`
+	PKCS12Password      types.String `tfsdk:"client_certificate_pkcs12_password"`
+	MaxRetries          types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin        types.Int64  `tfsdk:"retry_wait_min"`
+	RetryWaitMax        types.Int64  `tfsdk:"retry_wait_max"`
+	RequestTimeout      types.Int64  `tfsdk:"request_timeout"`
+	SkipConnectionCheck types.Bool   `tfsdk:"skip_connection_check"`
+	Instances           types.Map    `tfsdk:"instances"`
+}
+
+type CloudConnectorInstanceData struct {
 	InstanceURL       types.String `tfsdk:"instance_url"`
 	Username          types.String `tfsdk:"username"`
 	Password          types.String `tfsdk:"password"`
@@ -53,6 +88,10 @@ type CloudConnectorProviderData struct {
 	ClientCertificate types.String `tfsdk:"client_certificate"`
 	ClientKey         types.String `tfsdk:"client_key"`
 	SkipSSLValidation types.Bool   `tfsdk:"skip_ssl_validation"`
+	Token             types.String `tfsdk:"token"`
+	CredentialHelper  types.List   `tfsdk:"credential_helper"`
+	PKCS12Certificate types.String `tfsdk:"client_certificate_pkcs12"`
+	PKCS12Password    types.String `tfsdk:"client_certificate_pkcs12_password"`
 }
 
 func (c *CloudConnectorProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -112,6 +151,131 @@ Use **file(\"path/to/client_key.pem\")** in the provider block to load from a fi
 				MarkdownDescription: "Whether to skip SSL certificate validation when connecting to the Cloud Connector instance. This can also be sourced from the `SCC_SKIP_SSL_VALIDATION` environment variable. This is not recommended for production use. Defaults to false.",
 				Optional:            true,
 			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "A bearer token used to authenticate with the Cloud Connector instance, e.g. an OAuth access token. This can also be sourced from the `SCC_TOKEN` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"credential_helper": schema.ListAttribute{
+				MarkdownDescription: "A command, followed by its arguments, that prints the credential used to authenticate with the Cloud Connector instance as JSON object to its standard output. The object contains either a bearer `token`, optionally with an RFC 3339 `expires_at` timestamp, or a `username` and `password`. The command is run before the first request and again when the token expires. This can also be sourced from the `SCC_CREDENTIAL_HELPER` environment variable, whose value is split at white space.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"client_certificate_pkcs12": schema.StringAttribute{
+				MarkdownDescription: `Base64-encoded PKCS#12 (.p12) bundle with the **client certificate** and private key used for **mutual TLS (mTLS) authentication** with the Cloud Connector, as an alternative to client_certificate and client_key.
+Use **filebase64(\"path/to/client.p12\")** in the provider block to load from a file. This can also be sourced from the **SCC_CLIENT_CERTIFICATE_PKCS12** environment variable.`,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"client_certificate_pkcs12_password": schema.StringAttribute{
+				MarkdownDescription: "The password of the PKCS#12 bundle in `client_certificate_pkcs12`. This can also be sourced from the `SCC_CLIENT_CERTIFICATE_PKCS12_PASSWORD` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of times a request is retried after a transient failure, such as a connection error or an HTTP `429`, `502`, `503` or `504` response. Connection errors, `502` and `504` are only retried for idempotent requests. Set to `0` to disable retries. This can also be sourced from the `SCC_MAX_RETRIES` environment variable. Defaults to `3`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.Int64Attribute{
+				MarkdownDescription: "Minimum time in seconds to wait before retrying a request. The wait time doubles with every retry. This can also be sourced from the `SCC_RETRY_WAIT_MIN` environment variable. Defaults to `1`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				MarkdownDescription: "Maximum time in seconds to wait before retrying a request. This also caps the value of a `Retry-After` header sent by the Cloud Connector. This can also be sourced from the `SCC_RETRY_WAIT_MAX` environment variable. Defaults to `30`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in seconds for a single request attempt to the Cloud Connector, including reading the response. Set to `0` to disable the timeout. This can also be sourced from the `SCC_REQUEST_TIMEOUT` environment variable. Defaults to `0`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"skip_connection_check": schema.BoolAttribute{
+				MarkdownDescription: "Whether to skip testing the connection to the Cloud Connector instance when the provider is configured. The connection is then tested when the first request is sent to the Cloud Connector, so that plans succeed while the Cloud Connector is not reachable yet, for example because it is installed in the same apply. This can also be sourced from the `SCC_SKIP_CONNECTION_CHECK` environment variable. Defaults to false.",
+				Optional:            true,
+			},
+			"instances": schema.MapNestedAttribute{
+				MarkdownDescription: "Additional Cloud Connector instances, keyed by a name that resources, data sources, list resources and actions refer to in their `instance` attribute. Each instance has its own URL and credentials, while the retry settings of the provider apply to all of them. The connection to an instance is only tested when it is used for the first time. If `instances` is set, `instance_url` may be omitted; objects without `instance` then fail with an error.",
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"instance_url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the Cloud Connector instance.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be a valid URL starting with http:// or https://"),
+							},
+						},
+						"username": schema.StringAttribute{
+							MarkdownDescription: "The username used for Basic Authentication with the Cloud Connector instance.",
+							Optional:            true,
+						},
+						"password": schema.StringAttribute{
+							MarkdownDescription: "The password used for Basic Authentication with the Cloud Connector instance.",
+							Optional:            true,
+							Sensitive:           true,
+						},
+						"ca_certificate": schema.StringAttribute{
+							MarkdownDescription: "Contents of a PEM-encoded CA certificate used to verify the **UI Certificate** of the Cloud Connector instance.",
+							Optional:            true,
+							Sensitive:           true,
+						},
+						"client_certificate": schema.StringAttribute{
+							MarkdownDescription: "Contents of a PEM-encoded **client certificate** used for **mutual TLS (mTLS) authentication** with the Cloud Connector instance.",
+							Optional:            true,
+							Sensitive:           true,
+						},
+						"client_key": schema.StringAttribute{
+							MarkdownDescription: "Contents of a PEM-encoded **client private key** used for **mutual TLS (mTLS) authentication** with the Cloud Connector instance.",
+							Optional:            true,
+							Sensitive:           true,
+						},
+						"skip_ssl_validation": schema.BoolAttribute{
+							MarkdownDescription: "Whether to skip SSL certificate validation when connecting to the Cloud Connector instance. This is not recommended for production use. Defaults to false.",
+							Optional:            true,
+						},
+						"token": schema.StringAttribute{
+							MarkdownDescription: "A bearer token used to authenticate with the Cloud Connector instance.",
+							Optional:            true,
+							Sensitive:           true,
+						},
+						"credential_helper": schema.ListAttribute{
+							MarkdownDescription: "A command, followed by its arguments, that prints the credential used to authenticate with the Cloud Connector instance as JSON object. See the `credential_helper` attribute of the provider.",
+							Optional:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"client_certificate_pkcs12": schema.StringAttribute{
+							MarkdownDescription: "Base64-encoded PKCS#12 (.p12) bundle with the **client certificate** and private key used for **mutual TLS (mTLS) authentication** with the Cloud Connector instance.",
+							Optional:            true,
+							Sensitive:           true,
+						},
+						"client_certificate_pkcs12_password": schema.StringAttribute{
+							MarkdownDescription: "The password of the PKCS#12 bundle in `client_certificate_pkcs12`.",
+							Optional:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -130,31 +294,68 @@ func (c *CloudConnectorProvider) Configure(ctx context.Context, req provider.Con
 
 	instanceURL, username, password, caCertificate, clientCertificate, clientKey, skipSSLValidation := resolveAttributes(config)
 
-	// Validate values from config
-	if !ValidateConfig(instanceURL, username, password, caCertificate, clientCertificate, clientKey, skipSSLValidation, resp) {
+	retryConfig, diags := resolveRetryAttributes(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Parse Instance URL
-	parsedURL := ParseInstanceURL(instanceURL, resp)
-	if parsedURL == nil {
+	instances := resolveInstances(ctx, config, resp)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	// Create Client
-	client := CreateClient(c.HttpClient, parsedURL, username, password, caCertificate, clientCertificate, clientKey, skipSSLValidation, resp)
-	if client == nil {
-		return
+
+	var client *api.RestApiClient
+	switch {
+	case hasUnknownConnectionAttributes(config):
+		// The connection settings are only known during apply. Requests sent
+		// during plan fail, while all other operations can proceed.
+		client = &api.RestApiClient{Client: c.HttpClient, Retry: retryConfig}
+		client.DeferConnectionCheck(unknownConnectionCheck)
+	case instanceURL == "" && len(instances) > 0:
+		// Only named instances are configured, every object has to select one of them.
+		client = &api.RestApiClient{Client: c.HttpClient, Retry: retryConfig}
+	default:
+		authenticator, ok := newAuthenticator(path.Empty(), resolveAuthenticatorSettings(ctx, config), resp)
+		if !ok {
+			return
+		}
+
+		// Validate values from config
+		if !validateConfig(path.Empty(), instanceURL, username, password, caCertificate, clientCertificate, clientKey, skipSSLValidation, authenticator, resp) {
+			return
+		}
+
+		// Parse Instance URL
+		parsedURL := ParseInstanceURL(instanceURL, resp)
+		if parsedURL == nil {
+			return
+		}
+		// Create Client
+		client = createClient(c.HttpClient, parsedURL, username, password, caCertificate, clientCertificate, clientKey, skipSSLValidation, authenticator, resp)
+		if client == nil {
+			return
+		}
+		client.Retry = retryConfig
+
+		// Test Provider Connection
+		if getBoolAttribute(config.SkipConnectionCheck, "SCC_SKIP_CONNECTION_CHECK") {
+			client.DeferConnectionCheck(TestProviderConnection)
+		} else if diags := TestProviderConnection(ctx, client); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
 	}
-	// Test Provider Connection
-	if diags := TestProviderConnection(client); diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-		return
+
+	if len(instances) > 0 {
+		client.Instances = api.NewInstances(c.HttpClient, retryConfig, instances, TestProviderConnection)
 	}
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
 	resp.ListResourceData = client
 	resp.ActionData = client
+	resp.EphemeralResourceData = client
 }
 
 func resolveAttributes(config CloudConnectorProviderData) (string, string, string, string, string, string, bool) {
@@ -167,6 +368,222 @@ func resolveAttributes(config CloudConnectorProviderData) (string, string, strin
 		getBoolAttribute(config.SkipSSLValidation, "SCC_SKIP_SSL_VALIDATION")
 }
 
+// hasUnknownConnectionAttributes reports whether any of the attributes used to
+// connect to the default instance is unknown, e.g. because it refers to a
+// resource that is created in the same apply.
+func hasUnknownConnectionAttributes(config CloudConnectorProviderData) bool {
+	return config.InstanceURL.IsUnknown() ||
+		config.Username.IsUnknown() ||
+		config.Password.IsUnknown() ||
+		config.CaCertificate.IsUnknown() ||
+		config.ClientCertificate.IsUnknown() ||
+		config.ClientKey.IsUnknown() ||
+		config.SkipSSLValidation.IsUnknown() ||
+		config.Token.IsUnknown() ||
+		config.CredentialHelper.IsUnknown() ||
+		config.PKCS12Certificate.IsUnknown() ||
+		config.PKCS12Password.IsUnknown()
+}
+
+func unknownConnectionCheck(_ context.Context, _ *api.RestApiClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.AddError("Unknown Provider Configuration", "The Cloud Connector instance cannot be reached, because its connection settings in the provider configuration are not known until apply.")
+	return diags
+}
+
+// resolveInstances validates the named instances of the provider configuration
+// and returns their connection settings.
+func resolveInstances(ctx context.Context, config CloudConnectorProviderData, resp *provider.ConfigureResponse) map[string]api.InstanceConfig {
+	if config.Instances.IsNull() || config.Instances.IsUnknown() {
+		return nil
+	}
+
+	var instancesData map[string]CloudConnectorInstanceData
+	resp.Diagnostics.Append(config.Instances.ElementsAs(ctx, &instancesData, false)...)
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+
+	instances := make(map[string]api.InstanceConfig, len(instancesData))
+	for name, data := range instancesData {
+		attrPath := path.Root("instances").AtMapKey(name)
+		instanceURL := data.InstanceURL.ValueString()
+		caCertificate := data.CaCertificate.ValueString()
+		clientCertificate := data.ClientCertificate.ValueString()
+		clientKey := data.ClientKey.ValueString()
+		skipSSLValidation := data.SkipSSLValidation.ValueBool()
+
+		var credentialHelper []string
+		resp.Diagnostics.Append(data.CredentialHelper.ElementsAs(ctx, &credentialHelper, false)...)
+
+		authenticator, ok := newAuthenticator(attrPath, authenticatorSettings{
+			token:            data.Token.ValueString(),
+			credentialHelper: credentialHelper,
+			pkcs12:           data.PKCS12Certificate.ValueString(),
+			pkcs12Password:   data.PKCS12Password.ValueString(),
+		}, resp)
+		if !ok {
+			continue
+		}
+
+		if !validateConfig(attrPath, instanceURL, data.Username.ValueString(), data.Password.ValueString(), caCertificate, clientCertificate, clientKey, skipSSLValidation, authenticator, resp) {
+			continue
+		}
+
+		parsedURL, err := url.Parse(instanceURL)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				attrPath.AtName("instance_url"),
+				"Invalid Cloud Connector Instance URL",
+				fmt.Sprintf("Failed to parse the provided Cloud Connector Instance URL: %s. Error: %v", instanceURL, err),
+			)
+			continue
+		}
+
+		instances[name] = api.InstanceConfig{
+			BaseURL:           parsedURL,
+			Username:          data.Username.ValueString(),
+			Password:          data.Password.ValueString(),
+			CACertificate:     []byte(caCertificate),
+			ClientCertificate: []byte(clientCertificate),
+			ClientKey:         []byte(clientKey),
+			SkipSSLValidation: skipSSLValidation,
+			Authenticator:     authenticator,
+		}
+	}
+
+	return instances
+}
+
+// authenticatorSettings holds the settings of the authentication methods that
+// are plugged into the client as api.Authenticator.
+type authenticatorSettings struct {
+	token            string
+	credentialHelper []string
+	pkcs12           string
+	pkcs12Password   string
+}
+
+func resolveAuthenticatorSettings(ctx context.Context, config CloudConnectorProviderData) authenticatorSettings {
+	credentialHelper := strings.Fields(os.Getenv("SCC_CREDENTIAL_HELPER"))
+	if !config.CredentialHelper.IsNull() {
+		credentialHelper = nil
+		_ = config.CredentialHelper.ElementsAs(ctx, &credentialHelper, false)
+	}
+
+	return authenticatorSettings{
+		token:            getNonEmptyAttribute(config.Token, "SCC_TOKEN"),
+		credentialHelper: credentialHelper,
+		pkcs12:           getNonEmptyAttribute(config.PKCS12Certificate, "SCC_CLIENT_CERTIFICATE_PKCS12"),
+		pkcs12Password:   getNonEmptyAttribute(config.PKCS12Password, "SCC_CLIENT_CERTIFICATE_PKCS12_PASSWORD"),
+	}
+}
+
+// newAuthenticator returns the authenticator configured in settings, or nil if
+// none is configured. The attributes of settings are nested below attrPath.
+func newAuthenticator(attrPath path.Path, settings authenticatorSettings, resp *provider.ConfigureResponse) (api.Authenticator, bool) {
+	var configured []string
+	if settings.token != "" {
+		configured = append(configured, "token")
+	}
+	if len(settings.credentialHelper) > 0 {
+		configured = append(configured, "credential_helper")
+	}
+	if settings.pkcs12 != "" {
+		configured = append(configured, "client_certificate_pkcs12")
+	}
+
+	if len(configured) > 1 {
+		resp.Diagnostics.AddAttributeError(
+			attrPath.AtName(configured[1]),
+			"Conflicting Authentication Details",
+			fmt.Sprintf("Only one of %s can be used for authentication.", strings.Join(configured, ", ")),
+		)
+		return nil, false
+	}
+
+	switch {
+	case settings.token != "":
+		return &api.BearerTokenAuthenticator{Token: settings.token}, true
+	case len(settings.credentialHelper) > 0:
+		return &api.CredentialHelperAuthenticator{Command: settings.credentialHelper}, true
+	case settings.pkcs12 != "":
+		bundle := []byte(settings.pkcs12)
+		if decoded, err := base64.StdEncoding.DecodeString(settings.pkcs12); err == nil {
+			bundle = decoded
+		}
+
+		authenticator, err := api.NewPKCS12Authenticator(bundle, settings.pkcs12Password)
+		if err != nil {
+			var clientErr *api.Error
+			if errors.As(err, &clientErr) {
+				resp.Diagnostics.AddAttributeError(attrPath.AtName("client_certificate_pkcs12"), clientErr.Summary, clientErr.Detail)
+			}
+			return nil, false
+		}
+		return authenticator, true
+	}
+
+	return nil, true
+}
+
+func resolveRetryAttributes(config CloudConnectorProviderData) (api.RetryConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	retryConfig := api.DefaultRetryConfig()
+
+	maxRetries, d := getInt64Attribute(config.MaxRetries, "SCC_MAX_RETRIES", "max_retries", api.DefaultMaxRetries)
+	diags.Append(d...)
+	retryWaitMin, d := getInt64Attribute(config.RetryWaitMin, "SCC_RETRY_WAIT_MIN", "retry_wait_min", int64(api.DefaultRetryWaitMin/time.Second))
+	diags.Append(d...)
+	retryWaitMax, d := getInt64Attribute(config.RetryWaitMax, "SCC_RETRY_WAIT_MAX", "retry_wait_max", int64(api.DefaultRetryWaitMax/time.Second))
+	diags.Append(d...)
+	requestTimeout, d := getInt64Attribute(config.RequestTimeout, "SCC_REQUEST_TIMEOUT", "request_timeout", int64(api.DefaultRequestTimeout/time.Second))
+	diags.Append(d...)
+	if diags.HasError() {
+		return retryConfig, diags
+	}
+
+	if retryWaitMin > retryWaitMax {
+		diags.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Invalid Retry Configuration",
+			fmt.Sprintf("retry_wait_min (%d) must not be greater than retry_wait_max (%d).", retryWaitMin, retryWaitMax),
+		)
+		return retryConfig, diags
+	}
+
+	retryConfig.MaxRetries = int(maxRetries)
+	retryConfig.RetryWaitMin = time.Duration(retryWaitMin) * time.Second
+	retryConfig.RetryWaitMax = time.Duration(retryWaitMax) * time.Second
+	retryConfig.RequestTimeout = time.Duration(requestTimeout) * time.Second
+
+	return retryConfig, diags
+}
+
+func getInt64Attribute(attr types.Int64, envVar, attribute string, defaultValue int64) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !attr.IsNull() && !attr.IsUnknown() {
+		return attr.ValueInt64(), diags
+	}
+
+	envValue := os.Getenv(envVar)
+	if envValue == "" {
+		return defaultValue, diags
+	}
+
+	value, err := strconv.ParseInt(envValue, 10, 64)
+	if err != nil || value < 0 {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Environment Variable Value",
+			fmt.Sprintf("The value %q of environment variable %s must be a non-negative integer.", envValue, envVar),
+		)
+		return defaultValue, diags
+	}
+
+	return value, diags
+}
+
 func getBoolAttribute(attr types.Bool, envVar string) bool {
 	if !attr.IsNull() {
 		return attr.ValueBool()
@@ -182,47 +599,70 @@ func getNonEmptyAttribute(attr types.String, envVar string) string {
 }
 
 func ValidateConfig(instanceURL, username, password, caCertificate, clientCertificate, clientKey string, skipSSLValidation bool, resp *provider.ConfigureResponse) bool {
+	return validateConfig(path.Empty(), instanceURL, username, password, caCertificate, clientCertificate, clientKey, skipSSLValidation, nil, resp)
+}
+
+// validateConfig validates the connection settings of an instance, whose
+// attributes are nested below attrPath. authenticator is the instance's
+// authenticator returned by newAuthenticator.
+func validateConfig(attrPath path.Path, instanceURL, username, password, caCertificate, clientCertificate, clientKey string, skipSSLValidation bool, authenticator api.Authenticator, resp *provider.ConfigureResponse) bool {
 	if instanceURL == "" {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("instance_url"),
+			attrPath.AtName("instance_url"),
 			"Missing Cloud Connector Instance URL",
 			"The provider cannot create the Cloud Connector client because the Cloud Connector Instance URL is empty.",
 		)
 		return false
 	}
 
-	if caCertificate != "" && !ValidatePEMBlock(caCertificate, "ca_certificate", "CA Certificate", resp) {
+	if caCertificate != "" && !validatePEMBlock(caCertificate, attrPath.AtName("ca_certificate"), "CA Certificate", resp) {
 		return false
 	}
-	if clientCertificate != "" && !ValidatePEMBlock(clientCertificate, "client_certificate", "Client Certificate", resp) {
+	if clientCertificate != "" && !validatePEMBlock(clientCertificate, attrPath.AtName("client_certificate"), "Client Certificate", resp) {
 		return false
 	}
-	if clientKey != "" && !ValidatePEMBlock(clientKey, "client_key", "Client Key", resp) {
+	if clientKey != "" && !validatePEMBlock(clientKey, attrPath.AtName("client_key"), "Client Key", resp) {
 		return false
 	}
 
+	// Diagnostics of named instances point to the instance they belong to.
+	addDiagnostic := func(d diag.Diagnostic) {
+		if len(attrPath.Steps()) > 0 {
+			d = diag.WithPath(attrPath, d)
+		}
+		resp.Diagnostics.Append(d)
+	}
+
 	if skipSSLValidation && caCertificate != "" {
-		resp.Diagnostics.AddWarning(
+		addDiagnostic(diag.NewWarningDiagnostic(
 			"CA Certificate Ignored",
 			"The ca_certificate attribute is ignored when skip_ssl_validation is set to true.",
-		)
+		))
 	}
 
 	basicAuth := username != "" && password != ""
 	certAuth := clientCertificate != "" && clientKey != ""
 
 	switch {
+	case authenticator != nil && (basicAuth || certAuth):
+		addDiagnostic(diag.NewErrorDiagnostic(
+			"Conflicting Authentication Details",
+			"A token, credential helper or PKCS#12 client certificate cannot be combined with Basic Authentication or Certificate-based Authentication. Only one can be used.",
+		))
+		return false
+	case authenticator != nil:
+		return true
 	case !basicAuth && !certAuth:
-		resp.Diagnostics.AddError(
+		addDiagnostic(diag.NewErrorDiagnostic(
 			"Missing Authentication Details",
 			"Either a username/password or a client certificate/key must be provided for authentication.",
-		)
+		))
 		return false
 	case basicAuth && certAuth:
-		resp.Diagnostics.AddError(
+		addDiagnostic(diag.NewErrorDiagnostic(
 			"Conflicting Authentication Details",
 			"Both Basic Authentication and Certificate-based Authentication were provided. Only one can be used.",
-		)
+		))
 		return false
 	}
 
@@ -241,6 +681,15 @@ func ParseInstanceURL(instanceURL string, resp *provider.ConfigureResponse) *url
 	return parsedURL
 }
 func CreateClient(httpClient *http.Client, parsedURL *url.URL, username, password, caCertificate, clientCertificate, clientKey string, skipSSLValidation bool, resp *provider.ConfigureResponse) *api.RestApiClient {
+	return createClient(httpClient, parsedURL, username, password, caCertificate, clientCertificate, clientKey, skipSSLValidation, nil, resp)
+}
+
+func createClient(httpClient *http.Client, parsedURL *url.URL, username, password, caCertificate, clientCertificate, clientKey string, skipSSLValidation bool, authenticator api.Authenticator, resp *provider.ConfigureResponse) *api.RestApiClient {
+	var authenticators []api.Authenticator
+	if authenticator != nil {
+		authenticators = append(authenticators, authenticator)
+	}
+
 	client, diags := api.NewRestApiClient(
 		httpClient,
 		parsedURL,
@@ -250,6 +699,7 @@ func CreateClient(httpClient *http.Client, parsedURL *url.URL, username, passwor
 		[]byte(clientCertificate),
 		[]byte(clientKey),
 		skipSSLValidation,
+		authenticators...,
 	)
 	if diags.HasError() {
 		resp.Diagnostics.AddError(
@@ -260,11 +710,18 @@ func CreateClient(httpClient *http.Client, parsedURL *url.URL, username, passwor
 	}
 	return client
 }
-func TestProviderConnection(client *api.RestApiClient) diag.Diagnostics {
-	resp, diags := client.GetRequest("/api/v1/connector/version")
+func TestProviderConnection(ctx context.Context, client *api.RestApiClient) diag.Diagnostics {
+	resp, diags := client.GetRequest(ctx, endpoints.GetConnectorVersionEndpoint())
 	if diags.HasError() {
 		return diags
 	}
+
+	// Remember the version, so that version-gated features do not request it again.
+	var version apiobjects.ConnectorVersion
+	if body, err := io.ReadAll(resp.Body); err == nil && json.Unmarshal(body, &version) == nil {
+		client.RememberVersion(version.Version)
+	}
+
 	if cerr := resp.Body.Close(); cerr != nil {
 		diags.AddError("Response Body Close Error", fmt.Sprintf("Failed to close response body: %v", cerr))
 		return diags
@@ -279,11 +736,15 @@ func TestProviderConnection(client *api.RestApiClient) diag.Diagnostics {
 }
 
 func ValidatePEMBlock(pemString, attribute, title string, resp *provider.ConfigureResponse) bool {
+	return validatePEMBlock(pemString, path.Root(attribute), title, resp)
+}
+
+func validatePEMBlock(pemString string, attrPath path.Path, title string, resp *provider.ConfigureResponse) bool {
 	diags := helpers.ValidatePEMDataFunc(pemString)
 	if diags.HasError() {
 		for _, d := range diags {
 			resp.Diagnostics.AddAttributeError(
-				path.Root(attribute),
+				attrPath,
 				fmt.Sprintf("Invalid %s", title),
 				d.Detail(),
 			)
@@ -310,3 +771,8 @@ func (p *CloudConnectorProvider) ListResources(_ context.Context) []func() list.
 func (p *CloudConnectorProvider) Actions(_ context.Context) []func() action.Action {
 	return actions.All()
 }
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *CloudConnectorProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return ephemeralresources.All()
+}
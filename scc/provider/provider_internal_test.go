@@ -5,7 +5,9 @@ package provider
 
 import (
 	"testing"
+	"time"
 
+	"github.com/SAP/terraform-provider-scc/internal/api"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -118,3 +120,93 @@ func TestResolveAttributes_FromEnv(t *testing.T) {
 	assert.Equal(t, "env-key", key)
 	assert.True(t, skip)
 }
+
+// ---------------------------------------------------------------------------
+// resolveRetryAttributes
+// ---------------------------------------------------------------------------
+
+func TestResolveRetryAttributes_Defaults(t *testing.T) {
+	t.Setenv("SCC_MAX_RETRIES", "")
+	t.Setenv("SCC_RETRY_WAIT_MIN", "")
+	t.Setenv("SCC_RETRY_WAIT_MAX", "")
+	t.Setenv("SCC_REQUEST_TIMEOUT", "")
+
+	cfg := CloudConnectorProviderData{
+		MaxRetries:     types.Int64Null(),
+		RetryWaitMin:   types.Int64Null(),
+		RetryWaitMax:   types.Int64Null(),
+		RequestTimeout: types.Int64Null(),
+	}
+
+	retryConfig, diags := resolveRetryAttributes(cfg)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, api.DefaultRetryConfig(), retryConfig)
+}
+
+func TestResolveRetryAttributes_FromHCL(t *testing.T) {
+	cfg := CloudConnectorProviderData{
+		MaxRetries:     types.Int64Value(5),
+		RetryWaitMin:   types.Int64Value(2),
+		RetryWaitMax:   types.Int64Value(60),
+		RequestTimeout: types.Int64Value(120),
+	}
+
+	retryConfig, diags := resolveRetryAttributes(cfg)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 5, retryConfig.MaxRetries)
+	assert.Equal(t, 2*time.Second, retryConfig.RetryWaitMin)
+	assert.Equal(t, 60*time.Second, retryConfig.RetryWaitMax)
+	assert.Equal(t, 120*time.Second, retryConfig.RequestTimeout)
+}
+
+func TestResolveRetryAttributes_FromEnv(t *testing.T) {
+	t.Setenv("SCC_MAX_RETRIES", "0")
+	t.Setenv("SCC_RETRY_WAIT_MIN", "3")
+	t.Setenv("SCC_RETRY_WAIT_MAX", "9")
+	t.Setenv("SCC_REQUEST_TIMEOUT", "30")
+
+	cfg := CloudConnectorProviderData{
+		MaxRetries:     types.Int64Null(),
+		RetryWaitMin:   types.Int64Null(),
+		RetryWaitMax:   types.Int64Null(),
+		RequestTimeout: types.Int64Null(),
+	}
+
+	retryConfig, diags := resolveRetryAttributes(cfg)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 0, retryConfig.MaxRetries)
+	assert.Equal(t, 3*time.Second, retryConfig.RetryWaitMin)
+	assert.Equal(t, 9*time.Second, retryConfig.RetryWaitMax)
+	assert.Equal(t, 30*time.Second, retryConfig.RequestTimeout)
+}
+
+func TestResolveRetryAttributes_InvalidEnv(t *testing.T) {
+	t.Setenv("SCC_MAX_RETRIES", "many")
+
+	cfg := CloudConnectorProviderData{
+		MaxRetries:     types.Int64Null(),
+		RetryWaitMin:   types.Int64Null(),
+		RetryWaitMax:   types.Int64Null(),
+		RequestTimeout: types.Int64Null(),
+	}
+
+	_, diags := resolveRetryAttributes(cfg)
+
+	assert.True(t, diags.HasError())
+}
+
+func TestResolveRetryAttributes_WaitMinGreaterThanMax(t *testing.T) {
+	cfg := CloudConnectorProviderData{
+		MaxRetries:     types.Int64Null(),
+		RetryWaitMin:   types.Int64Value(10),
+		RetryWaitMax:   types.Int64Value(5),
+		RequestTimeout: types.Int64Null(),
+	}
+
+	_, diags := resolveRetryAttributes(cfg)
+
+	assert.True(t, diags.HasError())
+}
@@ -52,6 +52,90 @@ var all_resources = []testResource{
 			return r.(*resources.SubaccountK8SServiceChannelResource).Client
 		},
 	},
+	{
+		name:     "HAMasterSettingsResource",
+		resource: &resources.HAMasterSettingsResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*resources.HAMasterSettingsResource).Client
+		},
+	},
+	{
+		name:     "HAShadowConnectionResource",
+		resource: &resources.HAShadowConnectionResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*resources.HAShadowConnectionResource).Client
+		},
+	},
+	{
+		name:     "AuditLogSettingsResource",
+		resource: &resources.AuditLogSettingsResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*resources.AuditLogSettingsResource).Client
+		},
+	},
+	{
+		name:     "TraceSettingsResource",
+		resource: &resources.TraceSettingsResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*resources.TraceSettingsResource).Client
+		},
+	},
+	{
+		name:     "UserPasswordResource",
+		resource: &resources.UserPasswordResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*resources.UserPasswordResource).Client
+		},
+	},
+	{
+		name:     "LDAPAuthenticationResource",
+		resource: &resources.LDAPAuthenticationResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*resources.LDAPAuthenticationResource).Client
+		},
+	},
+	{
+		name:     "UserResource",
+		resource: &resources.UserResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*resources.UserResource).Client
+		},
+	},
+	{
+		name:     "UserRoleAssignmentResource",
+		resource: &resources.UserRoleAssignmentResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*resources.UserRoleAssignmentResource).Client
+		},
+	},
+	{
+		name:     "SNCSettingsResource",
+		resource: &resources.SNCSettingsResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*resources.SNCSettingsResource).Client
+		},
+	},
+	{
+		name:     "KerberosSettingsResource",
+		resource: &resources.KerberosSettingsResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*resources.KerberosSettingsResource).Client
+		},
+	},
+	{
+		name:     "SubaccountTrustConfigurationResource",
+		resource: &resources.SubaccountTrustConfigurationResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*resources.SubaccountTrustConfigurationResource).Client
+		},
+	},
+	{
+		name:     "SubjectPatternRulesResource",
+		resource: &resources.SubjectPatternRulesResource{},
+		getClient: func(r resource.Resource) *api.RestApiClient {
+			return r.(*resources.SubjectPatternRulesResource).Client
+		},
+	},
 }
 
 func TestAllResourceConfigure(t *testing.T) {
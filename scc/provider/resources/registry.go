@@ -23,5 +23,17 @@ func All() []func() resource.Resource {
 		NewProxySettingsResource,
 		NewBackendTrustStoreResource,
 		NewSubjectPatternRuleResource,
+		NewHAMasterSettingsResource,
+		NewHAShadowConnectionResource,
+		NewAuditLogSettingsResource,
+		NewTraceSettingsResource,
+		NewUserPasswordResource,
+		NewLDAPAuthenticationResource,
+		NewUserResource,
+		NewUserRoleAssignmentResource,
+		NewSNCSettingsResource,
+		NewKerberosSettingsResource,
+		NewSubaccountTrustConfigurationResource,
+		NewSubjectPatternRulesResource,
 	}
 }
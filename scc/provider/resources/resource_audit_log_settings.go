@@ -0,0 +1,252 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &AuditLogSettingsResource{}
+
+func NewAuditLogSettingsResource() resource.Resource {
+	return &AuditLogSettingsResource{}
+}
+
+type AuditLogSettingsResource struct {
+	Client *api.RestApiClient
+}
+
+const defaultAuditLogLevel = "security"
+
+var auditLogLevels = []string{"security", "all", "off"}
+
+type auditLogSettingsResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *AuditLogSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_log_settings"
+}
+
+func (r *AuditLogSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Audit Log Settings Resource.
+
+Manages the audit log level of the Cloud Connector. The audit log records security-relevant events such as configuration changes and logon attempts.
+
+On destroy, the audit log level is reset to its default *security*.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/manage-audit-logs>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
+			"level": schema.StringAttribute{
+				MarkdownDescription: "The audit log level. Possible values are: \n" +
+					helpers.GetFormattedValueAsTableRow("level", "description") +
+					helpers.GetFormattedValueAsTableRow("---", "---") +
+					helpers.GetFormattedValueAsTableRow("`security`", "Only security-relevant events are logged. This is the default.") +
+					helpers.GetFormattedValueAsTableRow("`all`", "Security-relevant events and all configuration changes are logged.") +
+					helpers.GetFormattedValueAsTableRow("`off`", "No audit entries are written."),
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(auditLogLevels...),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the audit log settings resource. Used for import and identity purposes. The value is always `audit-log-settings`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (rs *AuditLogSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *AuditLogSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = client
+}
+
+func (r *AuditLogSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.createOrUpdate(ctx, req.Plan, &resp.Diagnostics, &resp.State, &resp.Identity)
+}
+
+func (r *AuditLogSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state model.AuditLogSettingsResourceConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	respObj, err := r.Client.AuditLog().Level(ctx)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	responseModel, diags := model.AuditLogSettingsResourceValueFrom(ctx, respObj)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseModel.Instance = state.Instance
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identity := auditLogSettingsResourceIdentityModel{
+		ID: types.StringValue(model.AuditLogSettingsID),
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *AuditLogSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.createOrUpdate(ctx, req.Plan, &resp.Diagnostics, &resp.State, &resp.Identity)
+}
+
+func (r *AuditLogSettingsResource) createOrUpdate(ctx context.Context, requestPlan tfsdk.Plan, responseDiagnostics *diag.Diagnostics, responseState *tfsdk.State, responseIdentity **tfsdk.ResourceIdentity) {
+	var plan model.AuditLogSettingsResourceConfig
+	diags := requestPlan.Get(ctx, &plan)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
+	planBody := apiobjects.AuditLogLevel{
+		Level: strings.ToUpper(plan.Level.ValueString()),
+	}
+
+	if err := r.Client.AuditLog().UpdateLevel(ctx, planBody); err != nil {
+		responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	respObj, err := r.Client.AuditLog().Level(ctx)
+	if err != nil {
+		responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	responseModel, diags := model.AuditLogSettingsResourceValueFrom(ctx, respObj)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	responseModel.Instance = plan.Instance
+
+	diags = responseState.Set(ctx, responseModel)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	identity := auditLogSettingsResourceIdentityModel{
+		ID: types.StringValue(model.AuditLogSettingsID),
+	}
+
+	diags = (*responseIdentity).Set(ctx, identity)
+	responseDiagnostics.Append(diags...)
+}
+
+func (r *AuditLogSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state model.AuditLogSettingsResourceConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	// Fall back to the audit log level the Cloud Connector is installed with.
+	planBody := apiobjects.AuditLogLevel{
+		Level: strings.ToUpper(defaultAuditLogLevel),
+	}
+
+	if err := r.Client.AuditLog().UpdateLevel(ctx, planBody); err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (rs *AuditLogSettingsResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	req.ID = helpers.ImportInstanceID(ctx, rs.Client, req.ID, resp)
+
+	if req.ID != model.AuditLogSettingsID {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf(
+				"Expected import identifier %q. Got: %q",
+				model.AuditLogSettingsID,
+				req.ID,
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("id"), model.AuditLogSettingsID)...,
+	)
+}
@@ -0,0 +1,138 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResourceAuditLogSettings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			CheckDestroy: func(_ *terraform.State) error {
+				var err error
+				srv.Update(func(state *sccmock.State) {
+					if state.AuditLogLevel != "SECURITY" {
+						err = fmt.Errorf("expected the default audit log level SECURITY, got %s", state.AuditLogLevel)
+					}
+				})
+				return err
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceAuditLogSettings("scc_als", "all"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_audit_log_settings.scc_als", "level", "all"),
+						resource.TestCheckResourceAttr("scc_audit_log_settings.scc_als", "id", "audit-log-settings"),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity(
+							"scc_audit_log_settings.scc_als",
+							map[string]knownvalue.Check{
+								"id": knownvalue.StringExact("audit-log-settings"),
+							},
+						),
+					},
+				},
+				{
+					ResourceName:                         "scc_audit_log_settings.scc_als",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "audit-log-settings",
+					ImportStateVerifyIdentifierAttribute: "id",
+				},
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceAuditLogSettings("scc_als", "off"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_audit_log_settings.scc_als", "level", "off"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - drift is reverted", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceAuditLogSettings("scc_als", "all"),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.AuditLogLevel = "OFF"
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceAuditLogSettings("scc_als", "all"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_audit_log_settings.scc_als", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_audit_log_settings.scc_als", "level", "all"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid level", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceAuditLogSettings("scc_als", "verbose"),
+					ExpectError: regexp.MustCompile(`Attribute level value must be one of`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid import id", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceAuditLogSettings("scc_als", "all"),
+				},
+				{
+					ResourceName:  "scc_audit_log_settings.scc_als",
+					ImportState:   true,
+					ImportStateId: "invalid",
+					ExpectError:   regexp.MustCompile(`Expected import identifier`),
+				},
+			},
+		})
+	})
+}
+
+func ResourceAuditLogSettings(resourceName string, level string) string {
+	return fmt.Sprintf(`
+	resource "scc_audit_log_settings" "%s" {
+		level = "%s"
+	}
+	`, resourceName, level)
+}
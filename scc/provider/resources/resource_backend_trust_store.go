@@ -9,7 +9,6 @@ import (
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -75,6 +74,7 @@ __Tips:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/truststore-ca-certificates#add-a-back-end-certificate-to-truststore-(master-only)>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"certificate": schema.StringAttribute{
 				MarkdownDescription: `PEM-encoded CA certificate to add to the Back-End Trust Store.
 The certificate may be a root CA certificate, an intermediate CA certificate, or another trusted CA certificate required to validate TLS connections to back-end systems.
@@ -177,12 +177,16 @@ func (r *BackendTrustStoreResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	model, diags := UploadBackendCertificateFunc(r, ctx, plan.Certificate.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	model.Instance = plan.Instance
+
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -205,27 +209,23 @@ func (r *BackendTrustStoreResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	var state model.BackendTrustStoreResourceConfig
-	var trustStore apiobjects.BackendTrustStoreConfiguration
-
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
 	alias := state.Alias.ValueString()
-	endpoint := endpoints.GetBackendTrustStoreBaseEndpoint()
-
-	diags = helpers.RequestAndUnmarshal(
-		r.Client,
-		&trustStore,
-		"GET",
-		endpoint,
-		nil,
-		true,
-	)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+
+	trustStore, err := r.Client.BackendTrustStore().Get(ctx)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -234,11 +234,12 @@ func (r *BackendTrustStoreResource) Read(ctx context.Context, req resource.ReadR
 			continue
 		}
 
-		resourceModel, d := r.buildBackendTrustStoreModel(trustedBackend)
+		resourceModel, d := r.buildBackendTrustStoreModel(ctx, trustedBackend)
 		resp.Diagnostics.Append(d...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		resourceModel.Instance = state.Instance
 
 		diags = resp.State.Set(ctx, &resourceModel)
 		resp.Diagnostics.Append(diags...)
@@ -260,10 +261,8 @@ func (r *BackendTrustStoreResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	resp.Diagnostics.AddError(
-		"Backend trust store certificate not found",
-		fmt.Sprintf("No backend trust store certificate with alias %q exists.", alias),
-	)
+	// The certificate was removed from the trust store outside of Terraform.
+	resp.State.RemoveResource(ctx)
 }
 
 func (r *BackendTrustStoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -280,25 +279,17 @@ func (r *BackendTrustStoreResource) Delete(ctx context.Context, req resource.Del
 	}
 
 	var state model.BackendTrustStoreResourceConfig
-	var respObj apiobjects.BackendTrustStoreConfiguration
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetBackendTrustStoreCertificateEndpoint() + "/" + state.Alias.ValueString()
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 
-	diags = helpers.RequestAndUnmarshal(
-		r.Client,
-		&respObj,
-		"DELETE",
-		endpoint,
-		nil,
-		false,
-	)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	err := r.Client.BackendTrustStore().DeleteCertificate(ctx, state.Alias.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -327,21 +318,15 @@ var UploadBackendCertificateFunc = func(r *BackendTrustStoreResource, ctx contex
 		return nil, diags
 	}
 
-	uploadEndpoint := endpoints.GetBackendTrustStoreCertificateEndpoint()
-
-	d := helpers.UploadBackendTrustStoreCertificateFunc(r.Client, uploadEndpoint, certificate)
-	diags.Append(d...)
-	if diags.HasError() {
+	if err := r.Client.BackendTrustStore().UploadCertificate(ctx, certificate); err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
 	// Read trusted backends to get the alias and other metadata of the uploaded certificate
-	var trustStore apiobjects.BackendTrustStoreConfiguration
-
-	readEndpoint := endpoints.GetBackendTrustStoreBaseEndpoint()
-	d = helpers.RequestAndUnmarshal(r.Client, &trustStore, "GET", readEndpoint, nil, true)
-	diags.Append(d...)
-	if diags.HasError() {
+	trustStore, err := r.Client.BackendTrustStore().Get(ctx)
+	if err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
@@ -350,7 +335,7 @@ var UploadBackendCertificateFunc = func(r *BackendTrustStoreResource, ctx contex
 			continue
 		}
 
-		resourceModel, d := r.buildBackendTrustStoreModel(trustedBackend)
+		resourceModel, d := r.buildBackendTrustStoreModel(ctx, trustedBackend)
 		diags.Append(d...)
 		if diags.HasError() {
 			return nil, diags
@@ -368,6 +353,8 @@ var UploadBackendCertificateFunc = func(r *BackendTrustStoreResource, ctx contex
 }
 
 func (rs *BackendTrustStoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = helpers.ImportInstanceID(ctx, rs.Client, req.ID, resp)
+
 	if req.ID != "" {
 		idParts := strings.Split(req.ID, ",")
 
@@ -394,13 +381,11 @@ func (rs *BackendTrustStoreResource) ImportState(ctx context.Context, req resour
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("alias"), identity.Alias)...)
 }
 
-func (r *BackendTrustStoreResource) buildBackendTrustStoreModel(trustedBackend apiobjects.TrustedBackends) (model.BackendTrustStoreResourceConfig, diag.Diagnostics) {
+func (r *BackendTrustStoreResource) buildBackendTrustStoreModel(ctx context.Context, trustedBackend apiobjects.TrustedBackends) (model.BackendTrustStoreResourceConfig, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	certEndpoint := endpoints.GetBackendTrustStoreCertificateEndpoint() + "/" + trustedBackend.Alias
-
-	certBytes, d := helpers.GetCertificateBinaryFunc(r.Client, certEndpoint)
-	diags.Append(d...)
-	if diags.HasError() {
+	certBytes, err := r.Client.BackendTrustStore().DownloadCertificate(ctx, trustedBackend.Alias)
+	if err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return model.BackendTrustStoreResourceConfig{}, diags
 	}
 
@@ -115,30 +115,26 @@ func TestBackendTrustStore_Upload_InvalidPEM(t *testing.T) {
 }
 
 func TestBackendTrustStore_Upload_UploadFails(t *testing.T) {
-	old := helpers.UploadBackendTrustStoreCertificateFunc
-	defer func() { helpers.UploadBackendTrustStoreCertificateFunc = old }()
-
-	helpers.UploadBackendTrustStoreCertificateFunc = func(_ *api.RestApiClient, _, _ string) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("upload failed", "network error")
-		return d
-	}
+	var uploaded bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uploaded = uploaded || r.Method == http.MethodPost
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
 
-	r := &resources.BackendTrustStoreResource{Client: &api.RestApiClient{}}
+	r := &resources.BackendTrustStoreResource{Client: tfutils.NewTestClient(t, srv)}
 	_, diags := resources.UploadBackendCertificateFunc(r, context.Background(), tfutils.GenerateTestCert(t))
 
+	assert.True(t, uploaded)
 	assert.True(t, diags.HasError())
 }
 
 func TestBackendTrustStore_Upload_ReadAfterUploadFails(t *testing.T) {
-	old := helpers.UploadBackendTrustStoreCertificateFunc
-	defer func() { helpers.UploadBackendTrustStoreCertificateFunc = old }()
-
-	helpers.UploadBackendTrustStoreCertificateFunc = func(_ *api.RestApiClient, _, _ string) diag.Diagnostics {
-		return nil
-	}
-
-	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
 		w.WriteHeader(http.StatusInternalServerError)
 	}))
 	defer srv.Close()
@@ -150,13 +146,6 @@ func TestBackendTrustStore_Upload_ReadAfterUploadFails(t *testing.T) {
 }
 
 func TestBackendTrustStore_Upload_CertNotFoundInStore(t *testing.T) {
-	old := helpers.UploadBackendTrustStoreCertificateFunc
-	defer func() { helpers.UploadBackendTrustStoreCertificateFunc = old }()
-
-	helpers.UploadBackendTrustStoreCertificateFunc = func(_ *api.RestApiClient, _, _ string) diag.Diagnostics {
-		return nil
-	}
-
 	// Return a trust store that does NOT contain the uploaded cert.
 	emptyStore := apiobjects.BackendTrustStoreConfiguration{TrustedBackends: []apiobjects.TrustedBackends{}}
 	srv := httptest.NewServer(jsonHandler(t, emptyStore))
@@ -170,13 +159,6 @@ func TestBackendTrustStore_Upload_CertNotFoundInStore(t *testing.T) {
 }
 
 func TestBackendTrustStore_Upload_Success(t *testing.T) {
-	old := helpers.UploadBackendTrustStoreCertificateFunc
-	defer func() { helpers.UploadBackendTrustStoreCertificateFunc = old }()
-
-	helpers.UploadBackendTrustStoreCertificateFunc = func(_ *api.RestApiClient, _, _ string) diag.Diagnostics {
-		return nil
-	}
-
 	certPEM := tfutils.GenerateTestCert(t)
 	trustedBackend := buildMatchingTrustedBackend(t, certPEM)
 
@@ -189,6 +171,9 @@ func TestBackendTrustStore_Upload_Success(t *testing.T) {
 
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/truststore/certificates"):
+			w.WriteHeader(http.StatusNoContent)
+
 		case strings.HasSuffix(r.URL.Path, "/truststore"):
 			w.Header().Set("Content-Type", "application/json")
 			require.NoError(t, json.NewEncoder(w).Encode(store))
@@ -420,6 +405,7 @@ var btsSubjectDNType = tftypes.Object{
 
 var btsObjectType = tftypes.Object{
 	AttributeTypes: map[string]tftypes.Type{
+		"instance":    tftypes.String,
 		"certificate": tftypes.String,
 		"alias":       tftypes.String,
 		"subject_dn":  btsSubjectDNType,
@@ -438,6 +424,7 @@ func btsSchema(t *testing.T) resource.SchemaResponse {
 
 func btsRawValue(alias, certPEM string) tftypes.Value {
 	return tftypes.NewValue(btsObjectType, map[string]tftypes.Value{
+		"instance":    tftypes.NewValue(tftypes.String, nil),
 		"certificate": tftypes.NewValue(tftypes.String, certPEM),
 		"alias":       tftypes.NewValue(tftypes.String, alias),
 		"subject_dn":  tftypes.NewValue(btsSubjectDNType, nil),
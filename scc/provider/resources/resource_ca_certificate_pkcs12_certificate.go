@@ -6,8 +6,6 @@ import (
 	"fmt"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -71,6 +69,7 @@ The PKCS#12 file must be created from a CSR generated in SAP Cloud Connector and
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/ca-certificate-for-principal-propagation-apis#upload-a-pkcs#12-certificate-as-ca-certificate-for-principal-propagation-(master-only)>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"pkcs12_certificate": schema.StringAttribute{
 				MarkdownDescription: `PKCS#12 (.p12) certificate bundle.
 This value may be provided as:
@@ -210,6 +209,8 @@ func (r *CACertificatePKCS12CertificateResource) Create(ctx context.Context, req
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	responseModel, d := CreatePKCS12CACertificateFunc(r, ctx, plan)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
@@ -230,26 +231,29 @@ func (r *CACertificatePKCS12CertificateResource) Read(ctx context.Context, req r
 	}
 
 	var state model.PKCS12CACertificateResourceConfig
-	var respObj apiobjects.Certificate
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetCACertificateEndpoint()
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 
 	// Get Certificate Metadata
-	d := helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(d...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.CACertificate().Get(ctx)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
 	// Generate Binary Certificate
-	certBytes, d := helpers.GetCertificateBinaryFunc(r.Client, endpoint)
-	resp.Diagnostics.Append(d...)
-	if resp.Diagnostics.HasError() {
+	certBytes, err := r.Client.CACertificate().Download(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -258,7 +262,7 @@ func (r *CACertificatePKCS12CertificateResource) Read(ctx context.Context, req r
 		Bytes: certBytes,
 	})
 
-	d = helpers.ValidatePEMDataFunc(string(pemBytes))
+	d := helpers.ValidatePEMDataFunc(string(pemBytes))
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -270,6 +274,8 @@ func (r *CACertificatePKCS12CertificateResource) Read(ctx context.Context, req r
 		return
 	}
 
+	responseModel.Instance = state.Instance
+
 	responseModel.PKCS12Certificate = state.PKCS12Certificate
 	responseModel.Password = state.Password
 	responseModel.KeyPassword = state.KeyPassword
@@ -290,6 +296,8 @@ func (r *CACertificatePKCS12CertificateResource) Update(ctx context.Context, req
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -321,16 +329,15 @@ func (r *CACertificatePKCS12CertificateResource) Delete(ctx context.Context, req
 	}
 
 	var state model.PKCS12CACertificateResourceConfig
-	var respObj apiobjects.Certificate
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetCACertificateEndpoint()
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 
-	diags = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "DELETE", endpoint, nil, false)
+	diags = api.ErrorDiagnostics(r.Client.CACertificate().Delete(ctx))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -341,7 +348,6 @@ func (r *CACertificatePKCS12CertificateResource) Delete(ctx context.Context, req
 
 var CreatePKCS12CACertificateFunc = func(r *CACertificatePKCS12CertificateResource, ctx context.Context, plan model.PKCS12CACertificateResourceConfig) (*model.PKCS12CACertificateResourceConfig, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var respObj apiobjects.Certificate
 
 	rawCertificate, d := helpers.ValidatePKCS12InputsFunc(plan.PKCS12Certificate, plan.KeyPassword)
 	diags.Append(d...)
@@ -349,31 +355,28 @@ var CreatePKCS12CACertificateFunc = func(r *CACertificatePKCS12CertificateResour
 		return nil, diags
 	}
 
-	endpoint := endpoints.GetCACertificateEndpoint()
-
 	keyPassword := ""
 	if !plan.KeyPassword.IsNull() && !plan.KeyPassword.IsUnknown() {
 		keyPassword = plan.KeyPassword.ValueString()
 	}
 
 	// Upload PKCS#12 Certificate
-	d = helpers.UploadPKCS12CertificateFunc(r.Client, endpoint, rawCertificate, plan.Password.ValueString(), keyPassword)
-	diags.Append(d...)
-	if diags.HasError() {
+	if err := r.Client.CACertificate().UploadPKCS12(ctx, rawCertificate, plan.Password.ValueString(), keyPassword); err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
 	// Get Certificate Metadata
-	d = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "GET", endpoint, nil, true)
-	diags.Append(d...)
-	if diags.HasError() {
+	respObj, err := r.Client.CACertificate().Get(ctx)
+	if err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
 	// Generate Binary Certificate
-	certBytes, d := helpers.GetCertificateBinaryFunc(r.Client, endpoint)
-	diags.Append(d...)
-	if diags.HasError() {
+	certBytes, err := r.Client.CACertificate().Download(ctx)
+	if err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
@@ -394,6 +397,8 @@ var CreatePKCS12CACertificateFunc = func(r *CACertificatePKCS12CertificateResour
 		return nil, diags
 	}
 
+	responseModel.Instance = plan.Instance
+
 	responseModel.PKCS12Certificate = plan.PKCS12Certificate
 	responseModel.Password = plan.Password
 	responseModel.KeyPassword = plan.KeyPassword
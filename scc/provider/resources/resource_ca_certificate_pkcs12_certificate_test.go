@@ -3,7 +3,6 @@ package resources_test
 import (
 	"context"
 	"encoding/base64"
-	"encoding/pem"
 	"testing"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
@@ -16,7 +15,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
 )
 
 func TestCACertificatePKCS12Certificate_Metadata(t *testing.T) {
@@ -166,16 +164,7 @@ func TestCACertificatePKCS12Certificate_Create_ValidationFails(t *testing.T) {
 
 func TestCACertificatePKCS12Certificate_Create_UploadFails(t *testing.T) {
 	r := &resources.CACertificatePKCS12CertificateResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldUpload := helpers.UploadPKCS12CertificateFunc
-	defer func() { helpers.UploadPKCS12CertificateFunc = oldUpload }()
-
-	helpers.UploadPKCS12CertificateFunc = func(*api.RestApiClient, string, []byte, string, string) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("upload failed", "fail")
-		return d
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateUpload),
 	}
 
 	plan := model.PKCS12CACertificateResourceConfig{
@@ -190,24 +179,7 @@ func TestCACertificatePKCS12Certificate_Create_UploadFails(t *testing.T) {
 
 func TestCACertificatePKCS12Certificate_Create_MetadataFails(t *testing.T) {
 	r := &resources.CACertificatePKCS12CertificateResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldUpload := helpers.UploadPKCS12CertificateFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	defer func() {
-		helpers.UploadPKCS12CertificateFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-	}()
-
-	helpers.UploadPKCS12CertificateFunc = func(*api.RestApiClient, string, []byte, string, string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("metadata error", "fail")
-		return d
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateGet),
 	}
 
 	plan := model.PKCS12CACertificateResourceConfig{
@@ -222,30 +194,7 @@ func TestCACertificatePKCS12Certificate_Create_MetadataFails(t *testing.T) {
 
 func TestCACertificatePKCS12Certificate_Create_BinaryFails(t *testing.T) {
 	r := &resources.CACertificatePKCS12CertificateResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldUpload := helpers.UploadPKCS12CertificateFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
-	defer func() {
-		helpers.UploadPKCS12CertificateFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
-	}()
-
-	helpers.UploadPKCS12CertificateFunc = func(*api.RestApiClient, string, []byte, string, string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		var d diag.Diagnostics
-		d.AddError("binary error", "fail")
-		return nil, d
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateDownload),
 	}
 
 	plan := model.PKCS12CACertificateResourceConfig{
@@ -260,35 +209,15 @@ func TestCACertificatePKCS12Certificate_Create_BinaryFails(t *testing.T) {
 
 func TestCACertificatePKCS12Certificate_Create_InvalidPEM(t *testing.T) {
 	r := &resources.CACertificatePKCS12CertificateResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateDownload),
 	}
 
-	oldUpload := helpers.UploadPKCS12CertificateFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValue := model.PKCS12CACertificateResourceValueFromFunc
 
 	defer func() {
-		helpers.UploadPKCS12CertificateFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		model.PKCS12CACertificateResourceValueFromFunc = oldValue
 	}()
 
-	helpers.UploadPKCS12CertificateFunc = func(*api.RestApiClient, string, []byte, string, string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		var d diag.Diagnostics
-		d.AddError("binary error", "fail")
-		return nil, d
-	}
-
 	plan := model.PKCS12CACertificateResourceConfig{
 		PKCS12Certificate: types.StringValue("abc"),
 		Password:          types.StringValue("pass"),
@@ -301,36 +230,15 @@ func TestCACertificatePKCS12Certificate_Create_InvalidPEM(t *testing.T) {
 
 func TestCACertificatePKCS12Certificate_Create_ModelConversionFails(t *testing.T) {
 	r := &resources.CACertificatePKCS12CertificateResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, tfutils.GenerateValidDERCert(t)),
 	}
 
-	oldUpload := helpers.UploadPKCS12CertificateFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValue := model.PKCS12CACertificateResourceValueFromFunc
 
 	defer func() {
-		helpers.UploadPKCS12CertificateFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		model.PKCS12CACertificateResourceValueFromFunc = oldValue
 	}()
 
-	helpers.UploadPKCS12CertificateFunc = func(*api.RestApiClient, string, []byte, string, string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		cert := tfutils.GenerateTestCert(t)
-		block, _ := pem.Decode([]byte(cert))
-		require.NotNil(t, block)
-		return block.Bytes, nil
-	}
-
 	model.PKCS12CACertificateResourceValueFromFunc = func(context.Context, apiobjects.Certificate) (model.PKCS12CACertificateResourceConfig, diag.Diagnostics) {
 		var d diag.Diagnostics
 		d.AddError("model error", "fail")
@@ -349,33 +257,15 @@ func TestCACertificatePKCS12Certificate_Create_ModelConversionFails(t *testing.T
 
 func TestCACertificatePKCS12Certificate_Create_Success(t *testing.T) {
 	r := &resources.CACertificatePKCS12CertificateResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, tfutils.GenerateValidDERCert(t)),
 	}
 
-	oldUpload := helpers.UploadPKCS12CertificateFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValue := model.PKCS12CACertificateResourceValueFromFunc
 
 	defer func() {
-		helpers.UploadPKCS12CertificateFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		model.PKCS12CACertificateResourceValueFromFunc = oldValue
 	}()
 
-	helpers.UploadPKCS12CertificateFunc = func(*api.RestApiClient, string, []byte, string, string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		return tfutils.GenerateValidDERCert(t), nil
-	}
-
 	model.PKCS12CACertificateResourceValueFromFunc = func(ctx context.Context, obj apiobjects.Certificate) (model.PKCS12CACertificateResourceConfig, diag.Diagnostics) {
 		return model.PKCS12CACertificateResourceConfig{
 			PKCS12Certificate: types.StringValue("abc"),
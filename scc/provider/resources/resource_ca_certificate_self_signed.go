@@ -8,7 +8,6 @@ import (
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -55,6 +54,7 @@ func (r *CACertificateSelfSignedResource) Schema(ctx context.Context, req resour
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/ca-certificate-for-principal-propagation-apis#create-a-self-signed-ca-certificate-for-principal-propagation-(master-only)>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"key_size": schema.Int64Attribute{
 				MarkdownDescription: "Key size in bits. Allowed values: 2048 or 4096.",
 				Optional:            true,
@@ -216,6 +216,8 @@ func (r *CACertificateSelfSignedResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	model, diags := CreateSelfSignedCACertificateFunc(r, ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -234,14 +236,13 @@ func (r *CACertificateSelfSignedResource) Read(ctx context.Context, req resource
 		return
 	}
 	var state model.SelfSignedCACertificateResourceConfig
-	var respObj apiobjects.Certificate
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetCACertificateEndpoint()
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 
 	dnStruct, diags := helpers.ExpandSubjectDNFunc(ctx, state.SubjectDN)
 	resp.Diagnostics.Append(diags...)
@@ -250,16 +251,20 @@ func (r *CACertificateSelfSignedResource) Read(ctx context.Context, req resource
 	}
 
 	// Get Certificate Metadata
-	diags = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.CACertificate().Get(ctx)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
 	// Generate Binary Certificate
-	certBytes, diags := helpers.GetCertificateBinaryFunc(r.Client, endpoint)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	certBytes, err := r.Client.CACertificate().Download(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -280,6 +285,8 @@ func (r *CACertificateSelfSignedResource) Read(ctx context.Context, req resource
 		return
 	}
 
+	responseModel.Instance = state.Instance
+
 	responseModel.KeySize = state.KeySize
 	responseModel.CertificatePEM = types.StringValue(string(pemBytes))
 
@@ -298,6 +305,8 @@ func (r *CACertificateSelfSignedResource) Update(ctx context.Context, req resour
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -330,16 +339,15 @@ func (r *CACertificateSelfSignedResource) Delete(ctx context.Context, req resour
 		return
 	}
 	var state model.SelfSignedCACertificateResourceConfig
-	var respObj apiobjects.Certificate
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetCACertificateEndpoint()
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 
-	diags = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "DELETE", endpoint, nil, false)
+	diags = api.ErrorDiagnostics(r.Client.CACertificate().Delete(ctx))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -350,7 +358,6 @@ func (r *CACertificateSelfSignedResource) Delete(ctx context.Context, req resour
 
 var CreateSelfSignedCACertificateFunc = func(r *CACertificateSelfSignedResource, ctx context.Context, plan model.SelfSignedCACertificateResourceConfig) (*model.SelfSignedCACertificateResourceConfig, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var respObj apiobjects.Certificate
 
 	if plan.SubjectDN.IsNull() || plan.SubjectDN.IsUnknown() {
 		diags.AddError(
@@ -367,10 +374,9 @@ var CreateSelfSignedCACertificateFunc = func(r *CACertificateSelfSignedResource,
 	}
 
 	subjectDN := helpers.BuildSubjectDNFunc(dnStruct)
-	planBody := map[string]any{
-		"type":      "selfsigned",
-		"keySize":   plan.KeySize.ValueInt64(),
-		"subjectDN": subjectDN,
+	request := apiobjects.CertificateRequest{
+		KeySize:   plan.KeySize.ValueInt64(),
+		SubjectDN: subjectDN,
 	}
 
 	if !plan.SubjectAltNames.IsNull() &&
@@ -382,39 +388,31 @@ var CreateSelfSignedCACertificateFunc = func(r *CACertificateSelfSignedResource,
 			return nil, diags
 		}
 
-		if len(sanList) > 0 {
-			sanFields := []map[string]string{}
-			for _, san := range sanList {
-				sanFields = append(sanFields, map[string]string{
-					"type":  san.Type.ValueString(),
-					"value": san.Value.ValueString(),
-				})
-			}
-
-			planBody["subjectAltNames"] = sanFields
+		for _, san := range sanList {
+			request.SubjectAltNames = append(request.SubjectAltNames, apiobjects.SubjectAltNames{
+				Type:  san.Type.ValueString(),
+				Value: san.Value.ValueString(),
+			})
 		}
 	}
 
-	endpoint := endpoints.GetCACertificateEndpoint()
-
 	// Create Self-Signed Certificate
-	d = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "POST", endpoint, planBody, false)
-	diags.Append(d...)
-	if diags.HasError() {
+	if err := r.Client.CACertificate().CreateSelfSigned(ctx, request); err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
 	// Get Certificate Metadata
-	d = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "GET", endpoint, nil, true)
-	diags.Append(d...)
-	if diags.HasError() {
+	respObj, err := r.Client.CACertificate().Get(ctx)
+	if err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
 	// Generate Binary Certificate
-	certBytes, d := helpers.GetCertificateBinaryFunc(r.Client, endpoint)
-	diags.Append(d...)
-	if diags.HasError() {
+	certBytes, err := r.Client.CACertificate().Download(ctx)
+	if err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
@@ -435,6 +433,8 @@ var CreateSelfSignedCACertificateFunc = func(r *CACertificateSelfSignedResource,
 		return nil, diags
 	}
 
+	responseModel.Instance = plan.Instance
+
 	responseModel.KeySize = plan.KeySize
 	responseModel.CertificatePEM = types.StringValue(string(pemBytes))
 
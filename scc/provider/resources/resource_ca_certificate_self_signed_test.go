@@ -2,6 +2,9 @@ package resources_test
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
@@ -85,16 +88,7 @@ func TestCACertificateSelfSigned_Create_MissingSubjectDN(t *testing.T) {
 
 func TestCACertificateSelfSigned_Create_RequestFails(t *testing.T) {
 	r := &resources.CACertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	defer func() { helpers.RequestAndUnmarshalCertificateFunc = oldReq }()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("request failed", "fail")
-		return d
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateUpload),
 	}
 
 	plan := testValidSelfSignedCAPlan()
@@ -106,24 +100,7 @@ func TestCACertificateSelfSigned_Create_RequestFails(t *testing.T) {
 
 func TestCACertificateSelfSigned_Create_BinaryFails(t *testing.T) {
 	r := &resources.CACertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
-	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
-	}()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		var d diag.Diagnostics
-		d.AddError("binary error", "fail")
-		return nil, d
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateDownload),
 	}
 
 	plan := testValidSelfSignedCAPlan()
@@ -135,27 +112,15 @@ func TestCACertificateSelfSigned_Create_BinaryFails(t *testing.T) {
 
 func TestCACertificateSelfSigned_Create_Success(t *testing.T) {
 	r := &resources.CACertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, tfutils.GenerateValidDERCert(t)),
 	}
 
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValue := model.SelfSignedCACertificateResourceValueFromFunc
 
 	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		model.SelfSignedCACertificateResourceValueFromFunc = oldValue
 	}()
 
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		return tfutils.GenerateValidDERCert(t), nil
-	}
-
 	model.SelfSignedCACertificateResourceValueFromFunc = func(
 		ctx context.Context,
 		obj apiobjects.Certificate,
@@ -173,40 +138,31 @@ func TestCACertificateSelfSigned_Create_Success(t *testing.T) {
 }
 
 func TestCACertificateSelfSigned_Create_WithSANs(t *testing.T) {
+	der := tfutils.GenerateValidDERCert(t)
+	var capturedBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost:
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&capturedBody))
+			w.WriteHeader(http.StatusCreated)
+		case r.Header.Get("Accept") == "application/pkix-cert":
+			_, _ = w.Write(der)
+		default:
+			_, _ = w.Write([]byte("{}"))
+		}
+	}))
+	defer server.Close()
+
 	r := &resources.CACertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewTestClient(t, server),
 	}
 
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValue := model.SelfSignedCACertificateResourceValueFromFunc
 
 	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		model.SelfSignedCACertificateResourceValueFromFunc = oldValue
 	}()
 
-	var capturedBody map[string]any
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		_ *api.RestApiClient,
-		_ *apiobjects.Certificate,
-		method string,
-		_ string,
-		body map[string]any,
-		_ bool,
-	) diag.Diagnostics {
-		if method == "POST" {
-			capturedBody = body
-		}
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		return tfutils.GenerateValidDERCert(t), nil
-	}
-
 	model.SelfSignedCACertificateResourceValueFromFunc = func(
 		ctx context.Context,
 		obj apiobjects.Certificate,
@@ -239,26 +195,14 @@ func TestCACertificateSelfSigned_Create_WithSANs(t *testing.T) {
 
 func TestCACertificateSelfSigned_Create_InvalidPEM(t *testing.T) {
 	r := &resources.CACertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, []byte("invalid")),
 	}
 
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValidate := helpers.ValidatePEMDataFunc
 	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		helpers.ValidatePEMDataFunc = oldValidate
 	}()
 
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		return []byte("invalid"), nil
-	}
-
 	helpers.ValidatePEMDataFunc = func(string) diag.Diagnostics {
 		var d diag.Diagnostics
 		d.AddError("Invalid PEM", "failed to parse certificate")
@@ -274,27 +218,15 @@ func TestCACertificateSelfSigned_Create_InvalidPEM(t *testing.T) {
 
 func TestCACertificateSelfSigned_Create_ModelFails(t *testing.T) {
 	r := &resources.CACertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, tfutils.GenerateValidDERCert(t)),
 	}
 
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValue := model.SelfSignedCACertificateResourceValueFromFunc
 
 	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		model.SelfSignedCACertificateResourceValueFromFunc = oldValue
 	}()
 
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		return tfutils.GenerateValidDERCert(t), nil
-	}
-
 	model.SelfSignedCACertificateResourceValueFromFunc = func(
 		ctx context.Context,
 		obj apiobjects.Certificate,
@@ -314,14 +246,7 @@ func TestCACertificateSelfSigned_Create_ModelFails(t *testing.T) {
 
 func TestCACertificateSelfSigned_Delete_Success(t *testing.T) {
 	r := &resources.CACertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	defer func() { helpers.RequestAndUnmarshalCertificateFunc = oldReq }()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
+		Client: tfutils.NewCertificateTestClient(t, nil),
 	}
 
 	req := resource.DeleteRequest{}
@@ -334,16 +259,7 @@ func TestCACertificateSelfSigned_Delete_Success(t *testing.T) {
 
 func TestCACertificateSelfSigned_Delete_APIError(t *testing.T) {
 	r := &resources.CACertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	defer func() { helpers.RequestAndUnmarshalCertificateFunc = oldReq }()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("delete failed", "api error")
-		return d
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateDelete),
 	}
 
 	schemaResp := &resource.SchemaResponse{}
@@ -364,16 +280,7 @@ func TestCACertificateSelfSigned_Delete_APIError(t *testing.T) {
 
 func TestCACertificateSelfSigned_Read_APIError(t *testing.T) {
 	r := &resources.CACertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	defer func() { helpers.RequestAndUnmarshalCertificateFunc = oldReq }()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("get failed", "api error")
-		return d
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateGet),
 	}
 
 	schemaResp := &resource.SchemaResponse{}
@@ -394,23 +301,7 @@ func TestCACertificateSelfSigned_Read_APIError(t *testing.T) {
 
 func TestCACertificateSelfSigned_Read_BinaryError(t *testing.T) {
 	r := &resources.CACertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
-	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
-	}()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		var d diag.Diagnostics
-		d.AddError("binary failed", "cert binary error")
-		return nil, d
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateDownload),
 	}
 
 	schemaResp := &resource.SchemaResponse{}
@@ -431,24 +322,14 @@ func TestCACertificateSelfSigned_Read_BinaryError(t *testing.T) {
 
 func TestCACertificateSelfSigned_Read_PEMError(t *testing.T) {
 	r := &resources.CACertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, []byte("notcert")),
 	}
 
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValidate := helpers.ValidatePEMDataFunc
 	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		helpers.ValidatePEMDataFunc = oldValidate
 	}()
 
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		return []byte("notcert"), nil
-	}
 	helpers.ValidatePEMDataFunc = func(string) diag.Diagnostics {
 		var d diag.Diagnostics
 		d.AddError("Invalid PEM", "pem validation failed")
@@ -473,24 +354,14 @@ func TestCACertificateSelfSigned_Read_PEMError(t *testing.T) {
 
 func TestCACertificateSelfSigned_Read_ModelError(t *testing.T) {
 	r := &resources.CACertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, tfutils.GenerateValidDERCert(t)),
 	}
 
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValue := model.SelfSignedCACertificateResourceValueFromFunc
 	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		model.SelfSignedCACertificateResourceValueFromFunc = oldValue
 	}()
 
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		return tfutils.GenerateValidDERCert(t), nil
-	}
 	model.SelfSignedCACertificateResourceValueFromFunc = func(ctx context.Context, obj apiobjects.Certificate, dn *helpers.CertificateSubjectDNConfig) (model.SelfSignedCACertificateResourceConfig, diag.Diagnostics) {
 		var d diag.Diagnostics
 		d.AddError("model error", "conversion failed")
@@ -604,27 +475,15 @@ func TestCACertificateSelfSigned_Update_CreateFails(t *testing.T) {
 
 func TestCACertificateSelfSigned_Read_Success(t *testing.T) {
 	r := &resources.CACertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, tfutils.GenerateValidDERCert(t)),
 	}
 
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValue := model.SelfSignedCACertificateResourceValueFromFunc
 
 	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		model.SelfSignedCACertificateResourceValueFromFunc = oldValue
 	}()
 
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		return tfutils.GenerateValidDERCert(t), nil
-	}
-
 	model.SelfSignedCACertificateResourceValueFromFunc = func(
 		ctx context.Context,
 		obj apiobjects.Certificate,
@@ -6,8 +6,6 @@ import (
 	"fmt"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -71,6 +69,7 @@ The uploaded certificate chain becomes the **Principal Propagation CA certificat
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/ca-certificate-for-principal-propagation-apis#upload-a-signed-certificate-chain-as-ca-certificate-for-principal-propagation-(master-only)>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"signed_chain": schema.StringAttribute{
 				MarkdownDescription: `PEM-encoded signed certificate chain for the Principal Propagation CA certificate.
 The certificate chain must be ordered as follows:
@@ -198,12 +197,16 @@ func (r *CACertificateSignedChainResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	model, diags := CreateSignedChainCACertificateFunc(r, ctx, plan.SignedChain.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	model.Instance = plan.Instance
+
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -218,26 +221,29 @@ func (r *CACertificateSignedChainResource) Read(ctx context.Context, req resourc
 	}
 
 	var state model.SignedChainCACertificateResourceConfig
-	var respObj apiobjects.Certificate
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetCACertificateEndpoint()
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 
 	// Get Certificate Metadata
-	diags = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.CACertificate().Get(ctx)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
 	// Generate Binary Certificate
-	certBytes, diags := helpers.GetCertificateBinaryFunc(r.Client, endpoint)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	certBytes, err := r.Client.CACertificate().Download(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -258,6 +264,8 @@ func (r *CACertificateSignedChainResource) Read(ctx context.Context, req resourc
 		return
 	}
 
+	responseModel.Instance = state.Instance
+
 	responseModel.SignedChain = state.SignedChain
 	responseModel.CertificatePEM = types.StringValue(string(pemBytes))
 
@@ -276,6 +284,8 @@ func (r *CACertificateSignedChainResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -293,6 +303,8 @@ func (r *CACertificateSignedChainResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	model.Instance = plan.Instance
+
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -307,16 +319,15 @@ func (r *CACertificateSignedChainResource) Delete(ctx context.Context, req resou
 	}
 
 	var state model.SignedChainCACertificateResourceConfig
-	var respObj apiobjects.Certificate
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetCACertificateEndpoint()
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 
-	diags = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "DELETE", endpoint, nil, false)
+	diags = api.ErrorDiagnostics(r.Client.CACertificate().Delete(ctx))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -327,7 +338,6 @@ func (r *CACertificateSignedChainResource) Delete(ctx context.Context, req resou
 
 var CreateSignedChainCACertificateFunc = func(r *CACertificateSignedChainResource, ctx context.Context, signedChain string) (*model.SignedChainCACertificateResourceConfig, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var respObj apiobjects.Certificate
 
 	if signedChain != "" {
 		certDiags := helpers.ValidatePEMChainFunc(signedChain)
@@ -337,23 +347,20 @@ var CreateSignedChainCACertificateFunc = func(r *CACertificateSignedChainResourc
 		}
 	}
 
-	endpoint := endpoints.GetCACertificateEndpoint()
-
-	d := helpers.UploadSignedChainFunc(r.Client, endpoint, signedChain)
-	diags.Append(d...)
-	if diags.HasError() {
+	if err := r.Client.CACertificate().UploadSignedChain(ctx, signedChain); err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
-	d = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "GET", endpoint, nil, true)
-	diags.Append(d...)
-	if diags.HasError() {
+	respObj, err := r.Client.CACertificate().Get(ctx)
+	if err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
-	certBytes, d := helpers.GetCertificateBinaryFunc(r.Client, endpoint)
-	diags.Append(d...)
-	if diags.HasError() {
+	certBytes, err := r.Client.CACertificate().Download(ctx)
+	if err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
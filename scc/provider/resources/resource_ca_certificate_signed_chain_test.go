@@ -2,7 +2,6 @@ package resources_test
 
 import (
 	"context"
-	"encoding/pem"
 	"testing"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
@@ -18,7 +17,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
 )
 
 func TestCACertificateSignedChain_Metadata(t *testing.T) {
@@ -118,44 +116,16 @@ func TestCACertificateSignedChain_Update_WithChange(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewCACertificateSignedChainResource().(*resources.CACertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
+	r.Client = tfutils.NewCertificateTestClient(t, tfutils.GenerateValidDERCert(t))
 
-	oldUpload := helpers.UploadSignedChainFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValidate := helpers.ValidatePEMChainFunc
 	oldModel := model.SignedChainCACertificateResourceValueFromFunc
 
 	defer func() {
-		helpers.UploadSignedChainFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		helpers.ValidatePEMChainFunc = oldValidate
 		model.SignedChainCACertificateResourceValueFromFunc = oldModel
 	}()
 
-	helpers.UploadSignedChainFunc = func(client *api.RestApiClient, endpoint, chain string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(client *api.RestApiClient, endpoint string) ([]byte, diag.Diagnostics) {
-		certPEM := tfutils.GenerateTestCert(t)
-		block, _ := pem.Decode([]byte(certPEM))
-		require.NotNil(t, block)
-		return block.Bytes, nil
-	}
-
 	helpers.ValidatePEMChainFunc = func(data string) diag.Diagnostics {
 		return nil
 	}
@@ -164,7 +134,6 @@ func TestCACertificateSignedChain_Update_WithChange(t *testing.T) {
 		ctx context.Context,
 		resp apiobjects.Certificate,
 	) (model.SignedChainCACertificateResourceConfig, diag.Diagnostics) {
-
 		subjectDNValue, _ := types.ObjectValue(
 			map[string]attr.Type{
 				"cn":    types.StringType,
@@ -238,22 +207,8 @@ func TestCACertificateSignedChain_Update_WithChange(t *testing.T) {
 
 func TestCACertificateSignedChain_Delete(t *testing.T) {
 	r := &resources.CACertificateSignedChainResource{
-		Client: &api.RestApiClient{},
-	}
-
-	// Mock API call
-	old := helpers.RequestAndUnmarshalCertificateFunc
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		return nil
+		Client: tfutils.NewCertificateTestClient(t, nil),
 	}
-	defer func() { helpers.RequestAndUnmarshalCertificateFunc = old }()
 
 	resp := &resource.DeleteResponse{}
 	req := resource.DeleteRequest{}
@@ -276,35 +231,9 @@ func TestCACertificateSignedChain_Delete_NoState(t *testing.T) {
 
 func TestCACertificateSignedChain_Read(t *testing.T) {
 	r := &resources.CACertificateSignedChainResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(
-		client *api.RestApiClient,
-		endpoint string,
-	) ([]byte, diag.Diagnostics) {
-		return []byte("fakecert"), nil
+		Client: tfutils.NewCertificateTestClient(t, []byte("fakecert")),
 	}
 
-	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
-	}()
-
 	resp := &resource.ReadResponse{}
 	req := resource.ReadRequest{}
 
@@ -328,23 +257,7 @@ func TestCACertificateSignedChain_Delete_APIFailure(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewCACertificateSignedChainResource().(*resources.CACertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
-
-	old := helpers.RequestAndUnmarshalCertificateFunc
-	defer func() { helpers.RequestAndUnmarshalCertificateFunc = old }()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("delete failed", "fail")
-		return d
-	}
+	r.Client = tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateDelete)
 
 	// get resource schema
 	schemaResp := &resource.SchemaResponse{}
@@ -373,23 +286,7 @@ func TestCACertificateSignedChain_Read_APIFailure(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewCACertificateSignedChainResource().(*resources.CACertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
-
-	old := helpers.RequestAndUnmarshalCertificateFunc
-	defer func() { helpers.RequestAndUnmarshalCertificateFunc = old }()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("api error", "fail")
-		return d
-	}
+	r.Client = tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateGet)
 
 	schemaResp := &resource.SchemaResponse{}
 	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
@@ -411,35 +308,7 @@ func TestCACertificateSignedChain_Read_BinaryFailure(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewCACertificateSignedChainResource().(*resources.CACertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
-
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
-
-	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
-	}()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(
-		client *api.RestApiClient,
-		endpoint string,
-	) ([]byte, diag.Diagnostics) {
-		var d diag.Diagnostics
-		d.AddError("binary error", "fail")
-		return nil, d
-	}
+	r.Client = tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateDownload)
 
 	schemaResp := &resource.SchemaResponse{}
 	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
@@ -461,16 +330,7 @@ func TestCACertificateSignedChain_Create_UploadFails(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewCACertificateSignedChainResource().(*resources.CACertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
-
-	oldUpload := helpers.UploadSignedChainFunc
-	defer func() { helpers.UploadSignedChainFunc = oldUpload }()
-
-	helpers.UploadSignedChainFunc = func(client *api.RestApiClient, endpoint, chain string) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("upload failed", "fail")
-		return d
-	}
+	r.Client = tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateUpload)
 
 	// get resource schema
 	schemaResp := &resource.SchemaResponse{}
@@ -482,10 +342,12 @@ func TestCACertificateSignedChain_Create_UploadFails(t *testing.T) {
 		Raw: tftypes.NewValue(
 			tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
+					"instance":     tftypes.String,
 					"signed_chain": tftypes.String,
 				},
 			},
 			map[string]tftypes.Value{
+				"instance":     tftypes.NewValue(tftypes.String, nil),
 				"signed_chain": tftypes.NewValue(tftypes.String, "fake-cert"),
 			},
 		),
@@ -510,32 +372,7 @@ func TestCACertificateSignedChain_Create_MetadataFails(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewCACertificateSignedChainResource().(*resources.CACertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
-
-	oldUpload := helpers.UploadSignedChainFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-
-	defer func() {
-		helpers.UploadSignedChainFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-	}()
-
-	helpers.UploadSignedChainFunc = func(client *api.RestApiClient, endpoint, chain string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("metadata error", "fail")
-		return d
-	}
+	r.Client = tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateGet)
 
 	req := resource.CreateRequest{
 		Plan: buildCACertificateSignedChainPlan(ctx, r, "fake-cert"),
@@ -552,38 +389,7 @@ func TestCACertificateSignedChain_Create_BinaryFails(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewCACertificateSignedChainResource().(*resources.CACertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
-
-	oldUpload := helpers.UploadSignedChainFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
-
-	defer func() {
-		helpers.UploadSignedChainFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
-	}()
-
-	helpers.UploadSignedChainFunc = func(client *api.RestApiClient, endpoint, chain string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(client *api.RestApiClient, endpoint string) ([]byte, diag.Diagnostics) {
-		var d diag.Diagnostics
-		d.AddError("binary error", "fail")
-		return nil, d
-	}
+	r.Client = tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateDownload)
 
 	req := resource.CreateRequest{
 		Plan: buildCACertificateSignedChainPlan(ctx, r, "fake-cert"),
@@ -600,51 +406,16 @@ func TestCACertificateSignedChain_Create_Success(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewCACertificateSignedChainResource().(*resources.CACertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
+	r.Client = tfutils.NewCertificateTestClient(t, tfutils.GenerateValidDERCert(t))
 
-	oldUpload := helpers.UploadSignedChainFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldModel := model.SignedChainCACertificateResourceValueFromFunc
 	oldValidate := helpers.ValidatePEMChainFunc
 
 	defer func() {
-		helpers.UploadSignedChainFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		model.SignedChainCACertificateResourceValueFromFunc = oldModel
 		helpers.ValidatePEMChainFunc = oldValidate
 	}()
 
-	helpers.UploadSignedChainFunc = func(client *api.RestApiClient, endpoint, chain string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-
-		respObj.Issuer = "Test CA"
-		respObj.SerialNumber = "123"
-		respObj.NotBeforeTimeStamp = 1700000000000
-		respObj.NotAfterTimeStamp = 1800000000000
-		respObj.SubjectDN = "CN=test.example.com"
-
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(client *api.RestApiClient, endpoint string) ([]byte, diag.Diagnostics) {
-		cert := tfutils.GenerateTestCert(t)
-		block, _ := pem.Decode([]byte(cert))
-		require.NotNil(t, block)
-		return block.Bytes, nil
-	}
-
 	// model.SignedChainCACertificateResourceValueFromFunc = model.SignedChainCACertificateResourceValueFromFunc
 
 	helpers.ValidatePEMChainFunc = func(data string) diag.Diagnostics {
@@ -688,6 +459,7 @@ func buildUpdatePlanStateCAWithAllAttrs(
 	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
 
 	attrTypes := map[string]tftypes.Type{
+		"instance":        tftypes.String,
 		"signed_chain":    tftypes.String,
 		"issuer":          tftypes.String,
 		"serial_number":   tftypes.String,
@@ -712,6 +484,7 @@ func buildUpdatePlanStateCAWithAllAttrs(
 	raw := tftypes.NewValue(
 		tftypes.Object{AttributeTypes: attrTypes},
 		map[string]tftypes.Value{
+			"instance":     tftypes.NewValue(tftypes.String, nil),
 			"signed_chain": tftypes.NewValue(tftypes.String, value),
 
 			"issuer":          tftypes.NewValue(tftypes.String, nil),
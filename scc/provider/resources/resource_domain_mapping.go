@@ -3,12 +3,11 @@ package resources
 import (
 	"context"
 	"fmt"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"strings"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
-	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -51,6 +50,7 @@ __Tips:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/domain-mappings>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"region_host": schema.StringAttribute{
 				MarkdownDescription: "Region Host Name.",
 				Required:            true,
@@ -119,27 +119,28 @@ func (r *DomainMappingResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	regionHost := plan.RegionHost.ValueString()
 	subaccount := plan.Subaccount.ValueString()
 	internalDomain := plan.InternalDomain.ValueString()
-	endpoint := endpoints.GetDomainMappingBaseEndpoint(regionHost, subaccount)
 
-	planBody := map[string]any{
-		"virtualDomain":  plan.VirtualDomain.ValueString(),
-		"internalDomain": plan.InternalDomain.ValueString(),
+	body := apiobjects.DomainMappingRequest{
+		InternalDomain: plan.InternalDomain.ValueString(),
+		VirtualDomain:  plan.VirtualDomain.ValueString(),
 	}
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj.DomainMappings, "POST", endpoint, planBody, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	if err := r.Client.DomainMappings().Create(ctx, regionHost, subaccount, body); err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj.DomainMappings, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	domainMappings, err := r.Client.DomainMappings().List(ctx, regionHost, subaccount)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
+	respObj.DomainMappings = domainMappings
 
 	mappingRespObj, diags := model.GetDomainMapping(respObj, internalDomain)
 	resp.Diagnostics.Append(diags...)
@@ -178,20 +179,28 @@ func (r *DomainMappingResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
 	regionHost := state.RegionHost.ValueString()
 	subaccount := state.Subaccount.ValueString()
 	internalDomain := state.InternalDomain.ValueString()
-	endpoint := endpoints.GetDomainMappingBaseEndpoint(regionHost, subaccount)
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj.DomainMappings, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	domainMappings, err := r.Client.DomainMappings().List(ctx, regionHost, subaccount)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
+	respObj.DomainMappings = domainMappings
 
+	// The domain mappings can only be read as a whole, so a mapping missing from
+	// the list has been deleted outside of Terraform.
 	mappingRespObj, diags := model.GetDomainMapping(respObj, internalDomain)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	if diags.HasError() {
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -227,6 +236,8 @@ func (r *DomainMappingResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -242,26 +253,23 @@ func (r *DomainMappingResource) Update(ctx context.Context, req resource.UpdateR
 		resp.Diagnostics.AddError("Error updating the cloud connector domain mapping", "Failed to update the cloud connector domain mapping due to mismatched configuration values.")
 		return
 	}
-	endpoint := endpoints.GetDomainMappingEndpoint(regionHost, subaccount, internalDomain)
 
-	planBody := map[string]any{
-		"virtualDomain":  plan.VirtualDomain.ValueString(),
-		"internalDomain": plan.InternalDomain.ValueString(),
+	body := apiobjects.DomainMappingRequest{
+		InternalDomain: plan.InternalDomain.ValueString(),
+		VirtualDomain:  plan.VirtualDomain.ValueString(),
 	}
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj.DomainMappings, "PUT", endpoint, planBody, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	if err := r.Client.DomainMappings().Update(ctx, regionHost, subaccount, internalDomain, body); err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
-	endpoint = endpoints.GetDomainMappingBaseEndpoint(regionHost, subaccount)
-
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj.DomainMappings, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	domainMappings, err := r.Client.DomainMappings().List(ctx, regionHost, subaccount)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
+	respObj.DomainMappings = domainMappings
 
 	mappingRespObj, diags := model.GetDomainMapping(respObj, plan.InternalDomain.ValueString())
 	resp.Diagnostics.Append(diags...)
@@ -293,38 +301,27 @@ func (r *DomainMappingResource) Update(ctx context.Context, req resource.UpdateR
 
 func (r *DomainMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state model.DomainMappingConfig
-	var respObj apiobjects.DomainMapping
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
 	regionHost := state.RegionHost.ValueString()
 	subaccount := state.Subaccount.ValueString()
 	internalDomain := state.InternalDomain.ValueString()
-	endpoint := endpoints.GetDomainMappingEndpoint(regionHost, subaccount, internalDomain)
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "DELETE", endpoint, nil, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	responseModel, diags := model.DomainMappingValueFrom(ctx, state, respObj)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	diags = resp.State.Set(ctx, responseModel)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	if err := r.Client.DomainMappings().Delete(ctx, regionHost, subaccount, internalDomain); err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 }
 
 func (rs *DomainMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = helpers.ImportInstanceID(ctx, rs.Client, req.ID, resp)
+
 	if req.ID != "" {
 		idParts := strings.Split(req.ID, ",")
 
@@ -5,9 +5,11 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
 	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -95,6 +97,39 @@ func TestResourceDomainMapping(t *testing.T) {
 		})
 	})
 
+	t.Run("happy path - deleted outside of terraform is recreated", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+		srv.Update(func(state *sccmock.State) {
+			state.Subaccounts = append(state.Subaccounts, &sccmock.Subaccount{RegionHost: regionHost, ID: subaccount})
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceDomainMapping("scc_dm", regionHost, subaccount, virtualDomain, internalDomain),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.Subaccount(regionHost, subaccount).DomainMappings = nil
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceDomainMapping("scc_dm", regionHost, subaccount, virtualDomain, internalDomain),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_domain_mapping.scc_dm", plancheck.ResourceActionCreate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_domain_mapping.scc_dm", "virtual_domain", virtualDomain),
+					),
+				},
+			},
+		})
+	})
+
 	t.Run("error path - region host mandatory", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
 			IsUnitTest:               true,
@@ -0,0 +1,243 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &HAMasterSettingsResource{}
+
+func NewHAMasterSettingsResource() resource.Resource {
+	return &HAMasterSettingsResource{}
+}
+
+type HAMasterSettingsResource struct {
+	Client *api.RestApiClient
+}
+
+type haMasterSettingsResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *HAMasterSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ha_master_settings"
+}
+
+func (r *HAMasterSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector High Availability Master Settings Resource.
+
+Manages the high availability settings of a Cloud Connector master instance. The provider must be configured with the master instance.
+
+On destroy, high availability is disabled and the allowed shadow host is removed.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/install-failover-instance-for-high-availability>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
+			"ha_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether a shadow instance is allowed to connect to this master instance.",
+				Required:            true,
+			},
+			"allowed_shadow_host": schema.StringAttribute{
+				MarkdownDescription: "The host name of the shadow instance that is allowed to connect to this master instance. If not set, any shadow instance presenting valid credentials may connect.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the high availability master settings resource. Used for import and identity purposes. The value is always `ha-master-settings`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (rs *HAMasterSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *HAMasterSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = client
+}
+
+func (r *HAMasterSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.createOrUpdate(ctx, req.Plan, &resp.Diagnostics, &resp.State, &resp.Identity)
+}
+
+func (r *HAMasterSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state model.HAMasterSettingsResourceConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	respObj, err := r.Client.HighAvailability().MasterConfiguration(ctx)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	responseModel, diags := model.HAMasterSettingsResourceValueFrom(ctx, respObj)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseModel.Instance = state.Instance
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identity := haMasterSettingsResourceIdentityModel{
+		ID: types.StringValue(model.HAMasterSettingsID),
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HAMasterSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.createOrUpdate(ctx, req.Plan, &resp.Diagnostics, &resp.State, &resp.Identity)
+}
+
+func (r *HAMasterSettingsResource) createOrUpdate(ctx context.Context, requestPlan tfsdk.Plan, responseDiagnostics *diag.Diagnostics, responseState *tfsdk.State, responseIdentity **tfsdk.ResourceIdentity) {
+	var plan model.HAMasterSettingsResourceConfig
+	diags := requestPlan.Get(ctx, &plan)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
+	planBody := apiobjects.HAMasterConfiguration{
+		HAEnabled:         plan.HAEnabled.ValueBool(),
+		AllowedShadowHost: plan.AllowedShadowHost.ValueString(),
+	}
+
+	if err := r.Client.HighAvailability().UpdateMasterConfiguration(ctx, planBody); err != nil {
+		responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	respObj, err := r.Client.HighAvailability().MasterConfiguration(ctx)
+	if err != nil {
+		responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	responseModel, diags := model.HAMasterSettingsResourceValueFrom(ctx, respObj)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	responseModel.Instance = plan.Instance
+
+	diags = responseState.Set(ctx, responseModel)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	identity := haMasterSettingsResourceIdentityModel{
+		ID: types.StringValue(model.HAMasterSettingsID),
+	}
+
+	diags = (*responseIdentity).Set(ctx, identity)
+	responseDiagnostics.Append(diags...)
+}
+
+func (r *HAMasterSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state model.HAMasterSettingsResourceConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	// An empty configuration disables high availability and drops the allowed shadow host.
+	if err := r.Client.HighAvailability().UpdateMasterConfiguration(ctx, apiobjects.HAMasterConfiguration{}); err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (rs *HAMasterSettingsResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	req.ID = helpers.ImportInstanceID(ctx, rs.Client, req.ID, resp)
+
+	if req.ID != model.HAMasterSettingsID {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf(
+				"Expected import identifier %q. Got: %q",
+				model.HAMasterSettingsID,
+				req.ID,
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("id"), model.HAMasterSettingsID)...,
+	)
+}
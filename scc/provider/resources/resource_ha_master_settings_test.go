@@ -0,0 +1,166 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResourceHAMasterSettings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			CheckDestroy: func(_ *terraform.State) error {
+				var err error
+				srv.Update(func(state *sccmock.State) {
+					if state.HAMaster.HAEnabled || state.HAMaster.AllowedShadowHost != "" {
+						err = fmt.Errorf("high availability still configured: %+v", state.HAMaster)
+					}
+				})
+				return err
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceHAMasterSettings("scc_ha", true, "shadow.example.com"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_ha_master_settings.scc_ha", "ha_enabled", "true"),
+						resource.TestCheckResourceAttr("scc_ha_master_settings.scc_ha", "allowed_shadow_host", "shadow.example.com"),
+						resource.TestCheckResourceAttr("scc_ha_master_settings.scc_ha", "id", "ha-master-settings"),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity(
+							"scc_ha_master_settings.scc_ha",
+							map[string]knownvalue.Check{
+								"id": knownvalue.StringExact("ha-master-settings"),
+							},
+						),
+					},
+				},
+				{
+					ResourceName:                         "scc_ha_master_settings.scc_ha",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "ha-master-settings",
+					ImportStateVerifyIdentifierAttribute: "id",
+				},
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceHAMasterSettingsWoShadowHost("scc_ha", false),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_ha_master_settings.scc_ha", "ha_enabled", "false"),
+						resource.TestCheckNoResourceAttr("scc_ha_master_settings.scc_ha", "allowed_shadow_host"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - drift is reverted", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceHAMasterSettings("scc_ha", true, "shadow.example.com"),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.HAMaster.AllowedShadowHost = "other.example.com"
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceHAMasterSettings("scc_ha", true, "shadow.example.com"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_ha_master_settings.scc_ha", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_ha_master_settings.scc_ha", "allowed_shadow_host", "shadow.example.com"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - ha_enabled mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					resource "scc_ha_master_settings" "scc_ha" {
+						allowed_shadow_host = "shadow.example.com"
+					}
+					`,
+					ExpectError: regexp.MustCompile(`The argument "ha_enabled" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - empty allowed shadow host", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceHAMasterSettings("scc_ha", true, ""),
+					ExpectError: regexp.MustCompile(`Attribute allowed_shadow_host string length must be at least 1`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid import id", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceHAMasterSettings("scc_ha", true, "shadow.example.com"),
+				},
+				{
+					ResourceName:  "scc_ha_master_settings.scc_ha",
+					ImportState:   true,
+					ImportStateId: "invalid",
+					ExpectError:   regexp.MustCompile(`Expected import identifier`),
+				},
+			},
+		})
+	})
+}
+
+func ResourceHAMasterSettings(resourceName string, haEnabled bool, allowedShadowHost string) string {
+	return fmt.Sprintf(`
+	resource "scc_ha_master_settings" "%s" {
+		ha_enabled = %t
+		allowed_shadow_host = "%s"
+	}
+	`, resourceName, haEnabled, allowedShadowHost)
+}
+
+func ResourceHAMasterSettingsWoShadowHost(resourceName string, haEnabled bool) string {
+	return fmt.Sprintf(`
+	resource "scc_ha_master_settings" "%s" {
+		ha_enabled = %t
+	}
+	`, resourceName, haEnabled)
+}
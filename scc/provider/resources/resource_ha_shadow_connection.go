@@ -0,0 +1,404 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &HAShadowConnectionResource{}
+
+// haShadowStateDisconnected is the state reported by a shadow instance that is
+// not connected to its master instance.
+const haShadowStateDisconnected = "disconnected"
+
+func NewHAShadowConnectionResource() resource.Resource {
+	return &HAShadowConnectionResource{}
+}
+
+type HAShadowConnectionResource struct {
+	Client *api.RestApiClient
+}
+
+type haShadowConnectionResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *HAShadowConnectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ha_shadow_connection"
+}
+
+func (r *HAShadowConnectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector High Availability Shadow Connection Resource.
+
+Configures a Cloud Connector shadow instance and connects it to its master instance. The provider must be configured with the shadow instance, and high availability must be enabled on the master instance, see ` + "`scc_ha_master_settings`" + `.
+
+On destroy, the shadow instance is disconnected from the master instance. If the shadow instance is disconnected outside of Terraform, the next apply connects it again.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/install-failover-instance-for-high-availability>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
+			"master_host": schema.StringAttribute{
+				MarkdownDescription: "Host name of the master instance.",
+				Required:            true,
+			},
+			"master_port": schema.Int64Attribute{
+				MarkdownDescription: "Port of the master instance. Defaults to `8443`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(8443),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"own_host": schema.StringAttribute{
+				MarkdownDescription: "Host name of this shadow instance as seen by the master instance. Must match the allowed shadow host if one is configured on the master instance.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"check_interval": schema.Int64Attribute{
+				MarkdownDescription: "Interval in seconds in which the shadow instance checks whether the master instance is alive.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"takeover_delay": schema.Int64Attribute{
+				MarkdownDescription: "Time in seconds the shadow instance waits after the master instance became unreachable before it takes over the master role.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"connect_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in milliseconds for establishing the connection to the master instance.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in milliseconds for requests sent to the master instance.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "User of the master instance used to establish the connection. The user must be assigned to the Administrator role on the master instance.",
+				Required:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the master instance user.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "State of the connection between the shadow and the master instance as reported by the shadow instance.",
+				Computed:            true,
+			},
+			"state_message": schema.StringAttribute{
+				MarkdownDescription: "Additional information about the connection state, e.g. the reason of a connection failure.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the high availability shadow connection resource. Used for import and identity purposes. The value is always `ha-shadow-connection`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (rs *HAShadowConnectionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *HAShadowConnectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = client
+}
+
+func (r *HAShadowConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan model.HAShadowConnectionResourceConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
+	diags = r.updateConfiguration(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.connect(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseModel, diags := r.read(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identity := haShadowConnectionResourceIdentityModel{
+		ID: types.StringValue(model.HAShadowConnectionID),
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HAShadowConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state model.HAShadowConnectionResourceConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	responseModel, diags := r.read(ctx, state)
+	if api.IsNotFound(api.DiagnosticsError(diags)) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A shadow instance disconnected outside of Terraform is planned for
+	// creation again, which connects it to the master instance.
+	if responseModel.State.ValueString() == haShadowStateDisconnected {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identity := haShadowConnectionResourceIdentityModel{
+		ID: types.StringValue(model.HAShadowConnectionID),
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HAShadowConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state model.HAShadowConnectionResourceConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
+	// The shadow instance only picks up a new master or new credentials when it connects,
+	// so an established connection is dropped first.
+	reconnect := !plan.MasterHost.Equal(state.MasterHost) ||
+		!plan.MasterPort.Equal(state.MasterPort) ||
+		!plan.OwnHost.Equal(state.OwnHost) ||
+		!plan.Username.Equal(state.Username) ||
+		!plan.Password.Equal(state.Password)
+
+	if reconnect {
+		diags = r.disconnect(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = r.updateConfiguration(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if reconnect {
+		diags = r.connect(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	responseModel, diags := r.read(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identity := haShadowConnectionResourceIdentityModel{
+		ID: types.StringValue(model.HAShadowConnectionID),
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HAShadowConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state model.HAShadowConnectionResourceConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	diags = r.disconnect(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (rs *HAShadowConnectionResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	req.ID = helpers.ImportInstanceID(ctx, rs.Client, req.ID, resp)
+
+	if req.ID != model.HAShadowConnectionID {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf(
+				"Expected import identifier %q. Got: %q",
+				model.HAShadowConnectionID,
+				req.ID,
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("id"), model.HAShadowConnectionID)...,
+	)
+}
+
+func (r *HAShadowConnectionResource) updateConfiguration(ctx context.Context, plan model.HAShadowConnectionResourceConfig) diag.Diagnostics {
+	planBody := apiobjects.HAShadowConfiguration{
+		MasterHost:             plan.MasterHost.ValueString(),
+		MasterPort:             plan.MasterPort.ValueInt64(),
+		OwnHost:                plan.OwnHost.ValueString(),
+		CheckIntervalInSeconds: plan.CheckInterval.ValueInt64(),
+		TakeoverDelayInSeconds: plan.TakeoverDelay.ValueInt64(),
+		ConnectTimeoutInMillis: plan.ConnectTimeout.ValueInt64(),
+		RequestTimeoutInMillis: plan.RequestTimeout.ValueInt64(),
+	}
+
+	return api.ErrorDiagnostics(r.Client.HighAvailability().UpdateShadowConfiguration(ctx, planBody))
+}
+
+func (r *HAShadowConnectionResource) connect(ctx context.Context, plan model.HAShadowConnectionResourceConfig) diag.Diagnostics {
+	return api.ErrorDiagnostics(r.Client.HighAvailability().Connect(ctx, plan.Username.ValueString(), plan.Password.ValueString()))
+}
+
+func (r *HAShadowConnectionResource) disconnect(ctx context.Context) diag.Diagnostics {
+	return api.ErrorDiagnostics(r.Client.HighAvailability().Disconnect(ctx))
+}
+
+func (r *HAShadowConnectionResource) read(ctx context.Context, plan model.HAShadowConnectionResourceConfig) (model.HAShadowConnectionResourceConfig, diag.Diagnostics) {
+	config, err := r.Client.HighAvailability().ShadowConfiguration(ctx)
+	if err != nil {
+		return model.HAShadowConnectionResourceConfig{}, api.ErrorDiagnostics(err)
+	}
+
+	state, err := r.Client.HighAvailability().ShadowState(ctx)
+	if err != nil {
+		return model.HAShadowConnectionResourceConfig{}, api.ErrorDiagnostics(err)
+	}
+
+	return model.HAShadowConnectionResourceValueFrom(ctx, plan, config, state)
+}
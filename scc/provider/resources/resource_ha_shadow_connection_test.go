@@ -0,0 +1,218 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResourceHAShadowConnection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			CheckDestroy: func(_ *terraform.State) error {
+				var err error
+				srv.Update(func(state *sccmock.State) {
+					if state.HAShadowConnected {
+						err = fmt.Errorf("shadow instance still connected to %s", state.HAShadow.MasterHost)
+					}
+				})
+				return err
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceHAShadowConnection("scc_ha", "master.example.com", sccmock.Username, sccmock.Password),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_ha_shadow_connection.scc_ha", "master_host", "master.example.com"),
+						resource.TestCheckResourceAttr("scc_ha_shadow_connection.scc_ha", "master_port", "8443"),
+						resource.TestCheckNoResourceAttr("scc_ha_shadow_connection.scc_ha", "own_host"),
+						resource.TestCheckResourceAttr("scc_ha_shadow_connection.scc_ha", "check_interval", "5"),
+						resource.TestCheckResourceAttr("scc_ha_shadow_connection.scc_ha", "takeover_delay", "30"),
+						resource.TestCheckResourceAttr("scc_ha_shadow_connection.scc_ha", "connect_timeout", "15000"),
+						resource.TestCheckResourceAttr("scc_ha_shadow_connection.scc_ha", "request_timeout", "60000"),
+						resource.TestCheckResourceAttr("scc_ha_shadow_connection.scc_ha", "state", sccmock.HAShadowConnected),
+						resource.TestCheckResourceAttr("scc_ha_shadow_connection.scc_ha", "id", "ha-shadow-connection"),
+						checkHAShadowConnects(srv, 1),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity(
+							"scc_ha_shadow_connection.scc_ha",
+							map[string]knownvalue.Check{
+								"id": knownvalue.StringExact("ha-shadow-connection"),
+							},
+						),
+					},
+				},
+				{
+					ResourceName:                         "scc_ha_shadow_connection.scc_ha",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "ha-shadow-connection",
+					ImportStateVerifyIdentifierAttribute: "id",
+					ImportStateVerifyIgnore: []string{
+						"username",
+						"password",
+					},
+				},
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceHAShadowConnectionWithTimings("scc_ha", "master.example.com", sccmock.Username, sccmock.Password, 10, 60),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_ha_shadow_connection.scc_ha", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_ha_shadow_connection.scc_ha", "check_interval", "10"),
+						resource.TestCheckResourceAttr("scc_ha_shadow_connection.scc_ha", "takeover_delay", "60"),
+						resource.TestCheckResourceAttr("scc_ha_shadow_connection.scc_ha", "state", sccmock.HAShadowConnected),
+						// Changed timings are applied without dropping the connection.
+						checkHAShadowConnects(srv, 1),
+					),
+				},
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceHAShadowConnectionWithTimings("scc_ha", "new-master.example.com", sccmock.Username, sccmock.Password, 10, 60),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_ha_shadow_connection.scc_ha", "master_host", "new-master.example.com"),
+						resource.TestCheckResourceAttr("scc_ha_shadow_connection.scc_ha", "state", sccmock.HAShadowConnected),
+						// A new master instance requires a new connection.
+						checkHAShadowConnects(srv, 2),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - disconnect outside of terraform is restored", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceHAShadowConnection("scc_ha", "master.example.com", sccmock.Username, sccmock.Password),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.HAShadowConnected = false
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceHAShadowConnection("scc_ha", "master.example.com", sccmock.Username, sccmock.Password),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_ha_shadow_connection.scc_ha", plancheck.ResourceActionCreate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_ha_shadow_connection.scc_ha", "state", sccmock.HAShadowConnected),
+						checkHAShadowConnects(srv, 2),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid credentials", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config:      tfutils.ProviderConfig(user) + ResourceHAShadowConnection("scc_ha", "master.example.com", sccmock.Username, "wrong"),
+					ExpectError: regexp.MustCompile(`invalid credentials`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - master_host mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					resource "scc_ha_shadow_connection" "scc_ha" {
+						username = "Administrator"
+						password = "manage"
+					}
+					`,
+					ExpectError: regexp.MustCompile(`The argument "master_host" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid import id", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceHAShadowConnection("scc_ha", "master.example.com", sccmock.Username, sccmock.Password),
+				},
+				{
+					ResourceName:  "scc_ha_shadow_connection.scc_ha",
+					ImportState:   true,
+					ImportStateId: "invalid",
+					ExpectError:   regexp.MustCompile(`Expected import identifier`),
+				},
+			},
+		})
+	})
+}
+
+// checkHAShadowConnects verifies how often the shadow instance connected to
+// its master instance.
+func checkHAShadowConnects(srv *sccmock.Server, expected int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		var connects int
+		srv.Update(func(state *sccmock.State) {
+			connects = state.HAShadowConnects
+		})
+		if connects != expected {
+			return fmt.Errorf("expected %d connects to the master instance, got %d", expected, connects)
+		}
+		return nil
+	}
+}
+
+func ResourceHAShadowConnection(resourceName string, masterHost string, username string, password string) string {
+	return fmt.Sprintf(`
+	resource "scc_ha_shadow_connection" "%s" {
+		master_host = "%s"
+		username = "%s"
+		password = "%s"
+	}
+	`, resourceName, masterHost, username, password)
+}
+
+func ResourceHAShadowConnectionWithTimings(resourceName string, masterHost string, username string, password string, checkInterval int, takeoverDelay int) string {
+	return fmt.Sprintf(`
+	resource "scc_ha_shadow_connection" "%s" {
+		master_host = "%s"
+		username = "%s"
+		password = "%s"
+		check_interval = %d
+		takeover_delay = %d
+	}
+	`, resourceName, masterHost, username, password, checkInterval, takeoverDelay)
+}
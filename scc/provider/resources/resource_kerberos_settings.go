@@ -0,0 +1,303 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/SAP/terraform-provider-scc/validation/kerberosSettings"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &KerberosSettingsResource{}
+
+func NewKerberosSettingsResource() resource.Resource {
+	return &KerberosSettingsResource{}
+}
+
+type KerberosSettingsResource struct {
+	Client *api.RestApiClient
+}
+
+type kerberosSettingsResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *KerberosSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kerberos_settings"
+}
+
+func (r *KerberosSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Kerberos Settings Resource.
+
+Configures the key distribution centers (KDC) and the service user the Cloud Connector uses for Kerberos principal propagation. System mappings with the ` + "`authentication_mode`" + ` *KERBEROS* in ` + "`scc_system_mapping`" + ` require this configuration.
+
+The keytab of the service user cannot be read from the Cloud Connector, so it is neither stored in the Terraform state nor compared with the actual keytab. To replace the keytab, set a new ` + "`keytab_wo`" + ` and increase ` + "`keytab_wo_version`" + `.
+
+On destroy, the Kerberos configuration is removed, which disables Kerberos principal propagation.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+* Write-only attributes require Terraform 1.11 or later.
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/configure-kerberos>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
+			"realm": schema.StringAttribute{
+				MarkdownDescription: "The Kerberos realm of the service user, e.g. `EXAMPLE.COM`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Z0-9]([A-Z0-9.-]*[A-Z0-9])?$`), "must be an upper-case realm name, e.g. EXAMPLE.COM"),
+				},
+			},
+			"kdc_hosts": schema.ListAttribute{
+				MarkdownDescription: "The key distribution centers of the realm in the format `host[:port]`. Hosts without port use the default port `88`.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(kerberosSettings.ValidateHost()),
+				},
+			},
+			"service_user": schema.StringAttribute{
+				MarkdownDescription: "The name of the service user the keytab belongs to, e.g. `scc-service`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"keytab_wo": schema.StringAttribute{
+				MarkdownDescription: "The Base64-encoded keytab of the service user, e.g. `filebase64(\"scc.keytab\")`. It is set when the resource is created and whenever `keytab_wo_version` changes. This value is write-only and never stored in the Terraform state.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					kerberosSettings.ValidateKeytab(),
+				},
+			},
+			"keytab_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of `keytab_wo`. Changes of write-only attributes are not detected, so increase this value to replace the keytab.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Kerberos settings resource. Used for import and identity purposes. The value is always `kerberos-settings`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (rs *KerberosSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *KerberosSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = client
+}
+
+func (r *KerberosSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan model.KerberosSettingsConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keytab types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("keytab_wo"), &keytab)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.createOrUpdate(ctx, plan, keytab.ValueString(), &resp.Diagnostics, &resp.State, &resp.Identity)
+}
+
+func (r *KerberosSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state model.KerberosSettingsConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	respObj, err := r.Client.Kerberos().Get(ctx)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	// The Kerberos configuration has been removed outside of Terraform.
+	if !model.KerberosConfigured(respObj) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	responseModel, diags := model.KerberosSettingsResourceValueFrom(ctx, state, respObj)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identity := kerberosSettingsResourceIdentityModel{
+		ID: types.StringValue(model.KerberosSettingsID),
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *KerberosSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state model.KerberosSettingsConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without a new version, the Cloud Connector keeps the stored keytab.
+	var keytab types.String
+	if !plan.KeytabVersion.Equal(state.KeytabVersion) {
+		diags = req.Config.GetAttribute(ctx, path.Root("keytab_wo"), &keytab)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	r.createOrUpdate(ctx, plan, keytab.ValueString(), &resp.Diagnostics, &resp.State, &resp.Identity)
+}
+
+func (r *KerberosSettingsResource) createOrUpdate(ctx context.Context, plan model.KerberosSettingsConfig, keytab string, responseDiagnostics *diag.Diagnostics, responseState *tfsdk.State, responseIdentity **tfsdk.ResourceIdentity) {
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
+	planBody, diags := model.KerberosSettingsRequest(ctx, plan, keytab)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	if err := r.Client.Kerberos().Update(ctx, planBody); err != nil {
+		responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	respObj, err := r.Client.Kerberos().Get(ctx)
+	if err != nil {
+		responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	responseModel, diags := model.KerberosSettingsResourceValueFrom(ctx, plan, respObj)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	diags = responseState.Set(ctx, responseModel)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	identity := kerberosSettingsResourceIdentityModel{
+		ID: types.StringValue(model.KerberosSettingsID),
+	}
+
+	diags = (*responseIdentity).Set(ctx, identity)
+	responseDiagnostics.Append(diags...)
+}
+
+func (r *KerberosSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state model.KerberosSettingsConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	err := r.Client.Kerberos().Delete(ctx)
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (rs *KerberosSettingsResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	req.ID = helpers.ImportInstanceID(ctx, rs.Client, req.ID, resp)
+
+	if req.ID != model.KerberosSettingsID {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf(
+				"Expected import identifier %q. Got: %q",
+				model.KerberosSettingsID,
+				req.ID,
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("id"), model.KerberosSettingsID)...,
+	)
+}
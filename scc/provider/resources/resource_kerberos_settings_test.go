@@ -0,0 +1,250 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	testKeytab    = "BQIAAAA3AAEAC0VYQU1QTEUuQ09N"
+	testNewKeytab = "BQIAAAA3AAIAC0VYQU1QTEUuQ09N"
+)
+
+func TestResourceKerberosSettings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			CheckDestroy: func(_ *terraform.State) error {
+				var err error
+				srv.Update(func(state *sccmock.State) {
+					if state.Kerberos != nil {
+						err = fmt.Errorf("kerberos still configured for realm %s", state.Kerberos.Realm)
+					}
+				})
+				return err
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceKerberosSettings("scc_ks", "EXAMPLE.COM", `["kdc1.example.com:88", "kdc2.example.com:750"]`, "scc-service", testKeytab, 1),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_kerberos_settings.scc_ks", "realm", "EXAMPLE.COM"),
+						resource.TestCheckResourceAttr("scc_kerberos_settings.scc_ks", "kdc_hosts.#", "2"),
+						resource.TestCheckResourceAttr("scc_kerberos_settings.scc_ks", "kdc_hosts.0", "kdc1.example.com:88"),
+						resource.TestCheckResourceAttr("scc_kerberos_settings.scc_ks", "kdc_hosts.1", "kdc2.example.com:750"),
+						resource.TestCheckResourceAttr("scc_kerberos_settings.scc_ks", "service_user", "scc-service"),
+						resource.TestCheckNoResourceAttr("scc_kerberos_settings.scc_ks", "keytab_wo"),
+						resource.TestCheckResourceAttr("scc_kerberos_settings.scc_ks", "keytab_wo_version", "1"),
+						resource.TestCheckResourceAttr("scc_kerberos_settings.scc_ks", "id", "kerberos-settings"),
+						checkKerberosSettings(srv, []apiobjects.KerberosKDC{{Host: "kdc1.example.com", Port: 88}, {Host: "kdc2.example.com", Port: 750}}, testKeytab),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity(
+							"scc_kerberos_settings.scc_ks",
+							map[string]knownvalue.Check{
+								"id": knownvalue.StringExact("kerberos-settings"),
+							},
+						),
+					},
+				},
+				{
+					ResourceName:                         "scc_kerberos_settings.scc_ks",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "kerberos-settings",
+					ImportStateVerifyIdentifierAttribute: "id",
+					ImportStateVerifyIgnore: []string{
+						"keytab_wo_version",
+					},
+				},
+				// Without a new keytab_wo_version, the stored keytab is kept. Hosts
+				// without port keep the format of the configuration.
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceKerberosSettings("scc_ks", "EXAMPLE.COM", `["kdc1.example.com"]`, "scc-service", testNewKeytab, 1),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_kerberos_settings.scc_ks", "kdc_hosts.#", "1"),
+						resource.TestCheckResourceAttr("scc_kerberos_settings.scc_ks", "kdc_hosts.0", "kdc1.example.com"),
+						checkKerberosSettings(srv, []apiobjects.KerberosKDC{{Host: "kdc1.example.com", Port: 88}}, testKeytab),
+					),
+				},
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceKerberosSettings("scc_ks", "EXAMPLE.COM", `["kdc1.example.com"]`, "scc-service", testNewKeytab, 2),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_kerberos_settings.scc_ks", "keytab_wo_version", "2"),
+						checkKerberosSettings(srv, []apiobjects.KerberosKDC{{Host: "kdc1.example.com", Port: 88}}, testNewKeytab),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - Cloud Connector before 2.18.0", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+		srv.Update(func(state *sccmock.State) {
+			state.Version = "2.17.0"
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceKerberosSettings("scc_ks", "EXAMPLE.COM", `["kdc1.example.com"]`, "scc-service", testKeytab, 1),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_kerberos_settings.scc_ks", "realm", "EXAMPLE.COM"),
+						checkKerberosSettings(srv, []apiobjects.KerberosKDC{{Host: "kdc1.example.com", Port: 88}}, testKeytab),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - drift is reverted", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceKerberosSettings("scc_ks", "EXAMPLE.COM", `["kdc1.example.com"]`, "scc-service", testKeytab, 1),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.Kerberos.ServiceUser = "other-service"
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceKerberosSettings("scc_ks", "EXAMPLE.COM", `["kdc1.example.com"]`, "scc-service", testKeytab, 1),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_kerberos_settings.scc_ks", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_kerberos_settings.scc_ks", "service_user", "scc-service"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - removed outside of terraform is recreated", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceKerberosSettings("scc_ks", "EXAMPLE.COM", `["kdc1.example.com"]`, "scc-service", testKeytab, 1),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.Kerberos = nil
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceKerberosSettings("scc_ks", "EXAMPLE.COM", `["kdc1.example.com"]`, "scc-service", testKeytab, 1),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_kerberos_settings.scc_ks", plancheck.ResourceActionCreate),
+						},
+					},
+					Check: checkKerberosSettings(srv, []apiobjects.KerberosKDC{{Host: "kdc1.example.com", Port: 88}}, testKeytab),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid realm", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceKerberosSettings("scc_ks", "example.com", `["kdc1.example.com"]`, "scc-service", testKeytab, 1),
+					ExpectError: regexp.MustCompile(`must be an upper-case realm name`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid keytab", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceKerberosSettings("scc_ks", "EXAMPLE.COM", `["kdc1.example.com"]`, "scc-service", "not a keytab", 1),
+					ExpectError: regexp.MustCompile(`Invalid Keytab`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid import id", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceKerberosSettings("scc_ks", "EXAMPLE.COM", `["kdc1.example.com"]`, "scc-service", testKeytab, 1),
+				},
+				{
+					ResourceName:  "scc_kerberos_settings.scc_ks",
+					ImportState:   true,
+					ImportStateId: "invalid",
+					ExpectError:   regexp.MustCompile(`Expected import identifier`),
+				},
+			},
+		})
+	})
+}
+
+// checkKerberosSettings verifies the KDCs and the keytab stored by the fake
+// Cloud Connector, as the keytab is never read back.
+func checkKerberosSettings(srv *sccmock.Server, kdcs []apiobjects.KerberosKDC, keytab string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		var err error
+		srv.Update(func(state *sccmock.State) {
+			switch {
+			case state.Kerberos == nil:
+				err = fmt.Errorf("kerberos not configured")
+			case fmt.Sprint(state.Kerberos.KDCs) != fmt.Sprint(kdcs):
+				err = fmt.Errorf("expected the KDCs %v, got %v", kdcs, state.Kerberos.KDCs)
+			case state.Kerberos.Keytab != keytab:
+				err = fmt.Errorf("expected the keytab %s, got %s", keytab, state.Kerberos.Keytab)
+			}
+		})
+		return err
+	}
+}
+
+func ResourceKerberosSettings(resourceName string, realm string, kdcHosts string, serviceUser string, keytab string, keytabVersion int) string {
+	return fmt.Sprintf(`
+	resource "scc_kerberos_settings" "%s" {
+		realm = "%s"
+		kdc_hosts = %s
+		service_user = "%s"
+		keytab_wo = "%s"
+		keytab_wo_version = %d
+	}
+	`, resourceName, realm, kdcHosts, serviceUser, keytab, keytabVersion)
+}
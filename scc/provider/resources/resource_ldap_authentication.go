@@ -0,0 +1,341 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/SAP/terraform-provider-scc/validation/ldapAuthentication"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &LDAPAuthenticationResource{}
+
+func NewLDAPAuthenticationResource() resource.Resource {
+	return &LDAPAuthenticationResource{}
+}
+
+type LDAPAuthenticationResource struct {
+	Client *api.RestApiClient
+}
+
+type ldapAuthenticationResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *LDAPAuthenticationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_authentication"
+}
+
+func (r *LDAPAuthenticationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector LDAP Authentication Resource.
+
+Manages the LDAP user store the Cloud Connector can authenticate administrators against, and the mapping of LDAP groups to the roles of the Cloud Connector.
+
+Saving the configuration does not change how administrators log on. Use the ` + "`scc_switch_to_ldap_authentication`" + ` action to switch the Cloud Connector from its local user store to LDAP. Afterwards, the provider must authenticate with the credentials of an LDAP user.
+
+On destroy, the LDAP configuration is removed.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/authentication-and-ui-settings>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
+			"hosts": schema.ListAttribute{
+				MarkdownDescription: "The URLs of the LDAP servers, e.g. `ldaps://ldap.example.com:636`. The servers are tried in the given order. URLs without port use the default port of their scheme, `389` for `ldap` and `636` for `ldaps`.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(ldapAuthentication.ValidateURL()),
+				},
+			},
+			"secure": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Cloud Connector connects to the LDAP servers with secure LDAP (LDAPS). If `true`, all `hosts` must use the `ldaps` scheme, otherwise the `ldap` scheme. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The distinguished name of the technical user the Cloud Connector binds to the LDAP servers with. If not set, the Cloud Connector binds anonymously.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of the technical user.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("user")),
+				},
+			},
+			"user_search_base": schema.StringAttribute{
+				MarkdownDescription: "The distinguished name of the entry below which users are searched, e.g. `ou=users,dc=example,dc=com`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"user_search_filter": schema.StringAttribute{
+				MarkdownDescription: "The LDAP filter users are searched with. `{0}` is replaced by the user name entered at logon, e.g. `(uid={0})`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_search_base": schema.StringAttribute{
+				MarkdownDescription: "The distinguished name of the entry below which the groups of a user are searched, e.g. `ou=groups,dc=example,dc=com`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"group_search_filter": schema.StringAttribute{
+				MarkdownDescription: "The LDAP filter the groups of a user are searched with. `{0}` is replaced by the distinguished name of the user, e.g. `(member={0})`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_name_attribute": schema.StringAttribute{
+				MarkdownDescription: "The attribute of a group entry that holds the name of the group, e.g. `cn`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_mapping": schema.MapAttribute{
+				MarkdownDescription: "The LDAP groups whose members are granted the roles of the Cloud Connector, keyed by role. If not set, the Cloud Connector uses its default groups. Possible keys are: \n" +
+					helpers.GetFormattedValueAsTableRow("key", "role") +
+					helpers.GetFormattedValueAsTableRow("---", "---") +
+					helpers.GetFormattedValueAsTableRow("`administrator`", "Administrator") +
+					helpers.GetFormattedValueAsTableRow("`subaccount_administrator`", "Subaccount Administrator") +
+					helpers.GetFormattedValueAsTableRow("`display`", "Display") +
+					helpers.GetFormattedValueAsTableRow("`support`", "Support") +
+					helpers.GetFormattedValueAsTableRow("`monitoring`", "Monitoring"),
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(model.Roles...)),
+					mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Cloud Connector authenticates administrators against LDAP.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the LDAP authentication resource. Used for import and identity purposes. The value is always `ldap-authentication`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (rs *LDAPAuthenticationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *LDAPAuthenticationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = client
+}
+
+func (r *LDAPAuthenticationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.createOrUpdate(ctx, req.Plan, &resp.Diagnostics, &resp.State, &resp.Identity)
+}
+
+func (r *LDAPAuthenticationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state model.LDAPAuthenticationConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	respObj, err := r.Client.Authentication().LDAP(ctx)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	authenticationType, err := r.Client.Authentication().Type(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	responseModel, diags := model.LDAPAuthenticationResourceValueFrom(ctx, state, respObj, authenticationType)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identity := ldapAuthenticationResourceIdentityModel{
+		ID: types.StringValue(model.LDAPAuthenticationID),
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *LDAPAuthenticationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.createOrUpdate(ctx, req.Plan, &resp.Diagnostics, &resp.State, &resp.Identity)
+}
+
+func (r *LDAPAuthenticationResource) createOrUpdate(ctx context.Context, requestPlan tfsdk.Plan, responseDiagnostics *diag.Diagnostics, responseState *tfsdk.State, responseIdentity **tfsdk.ResourceIdentity) {
+	var plan model.LDAPAuthenticationConfig
+	diags := requestPlan.Get(ctx, &plan)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
+	planBody, diags := model.LDAPAuthenticationRequest(ctx, plan)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	if err := r.Client.Authentication().UpdateLDAP(ctx, planBody); err != nil {
+		responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	respObj, err := r.Client.Authentication().LDAP(ctx)
+	if err != nil {
+		responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	authenticationType, err := r.Client.Authentication().Type(ctx)
+	if err != nil {
+		responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	responseModel, diags := model.LDAPAuthenticationResourceValueFrom(ctx, plan, respObj, authenticationType)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	diags = responseState.Set(ctx, responseModel)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	identity := ldapAuthenticationResourceIdentityModel{
+		ID: types.StringValue(model.LDAPAuthenticationID),
+	}
+
+	diags = (*responseIdentity).Set(ctx, identity)
+	responseDiagnostics.Append(diags...)
+}
+
+func (r *LDAPAuthenticationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state model.LDAPAuthenticationConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	err := r.Client.Authentication().DeleteLDAP(ctx)
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (rs *LDAPAuthenticationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	req.ID = helpers.ImportInstanceID(ctx, rs.Client, req.ID, resp)
+
+	if req.ID != model.LDAPAuthenticationID {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf(
+				"Expected import identifier %q. Got: %q",
+				model.LDAPAuthenticationID,
+				req.ID,
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("id"), model.LDAPAuthenticationID)...,
+	)
+}
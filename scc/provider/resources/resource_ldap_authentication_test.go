@@ -0,0 +1,310 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResourceLDAPAuthentication(t *testing.T) {
+	userSearchBase := "ou=users,dc=example,dc=com"
+	groupSearchBase := "ou=groups,dc=example,dc=com"
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			CheckDestroy: func(_ *terraform.State) error {
+				var err error
+				srv.Update(func(state *sccmock.State) {
+					if state.LDAP != nil {
+						err = fmt.Errorf("LDAP authentication still configured for %v", state.LDAP.Hosts)
+					}
+				})
+				return err
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceLDAPAuthentication("scc_ldap", `["ldaps://ldap1.example.com:636", "ldaps://ldap2.example.com:1636"]`, true, userSearchBase, groupSearchBase),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "hosts.#", "2"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "hosts.0", "ldaps://ldap1.example.com:636"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "hosts.1", "ldaps://ldap2.example.com:1636"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "secure", "true"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "user", "cn=scc,dc=example,dc=com"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "user_search_base", userSearchBase),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "user_search_filter", "(uid={0})"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "group_search_base", groupSearchBase),
+						resource.TestCheckNoResourceAttr("scc_ldap_authentication.scc_ldap", "group_search_filter"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "role_mapping.%", "2"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "role_mapping.administrator", "scc-admins"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "role_mapping.display", "scc-viewers"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "active", "false"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "id", "ldap-authentication"),
+						func(_ *terraform.State) error {
+							var err error
+							srv.Update(func(state *sccmock.State) {
+								switch {
+								case state.LDAP.Password != "secret":
+									err = fmt.Errorf("expected the password of the technical user to be sent")
+								case state.LDAP.CustomAdminRole != "scc-admins" || state.LDAP.CustomDisplayRole != "scc-viewers" || state.LDAP.CustomSupportRole != "":
+									err = fmt.Errorf("unexpected role mapping %+v", *state.LDAP)
+								}
+							})
+							return err
+						},
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity(
+							"scc_ldap_authentication.scc_ldap",
+							map[string]knownvalue.Check{
+								"id": knownvalue.StringExact("ldap-authentication"),
+							},
+						),
+					},
+				},
+				{
+					ResourceName:                         "scc_ldap_authentication.scc_ldap",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "ldap-authentication",
+					ImportStateVerifyIdentifierAttribute: "id",
+					ImportStateVerifyIgnore: []string{
+						"password",
+					},
+				},
+				// Hosts without port keep the format of the configuration.
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceLDAPAuthenticationWoSecure("scc_ldap", `["ldap://ldap.example.com"]`, userSearchBase, groupSearchBase),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "hosts.#", "1"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "hosts.0", "ldap://ldap.example.com"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "secure", "false"),
+						func(_ *terraform.State) error {
+							var err error
+							srv.Update(func(state *sccmock.State) {
+								expected := []apiobjects.LDAPHost{{Host: "ldap.example.com", Port: 389}}
+								if fmt.Sprint(state.LDAP.Hosts) != fmt.Sprint(expected) {
+									err = fmt.Errorf("expected the LDAP hosts %v, got %v", expected, state.LDAP.Hosts)
+								}
+							})
+							return err
+						},
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - IPv6 host", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceLDAPAuthenticationWoSecure("scc_ldap", `["ldap://[::1]:389"]`, userSearchBase, groupSearchBase),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "hosts.0", "ldap://[::1]:389"),
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "secure", "false"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - switch to LDAP outside of terraform is detected", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceLDAPAuthenticationWoSecure("scc_ldap", `["ldap://ldap.example.com"]`, userSearchBase, groupSearchBase),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.AuthenticationType = apiobjects.AuthenticationTypeLDAP
+						})
+					},
+					RefreshState: true,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "active", "true"),
+					),
+				},
+				// An active LDAP configuration cannot be removed, so switch back
+				// before destroying it.
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.AuthenticationType = apiobjects.AuthenticationTypeBasic
+						})
+					},
+					RefreshState: true,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "active", "false"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - drift is reverted", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceLDAPAuthenticationWoSecure("scc_ldap", `["ldap://ldap.example.com"]`, userSearchBase, groupSearchBase),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.LDAP.UserBase = "ou=people,dc=example,dc=com"
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceLDAPAuthenticationWoSecure("scc_ldap", `["ldap://ldap.example.com"]`, userSearchBase, groupSearchBase),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_ldap_authentication.scc_ldap", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_ldap_authentication.scc_ldap", "user_search_base", userSearchBase),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - removed outside of terraform is recreated", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceLDAPAuthenticationWoSecure("scc_ldap", `["ldap://ldap.example.com"]`, userSearchBase, groupSearchBase),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.LDAP = nil
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceLDAPAuthenticationWoSecure("scc_ldap", `["ldap://ldap.example.com"]`, userSearchBase, groupSearchBase),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_ldap_authentication.scc_ldap", plancheck.ResourceActionCreate),
+						},
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - ldaps host without secure", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceLDAPAuthenticationWoSecure("scc_ldap", `["ldaps://ldap.example.com"]`, userSearchBase, groupSearchBase),
+					ExpectError: regexp.MustCompile(`Invalid LDAP URL Scheme`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - ldap host with secure", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceLDAPAuthentication("scc_ldap", `["ldap://ldap.example.com"]`, true, userSearchBase, groupSearchBase),
+					ExpectError: regexp.MustCompile(`Invalid LDAP URL Scheme`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid host", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceLDAPAuthenticationWoSecure("scc_ldap", `["ldap://ldap.example.com/dc=example,dc=com"]`, userSearchBase, groupSearchBase),
+					ExpectError: regexp.MustCompile(`Invalid LDAP URL`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid import id", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceLDAPAuthenticationWoSecure("scc_ldap", `["ldap://ldap.example.com"]`, userSearchBase, groupSearchBase),
+				},
+				{
+					ResourceName:  "scc_ldap_authentication.scc_ldap",
+					ImportState:   true,
+					ImportStateId: "invalid",
+					ExpectError:   regexp.MustCompile(`Expected import identifier`),
+				},
+			},
+		})
+	})
+}
+
+func ResourceLDAPAuthentication(resourceName string, hosts string, secure bool, userSearchBase string, groupSearchBase string) string {
+	return fmt.Sprintf(`
+	resource "scc_ldap_authentication" "%s" {
+		hosts = %s
+		secure = %t
+		user = "cn=scc,dc=example,dc=com"
+		password = "secret"
+		user_search_base = "%s"
+		user_search_filter = "(uid={0})"
+		group_search_base = "%s"
+		role_mapping = {
+			administrator = "scc-admins"
+			display = "scc-viewers"
+		}
+	}
+	`, resourceName, hosts, secure, userSearchBase, groupSearchBase)
+}
+
+func ResourceLDAPAuthenticationWoSecure(resourceName string, hosts string, userSearchBase string, groupSearchBase string) string {
+	return fmt.Sprintf(`
+	resource "scc_ldap_authentication" "%s" {
+		hosts = %s
+		user_search_base = "%s"
+		group_search_base = "%s"
+	}
+	`, resourceName, hosts, userSearchBase, groupSearchBase)
+}
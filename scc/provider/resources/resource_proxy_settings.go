@@ -6,7 +6,6 @@ import (
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -50,6 +49,7 @@ __Tips:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/proxy-settings>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"host": schema.StringAttribute{
 				MarkdownDescription: "The name of the proxy host.",
 				Required:            true,
@@ -119,18 +119,21 @@ func (r *ProxySettingsResource) Create(ctx context.Context, req resource.CreateR
 
 func (r *ProxySettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state model.ProxySettingsResourceConfig
-	var respObj apiobjects.ProxySettings
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetProxySettingsEndpoint()
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.ProxySettings().Get(ctx)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -160,37 +163,29 @@ func (r *ProxySettingsResource) Update(ctx context.Context, req resource.UpdateR
 
 func (r *ProxySettingsResource) createOrUpdate(ctx context.Context, requestPlan tfsdk.Plan, responseDiagnostics *diag.Diagnostics, responseState *tfsdk.State, responseIdentity **tfsdk.ResourceIdentity) {
 	var plan model.ProxySettingsResourceConfig
-	var respObj apiobjects.ProxySettings
 	diags := requestPlan.Get(ctx, &plan)
 	responseDiagnostics.Append(diags...)
 	if responseDiagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetProxySettingsEndpoint()
-
-	planBody := map[string]any{
-		"host": plan.Host.ValueString(),
-		"port": plan.Port.ValueInt64(),
-	}
-
-	if !plan.User.IsNull() && !plan.User.IsUnknown() {
-		planBody["user"] = plan.User.ValueString()
-	}
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
 
-	if !plan.Password.IsNull() && !plan.Password.IsUnknown() {
-		planBody["password"] = plan.Password.ValueString()
+	planBody := apiobjects.ProxySettingsRequest{
+		Host:     plan.Host.ValueString(),
+		Port:     plan.Port.ValueInt64(),
+		User:     plan.User.ValueString(),
+		Password: plan.Password.ValueString(),
 	}
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "PUT", endpoint, planBody, false)
-	responseDiagnostics.Append(diags...)
-	if responseDiagnostics.HasError() {
+	if err := r.Client.ProxySettings().Update(ctx, planBody); err != nil {
+		responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "GET", endpoint, nil, true)
-	responseDiagnostics.Append(diags...)
-	if responseDiagnostics.HasError() {
+	respObj, err := r.Client.ProxySettings().Get(ctx)
+	if err != nil {
+		responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -219,18 +214,16 @@ func (r *ProxySettingsResource) createOrUpdate(ctx context.Context, requestPlan
 
 func (r *ProxySettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state model.ProxySettingsResourceConfig
-	var respObj apiobjects.ProxySettings
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetProxySettingsEndpoint()
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "DELETE", endpoint, nil, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	if err := r.Client.ProxySettings().Delete(ctx); err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -242,6 +235,8 @@ func (rs *ProxySettingsResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
+	req.ID = helpers.ImportInstanceID(ctx, rs.Client, req.ID, resp)
+
 	if req.ID != "proxy-settings" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
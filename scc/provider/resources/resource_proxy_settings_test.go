@@ -5,9 +5,11 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
 	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 )
 
@@ -109,6 +111,40 @@ func TestResourceProxySettings(t *testing.T) {
 		})
 	})
 
+	t.Run("happy path - drift is reverted", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceProxySettings("scc_ps", "testHost", 123, "testUser", "testPassword"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_proxy_settings.scc_ps", "host", "testHost"),
+						resource.TestCheckResourceAttr("scc_proxy_settings.scc_ps", "port", "123"),
+					),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.ProxySettings.Host = "changedHost"
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceProxySettings("scc_ps", "testHost", 123, "testUser", "testPassword"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_proxy_settings.scc_ps", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_proxy_settings.scc_ps", "host", "testHost"),
+					),
+				},
+			},
+		})
+	})
+
 	t.Run("error path - invalid import id", func(t *testing.T) {
 		rec, user := tfutils.SetupVCR(t, "fixtures/resource_proxy_settings_invalid_import")
 		defer tfutils.StopQuietly(rec)
@@ -0,0 +1,273 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/SAP/terraform-provider-scc/validation/sncSettings"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &SNCSettingsResource{}
+
+func NewSNCSettingsResource() resource.Resource {
+	return &SNCSettingsResource{}
+}
+
+type SNCSettingsResource struct {
+	Client *api.RestApiClient
+}
+
+const defaultSNCQualityOfProtection = 3
+
+type sncSettingsResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *SNCSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snc_settings"
+}
+
+func (r *SNCSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector SNC Settings Resource.
+
+Configures Secure Network Communication (SNC) for the Cloud Connector. SNC protects the RFC connections to backend systems that are exposed with the *RFCS* protocol and an ` + "`snc_partner_name`" + ` in ` + "`scc_system_mapping`" + `.
+
+The SNC library, e.g. the SAP Cryptographic Library, and the personal security environment (PSE) of the Cloud Connector must already be installed on the host of the Cloud Connector.
+
+On destroy, the SNC settings are removed, which disables SNC for all RFC connections.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+* Restart the Cloud Connector to apply changed SNC settings.
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/initial-configuration-rfc>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
+			"library_path": schema.StringAttribute{
+				MarkdownDescription: "The path of the SNC library on the host of the Cloud Connector, e.g. `/opt/sap/scc/sapcrypto/libsapcrypto.so`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"own_name": schema.StringAttribute{
+				MarkdownDescription: "The SNC name of the Cloud Connector in the format `p:<Distinguished_Name>`, e.g. `p:CN=SCC, O=Example, C=DE`. It must match the subject of the certificate in the PSE of the Cloud Connector.",
+				Required:            true,
+				Validators: []validator.String{
+					sncSettings.ValidateName(),
+				},
+			},
+			"quality_of_protection": schema.Int64Attribute{
+				MarkdownDescription: "The quality of protection of SNC connections. Possible values are: \n" +
+					helpers.GetFormattedValueAsTableRow("value", "description") +
+					helpers.GetFormattedValueAsTableRow("---", "---") +
+					helpers.GetFormattedValueAsTableRow("`1`", "Authentication only.") +
+					helpers.GetFormattedValueAsTableRow("`2`", "Integrity protection.") +
+					helpers.GetFormattedValueAsTableRow("`3`", "Privacy protection, i.e. encryption. This is the default.") +
+					helpers.GetFormattedValueAsTableRow("`8`", "The default protection of the backend system.") +
+					helpers.GetFormattedValueAsTableRow("`9`", "The maximum protection available."),
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(defaultSNCQualityOfProtection),
+				Validators: []validator.Int64{
+					int64validator.OneOf(1, 2, 3, 8, 9),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the SNC settings resource. Used for import and identity purposes. The value is always `snc-settings`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (rs *SNCSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *SNCSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = client
+}
+
+func (r *SNCSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.createOrUpdate(ctx, req.Plan, &resp.Diagnostics, &resp.State, &resp.Identity)
+}
+
+func (r *SNCSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state model.SNCSettingsConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	respObj, err := r.Client.SNC().Get(ctx)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	// The SNC settings have been removed outside of Terraform.
+	if !model.SNCConfigured(respObj) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	responseModel, diags := model.SNCSettingsResourceValueFrom(ctx, state, respObj)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identity := sncSettingsResourceIdentityModel{
+		ID: types.StringValue(model.SNCSettingsID),
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *SNCSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.createOrUpdate(ctx, req.Plan, &resp.Diagnostics, &resp.State, &resp.Identity)
+}
+
+func (r *SNCSettingsResource) createOrUpdate(ctx context.Context, requestPlan tfsdk.Plan, responseDiagnostics *diag.Diagnostics, responseState *tfsdk.State, responseIdentity **tfsdk.ResourceIdentity) {
+	var plan model.SNCSettingsConfig
+	diags := requestPlan.Get(ctx, &plan)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
+	planBody := apiobjects.SNCSettings{
+		LibraryPath: plan.LibraryPath.ValueString(),
+		MyName:      plan.OwnName.ValueString(),
+		QoP:         plan.QualityOfProtection.ValueInt64(),
+	}
+
+	if err := r.Client.SNC().Update(ctx, planBody); err != nil {
+		responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	respObj, err := r.Client.SNC().Get(ctx)
+	if err != nil {
+		responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	responseModel, diags := model.SNCSettingsResourceValueFrom(ctx, plan, respObj)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	diags = responseState.Set(ctx, responseModel)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	identity := sncSettingsResourceIdentityModel{
+		ID: types.StringValue(model.SNCSettingsID),
+	}
+
+	diags = (*responseIdentity).Set(ctx, identity)
+	responseDiagnostics.Append(diags...)
+}
+
+func (r *SNCSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state model.SNCSettingsConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	err := r.Client.SNC().Delete(ctx)
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (rs *SNCSettingsResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	req.ID = helpers.ImportInstanceID(ctx, rs.Client, req.ID, resp)
+
+	if req.ID != model.SNCSettingsID {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf(
+				"Expected import identifier %q. Got: %q",
+				model.SNCSettingsID,
+				req.ID,
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("id"), model.SNCSettingsID)...,
+	)
+}
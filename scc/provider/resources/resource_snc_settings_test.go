@@ -0,0 +1,229 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResourceSNCSettings(t *testing.T) {
+	libraryPath := "/opt/sap/scc/sapcrypto/libsapcrypto.so"
+	ownName := "p:CN=SCC, O=Example, C=DE"
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			CheckDestroy: func(_ *terraform.State) error {
+				var err error
+				srv.Update(func(state *sccmock.State) {
+					if state.SNC != nil {
+						err = fmt.Errorf("SNC still configured for %s", state.SNC.MyName)
+					}
+				})
+				return err
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSNCSettings("scc_snc", libraryPath, ownName, 9),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_snc_settings.scc_snc", "library_path", libraryPath),
+						resource.TestCheckResourceAttr("scc_snc_settings.scc_snc", "own_name", ownName),
+						resource.TestCheckResourceAttr("scc_snc_settings.scc_snc", "quality_of_protection", "9"),
+						resource.TestCheckResourceAttr("scc_snc_settings.scc_snc", "id", "snc-settings"),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity(
+							"scc_snc_settings.scc_snc",
+							map[string]knownvalue.Check{
+								"id": knownvalue.StringExact("snc-settings"),
+							},
+						),
+					},
+				},
+				{
+					ResourceName:                         "scc_snc_settings.scc_snc",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "snc-settings",
+					ImportStateVerifyIdentifierAttribute: "id",
+				},
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSNCSettingsWoQualityOfProtection("scc_snc", libraryPath, "p:CN=SCC2, O=Example, C=DE"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_snc_settings.scc_snc", "own_name", "p:CN=SCC2, O=Example, C=DE"),
+						resource.TestCheckResourceAttr("scc_snc_settings.scc_snc", "quality_of_protection", "3"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - Cloud Connector before 2.18.0", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+		srv.Update(func(state *sccmock.State) {
+			state.Version = "2.17.0"
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSNCSettings("scc_snc", libraryPath, ownName, 3),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_snc_settings.scc_snc", "own_name", ownName),
+						func(_ *terraform.State) error {
+							var err error
+							srv.Update(func(state *sccmock.State) {
+								expected := apiobjects.SNCSettings{LibraryPath: libraryPath, MyName: ownName, QoP: 3}
+								if state.SNC == nil || *state.SNC != expected {
+									err = fmt.Errorf("expected the SNC settings %+v, got %+v", expected, state.SNC)
+								}
+							})
+							return err
+						},
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - drift is reverted", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSNCSettings("scc_snc", libraryPath, ownName, 3),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.SNC.QoP = 1
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceSNCSettings("scc_snc", libraryPath, ownName, 3),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_snc_settings.scc_snc", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_snc_settings.scc_snc", "quality_of_protection", "3"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - removed outside of terraform is recreated", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSNCSettings("scc_snc", libraryPath, ownName, 3),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.SNC = nil
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceSNCSettings("scc_snc", libraryPath, ownName, 3),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_snc_settings.scc_snc", plancheck.ResourceActionCreate),
+						},
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid quality of protection", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceSNCSettings("scc_snc", libraryPath, ownName, 4),
+					ExpectError: regexp.MustCompile(`Attribute quality_of_protection value must be one of`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - library path mandatory", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					resource "scc_snc_settings" "scc_snc" {
+						own_name = "p:CN=SCC"
+					}
+					`,
+					ExpectError: regexp.MustCompile(`The argument "library_path" is required, but no definition was found.`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid import id", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSNCSettings("scc_snc", libraryPath, ownName, 3),
+				},
+				{
+					ResourceName:  "scc_snc_settings.scc_snc",
+					ImportState:   true,
+					ImportStateId: "invalid",
+					ExpectError:   regexp.MustCompile(`Expected import identifier`),
+				},
+			},
+		})
+	})
+}
+
+func ResourceSNCSettings(resourceName string, libraryPath string, ownName string, qualityOfProtection int) string {
+	return fmt.Sprintf(`
+	resource "scc_snc_settings" "%s" {
+		library_path = "%s"
+		own_name = "%s"
+		quality_of_protection = %d
+	}
+	`, resourceName, libraryPath, ownName, qualityOfProtection)
+}
+
+func ResourceSNCSettingsWoQualityOfProtection(resourceName string, libraryPath string, ownName string) string {
+	return fmt.Sprintf(`
+	resource "scc_snc_settings" "%s" {
+		library_path = "%s"
+		own_name = "%s"
+	}
+	`, resourceName, libraryPath, ownName)
+}
@@ -8,10 +8,10 @@ import (
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -60,6 +60,7 @@ Automatic renewal requires two steps. Configure it in this resource, and also en
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/subaccount>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"region_host": schema.StringAttribute{
 				MarkdownDescription: "Region Host Name.",
 				Required:            true,
@@ -124,11 +125,19 @@ The actual tunnel status is reported by the Cloud Connector and may differ:
 
 **Important:**
 In case of *ConnectFailure*, the provider will issue a warning but will **not reset** the value of connected.
-To recover, set connected = false, apply, and then set it back to true to retry the connection.`,
+To recover, set connected = false, apply, and then set it back to true to retry the connection.
+Alternatively, set ` + "`wait_for_connection`" + ` to let the provider wait for the tunnel and retry the connection automatically.`,
 				Optional: true,
 				Computed: true,
 				Default:  booldefault.StaticBool(true),
 			},
+			"wait_for_connection": schema.BoolAttribute{
+				MarkdownDescription: "Whether create and update wait until the tunnel has reached the state requested by `connected`. " +
+					"The tunnel state is polled with increasing intervals, and failed connection attempts are retried automatically until the timeout configured in the `timeouts` block elapses, 10 minutes by default. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"auto_renew_before_days": schema.Int64Attribute{
 				MarkdownDescription: "Number of days before certificate expiration when the provider should renew the certificate automatically. Minimum is 7 days, maximum is 45 days.\n\n" +
 					"This check is skipped when `auto_certificate_renewal` is `true`, because the Cloud Connector handles renewal natively in that case.",
@@ -260,6 +269,9 @@ To recover, set connected = false, apply, and then set it back to true to retry
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true}),
+		},
 	}
 }
 
@@ -297,13 +309,14 @@ func (r *SubaccountResource) Configure(ctx context.Context, req resource.Configu
 
 func (r *SubaccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan model.SubaccountConfig
-	var respObj apiobjects.SubaccountResource
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	if plan.CloudUser.IsNull() || plan.CloudUser.IsUnknown() ||
 		plan.CloudPassword.IsNull() || plan.CloudPassword.IsUnknown() {
 		resp.Diagnostics.AddError(
@@ -313,58 +326,56 @@ func (r *SubaccountResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	regionHost := plan.RegionHost.ValueString()
-	subaccount := plan.Subaccount.ValueString()
-
-	endpoint := endpoints.GetSubaccountBaseEndpoint()
-
-	planBody := map[string]any{
-		"regionHost":    regionHost,
-		"subaccount":    subaccount,
-		"cloudUser":     plan.CloudUser.ValueString(),
-		"cloudPassword": plan.CloudPassword.ValueString(),
-		"description":   plan.Description.ValueString(),
-		"locationID":    plan.LocationID.ValueString(),
-		"displayName":   plan.DisplayName.ValueString(),
-	}
-
-	if !plan.IsManaged.IsNull() && !plan.IsManaged.IsUnknown() {
-		planBody["isManaged"] = plan.IsManaged.ValueBool()
+	resp.Diagnostics.Append(requireManagedSubaccountVersion(ctx, r.Client, plan.IsManaged, plan.AutoCertificateRenewal)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !plan.AutoCertificateRenewal.IsNull() && !plan.AutoCertificateRenewal.IsUnknown() {
-		planBody["autoCertRenewal"] = plan.AutoCertificateRenewal.ValueBool()
-	}
+	regionHost := plan.RegionHost.ValueString()
+	subaccount := plan.Subaccount.ValueString()
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "POST", endpoint, planBody, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	planBody := apiobjects.SubaccountCreateRequest{
+		RegionHost:             regionHost,
+		Subaccount:             subaccount,
+		CloudUser:              plan.CloudUser.ValueString(),
+		CloudPassword:          plan.CloudPassword.ValueString(),
+		Description:            plan.Description.ValueString(),
+		LocationID:             plan.LocationID.ValueString(),
+		DisplayName:            plan.DisplayName.ValueString(),
+		IsManaged:              helpers.BoolPointerOrNil(plan.IsManaged),
+		AutoCertificateRenewal: helpers.BoolPointerOrNil(plan.AutoCertificateRenewal),
+	}
+
+	respObj, err := r.Client.Subaccounts().Create(ctx, planBody)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
 	connectedState := respObj.Tunnel.State == "Connected"
 
 	if !plan.Connected.IsNull() && !plan.Connected.IsUnknown() {
-		endpoint = endpoints.GetSubaccountEndpoint(regionHost, subaccount)
-		diags = r.updateTunnelState(plan, connectedState, endpoint, &respObj)
+		diags = r.updateTunnelState(ctx, plan, connectedState, regionHost, subaccount, &respObj)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
 
-	if respObj.Tunnel.State == "ConnectFailure" {
+	waitDiags := helpers.WaitForPlannedTunnel(ctx, r.Client, regionHost, subaccount, plan.WaitForConnection, plan.Connected, plan.Timeouts, "create", &respObj)
+
+	if respObj.Tunnel.State == "ConnectFailure" && !plan.WaitForConnection.ValueBool() {
 		resp.Diagnostics.AddWarning(
 			"Tunnel connection failed",
 			"The subaccount was created/updated successfully, but the tunnel could not be established (state=ConnectFailure). "+
-				"You can retry by toggling 'connected' from false to true.",
+				"You can retry by toggling 'connected' from false to true, or set 'wait_for_connection' to let the provider retry automatically.",
 		)
 	}
 
 	if respObj.Tunnel.State == "Connected" {
 		// Trigger trust configuration sync for the subaccount without persisting to Terraform state
 		autoTrustSync := plan.AutoTrustSync.ValueBool()
-		diags = r.syncTrustConfiguration(regionHost, subaccount, &respObj, autoTrustSync)
+		diags = r.syncTrustConfiguration(ctx, regionHost, subaccount, autoTrustSync)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -396,24 +407,32 @@ func (r *SubaccountResource) Create(ctx context.Context, req resource.CreateRequ
 	diags = resp.Identity.Set(ctx, identity)
 	resp.Diagnostics.Append(diags...)
 
+	// Waiting errors are reported after the state is stored, so that the
+	// subaccount is not lost if the tunnel does not settle in time.
+	resp.Diagnostics.Append(waitDiags...)
+
 }
 
 func (r *SubaccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state model.SubaccountConfig
-	var respObj apiobjects.SubaccountResource
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
 	regionHost := state.RegionHost.ValueString()
 	subaccount := state.Subaccount.ValueString()
-	endpoint := endpoints.GetSubaccountEndpoint(regionHost, subaccount)
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.Subaccounts().Get(ctx, regionHost, subaccount)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -421,7 +440,7 @@ func (r *SubaccountResource) Read(ctx context.Context, req resource.ReadRequest,
 		shouldRenewCertificate(respObj.Tunnel.SubaccountCertificate.NotAfterTimeStamp, state.AutoRenewBeforeDays.ValueInt64())
 
 	if shouldRenew {
-		renewedRespObj, diags := r.renewCertificate(state, regionHost, subaccount)
+		renewedRespObj, diags := r.renewCertificate(ctx, state, regionHost, subaccount)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() && renewedRespObj != nil {
 			respObj = *renewedRespObj
@@ -433,7 +452,7 @@ func (r *SubaccountResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	if respObj.Tunnel.State == "Connected" {
-		diags = r.syncTrustConfiguration(regionHost, subaccount, &respObj, state.AutoTrustSync.ValueBool())
+		diags = r.syncTrustConfiguration(ctx, regionHost, subaccount, state.AutoTrustSync.ValueBool())
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -480,12 +499,13 @@ func (r *SubaccountResource) Read(ctx context.Context, req resource.ReadRequest,
 
 func (r *SubaccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, state model.SubaccountConfig
-	var respObj apiobjects.SubaccountResource
 
 	if diags := req.Plan.Get(ctx, &plan); appendAndCheckErrors(&resp.Diagnostics, diags) {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	if diags := req.State.Get(ctx, &state); appendAndCheckErrors(&resp.Diagnostics, diags) {
 		return
 	}
@@ -496,30 +516,33 @@ func (r *SubaccountResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	if !plan.AutoCertificateRenewal.Equal(state.AutoCertificateRenewal) {
+		resp.Diagnostics.Append(requireManagedSubaccountVersion(ctx, r.Client, types.BoolNull(), plan.AutoCertificateRenewal)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	regionHost := plan.RegionHost.ValueString()
 	subaccount := plan.Subaccount.ValueString()
-	endpoint := endpoints.GetSubaccountEndpoint(regionHost, subaccount)
-
-	updateBody := map[string]any{
-		"locationID":  plan.LocationID.ValueString(),
-		"displayName": plan.DisplayName.ValueString(),
-		"description": plan.Description.ValueString(),
-	}
 
-	if !plan.AutoCertificateRenewal.IsNull() && !plan.AutoCertificateRenewal.IsUnknown() {
-		updateBody["autoCertRenewal"] = plan.AutoCertificateRenewal.ValueBool()
+	updateBody := apiobjects.SubaccountUpdateRequest{
+		LocationID:             plan.LocationID.ValueString(),
+		DisplayName:            plan.DisplayName.ValueString(),
+		Description:            plan.Description.ValueString(),
+		AutoCertificateRenewal: helpers.BoolPointerOrNil(plan.AutoCertificateRenewal),
 	}
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "PUT", endpoint, updateBody, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.Subaccounts().Update(ctx, regionHost, subaccount, updateBody)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
 	connectedState := respObj.Tunnel.State == "Connected"
 
 	if !plan.Connected.IsNull() && !plan.Connected.IsUnknown() {
-		diags = r.updateTunnelState(plan, connectedState, endpoint, &respObj)
+		diags = r.updateTunnelState(ctx, plan, connectedState, regionHost, subaccount, &respObj)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -527,18 +550,20 @@ func (r *SubaccountResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	}
 
-	if respObj.Tunnel.State == "ConnectFailure" {
+	waitDiags := helpers.WaitForPlannedTunnel(ctx, r.Client, regionHost, subaccount, plan.WaitForConnection, plan.Connected, plan.Timeouts, "update", &respObj)
+
+	if respObj.Tunnel.State == "ConnectFailure" && !plan.WaitForConnection.ValueBool() {
 		resp.Diagnostics.AddWarning(
 			"Tunnel connection failed",
 			"The subaccount was created/updated successfully, but the tunnel could not be established (state=ConnectFailure). "+
-				"You can retry by toggling 'connected' from false to true.",
+				"You can retry by toggling 'connected' from false to true, or set 'wait_for_connection' to let the provider retry automatically.",
 		)
 	}
 
 	if !plan.AutoCertificateRenewal.ValueBool() &&
 		!plan.AutoRenewBeforeDays.IsNull() && !plan.AutoRenewBeforeDays.IsUnknown() {
 		if shouldRenewCertificate(respObj.Tunnel.SubaccountCertificate.NotAfterTimeStamp, plan.AutoRenewBeforeDays.ValueInt64()) {
-			renewedRespObj, diags := r.renewCertificate(plan, regionHost, subaccount)
+			renewedRespObj, diags := r.renewCertificate(ctx, plan, regionHost, subaccount)
 			resp.Diagnostics.Append(diags...)
 			if !resp.Diagnostics.HasError() && renewedRespObj != nil {
 				respObj = *renewedRespObj
@@ -551,7 +576,7 @@ func (r *SubaccountResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	if respObj.Tunnel.State == "Connected" {
-		diags = r.syncTrustConfiguration(regionHost, subaccount, &respObj, plan.AutoTrustSync.ValueBool())
+		diags = r.syncTrustConfiguration(ctx, regionHost, subaccount, plan.AutoTrustSync.ValueBool())
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -578,6 +603,10 @@ func (r *SubaccountResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	diags = resp.Identity.Set(ctx, identity)
 	resp.Diagnostics.Append(diags...)
+
+	// Waiting errors are reported after the state is stored, so that the
+	// subaccount is not lost if the tunnel does not settle in time.
+	resp.Diagnostics.Append(waitDiags...)
 }
 
 func appendAndCheckErrors(diags *diag.Diagnostics, newDiags diag.Diagnostics) bool {
@@ -605,12 +634,23 @@ func validateUpdateInputs(plan, state model.SubaccountConfig) diag.Diagnostics {
 	return diags
 }
 
-func (r *SubaccountResource) syncTrustConfiguration(regionHost, subaccount string, respObj *apiobjects.SubaccountResource, autoTrustSync bool) diag.Diagnostics {
-	endpoint := endpoints.GetSubaccountEndpoint(regionHost, subaccount) + "/trust"
+// requireManagedSubaccountVersion rejects is_managed and auto_certificate_renewal
+// if they are enabled for a Cloud Connector that does not support them yet.
+func requireManagedSubaccountVersion(ctx context.Context, client *api.RestApiClient, isManaged, autoCertificateRenewal types.Bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if isManaged.ValueBool() {
+		diags.Append(helpers.RequireVersion(ctx, client, api.VersionManagedSubaccounts, path.Root("is_managed"))...)
+	}
+	if autoCertificateRenewal.ValueBool() {
+		diags.Append(helpers.RequireVersion(ctx, client, api.VersionManagedSubaccounts, path.Root("auto_certificate_renewal"))...)
+	}
+	return diags
+}
 
+func (r *SubaccountResource) syncTrustConfiguration(ctx context.Context, regionHost, subaccount string, autoTrustSync bool) diag.Diagnostics {
 	// Manual sync always runs first — required before auto sync can be enabled,
 	// and the only path on older SCC versions.
-	diags := helpers.RequestAndUnmarshal(r.Client, respObj, "POST", endpoint, nil, false)
+	diags := api.ErrorDiagnostics(r.Client.Subaccounts().SyncTrust(ctx, regionHost, subaccount))
 	if diags.HasError() {
 		return diags
 	}
@@ -622,8 +662,7 @@ func (r *SubaccountResource) syncTrustConfiguration(regionHost, subaccount strin
 		return diags
 	}
 
-	putBody := map[string]any{"autoSyncTrustEnabled": true}
-	diags = helpers.RequestAndUnmarshal(r.Client, respObj, "PUT", endpoint, putBody, false)
+	diags = api.ErrorDiagnostics(r.Client.Subaccounts().SetAutoTrustSync(ctx, regionHost, subaccount, true))
 	if diags.HasError() {
 		diags.AddError(
 			"Failed to enable automatic trust synchronization",
@@ -635,7 +674,7 @@ func (r *SubaccountResource) syncTrustConfiguration(regionHost, subaccount strin
 	return diags
 }
 
-func (r *SubaccountResource) updateTunnelState(plan model.SubaccountConfig, connectedState bool, endpoint string, respObj *apiobjects.SubaccountResource) diag.Diagnostics {
+func (r *SubaccountResource) updateTunnelState(ctx context.Context, plan model.SubaccountConfig, connectedState bool, regionHost, subaccount string, respObj *apiobjects.Subaccount) diag.Diagnostics {
 	var diags diag.Diagnostics
 	// Check if the desired state is different from the current state
 	desiredState := plan.Connected.ValueBool()
@@ -643,20 +682,14 @@ func (r *SubaccountResource) updateTunnelState(plan model.SubaccountConfig, conn
 		return diags
 	}
 	// Update the tunnel state
-	patch := map[string]any{"connected": desiredState}
-
-	diags = helpers.RequestAndUnmarshal(r.Client, respObj, "PUT", endpoint+"/state", patch, false)
-	if diags.HasError() {
-		return diags
+	err := r.Client.Subaccounts().SetTunnelState(ctx, regionHost, subaccount, desiredState)
+	if err != nil {
+		return api.ErrorDiagnostics(err)
 	}
 
 	// Re-fetch to update tunnel state
-	diags = helpers.RequestAndUnmarshal(r.Client, respObj, "GET", endpoint, nil, true)
-	if diags.HasError() {
-		return diags
-	}
-
-	return diags
+	*respObj, err = r.Client.Subaccounts().Get(ctx, regionHost, subaccount)
+	return api.ErrorDiagnostics(err)
 }
 
 func shouldRenewCertificate(expiry, autoRenewBeforeDays int64) bool {
@@ -666,46 +699,42 @@ func shouldRenewCertificate(expiry, autoRenewBeforeDays int64) bool {
 	return expiryTime.Before(renewalThreshold)
 }
 
-func (r *SubaccountResource) renewCertificate(plan model.SubaccountConfig, regionHost, subaccount string) (*apiobjects.SubaccountResource, diag.Diagnostics) {
-	var respObj apiobjects.SubaccountResource
-	var diags diag.Diagnostics
-
-	endpoint := endpoints.GetSubaccountEndpoint(regionHost, subaccount) + "/validity"
-
-	reqBody := map[string]any{
-		"user":     plan.CloudUser.ValueString(),
-		"password": plan.CloudPassword.ValueString(),
+func (r *SubaccountResource) renewCertificate(ctx context.Context, plan model.SubaccountConfig, regionHost, subaccount string) (*apiobjects.Subaccount, diag.Diagnostics) {
+	reqBody := apiobjects.SubaccountCertificateRenewalRequest{
+		User:     plan.CloudUser.ValueString(),
+		Password: plan.CloudPassword.ValueString(),
 	}
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "POST", endpoint, reqBody, true)
-	if diags.HasError() {
-		return nil, diags
+	respObj, err := r.Client.Subaccounts().RenewCertificate(ctx, regionHost, subaccount, reqBody)
+	if err != nil {
+		return nil, api.ErrorDiagnostics(err)
 	}
 
-	return &respObj, diags
+	return &respObj, nil
 }
 
 func (r *SubaccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state model.SubaccountConfig
-	var respObj apiobjects.SubaccountResource
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 	regionHost := state.RegionHost.ValueString()
 	subaccount := state.Subaccount.ValueString()
 
-	endpoint := endpoints.GetSubaccountEndpoint(regionHost, subaccount)
-
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "DELETE", endpoint, nil, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	err := r.Client.Subaccounts().Delete(ctx, regionHost, subaccount)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 }
 
 func (rs *SubaccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = helpers.ImportInstanceID(ctx, rs.Client, req.ID, resp)
+
 	if req.ID != "" {
 		idParts := strings.Split(req.ID, ",")
 
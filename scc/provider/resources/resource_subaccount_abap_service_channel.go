@@ -8,7 +8,6 @@ import (
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
@@ -69,6 +68,7 @@ __Operational notes:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/subaccount-service-channels>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"region_host": schema.StringAttribute{
 				MarkdownDescription: "Region Host Name.",
 				Required:            true,
@@ -196,44 +196,37 @@ func (r *SubaccountABAPServiceChannelResource) Configure(ctx context.Context, re
 
 func (r *SubaccountABAPServiceChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan model.SubaccountABAPServiceChannelConfig
-	var respObj apiobjects.SubaccountABAPServiceChannels
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	regionHost := plan.RegionHost.ValueString()
 	subaccount := plan.Subaccount.ValueString()
 
 	var serviceChannelType string
 	if plan.SNCEncrypted.ValueBool() {
-		serviceChannelType = "ABAPCloudSNC"
+		serviceChannelType = api.ServiceChannelTypeABAPCloudSNC
 	} else {
-		serviceChannelType = "ABAPCloud"
-	}
-	endpoint := endpoints.GetSubaccountServiceChannelBaseEndpoint(regionHost, subaccount, serviceChannelType)
-
-	planBody := map[string]any{
-		"abapCloudTenantHost": plan.ABAPCloudTenantHost.ValueString(),
-		"instanceNumber":      fmt.Sprintf("%d", plan.InstanceNumber.ValueInt64()),
-		"connections":         fmt.Sprintf("%d", plan.Connections.ValueInt64()),
-		"comment":             plan.Comment.ValueString(),
+		serviceChannelType = api.ServiceChannelTypeABAPCloud
 	}
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj.SubaccountABAPServiceChannels, "POST", endpoint, planBody, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	err := r.Client.ABAPServiceChannels().Create(ctx, regionHost, subaccount, serviceChannelType, buildSubaccountABAPServiceChannelBody(plan))
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj.SubaccountABAPServiceChannels, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	serviceChannels, err := r.Client.ABAPServiceChannels().List(ctx, regionHost, subaccount, serviceChannelType)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
-	serviceChannelRespObj, diags := r.getSubaccountABAPServiceChannel(respObj, plan.ABAPCloudTenantHost.ValueString())
+	serviceChannelRespObj, diags := r.getSubaccountABAPServiceChannel(serviceChannels, plan.ABAPCloudTenantHost.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -242,9 +235,8 @@ func (r *SubaccountABAPServiceChannelResource) Create(ctx context.Context, req r
 	id := serviceChannelRespObj.ID
 
 	if !plan.Enabled.IsNull() {
-		endpoint = endpoints.GetSubaccountServiceChannelEndpoint(regionHost, subaccount, serviceChannelType, id)
-		enableDiags := r.enableSubaccountABAPServiceChannel(plan, *serviceChannelRespObj, endpoint+"/state")
-		if enableDiags.HasError() {
+		err := r.Client.ABAPServiceChannels().SetEnabled(ctx, regionHost, subaccount, serviceChannelType, id, plan.Enabled.ValueBool())
+		if err != nil {
 			// The channel was created but enabling failed (e.g. HTTP 500 when SCC
 			// cannot reach the ABAP tenant host). Save the created-but-disabled state
 			// so Terraform tracks the resource and the user can fix connectivity and
@@ -259,15 +251,16 @@ func (r *SubaccountABAPServiceChannelResource) Create(ctx context.Context, req r
 					Type:       types.StringValue(serviceChannelType),
 				})
 			}
-			resp.Diagnostics.Append(enableDiags...)
+			resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 			return
 		}
 
-		diags = helpers.RequestAndUnmarshal(r.Client, &serviceChannelRespObj, "GET", endpoint, nil, true)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
+		serviceChannel, err := r.Client.ABAPServiceChannels().Get(ctx, regionHost, subaccount, serviceChannelType, id)
+		if err != nil {
+			resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 			return
 		}
+		serviceChannelRespObj = &serviceChannel
 	}
 
 	responseModel, diags := model.SubaccountABAPServiceChannelValueFrom(ctx, plan, *serviceChannelRespObj)
@@ -297,28 +290,32 @@ func (r *SubaccountABAPServiceChannelResource) Create(ctx context.Context, req r
 
 func (r *SubaccountABAPServiceChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state model.SubaccountABAPServiceChannelConfig
-	var respObj apiobjects.SubaccountABAPServiceChannel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
 	regionHost := state.RegionHost.ValueString()
 	subaccount := state.Subaccount.ValueString()
 	id := state.ID.ValueInt64()
 
 	var serviceChannelType string
 	if state.SNCEncrypted.ValueBool() {
-		serviceChannelType = "ABAPCloudSNC"
+		serviceChannelType = api.ServiceChannelTypeABAPCloudSNC
 	} else {
-		serviceChannelType = "ABAPCloud"
+		serviceChannelType = api.ServiceChannelTypeABAPCloud
 	}
-	endpoint := endpoints.GetSubaccountServiceChannelEndpoint(regionHost, subaccount, serviceChannelType, id)
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.ABAPServiceChannels().Get(ctx, regionHost, subaccount, serviceChannelType, id)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -349,7 +346,6 @@ func (r *SubaccountABAPServiceChannelResource) Read(ctx context.Context, req res
 
 func (r *SubaccountABAPServiceChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, state model.SubaccountABAPServiceChannelConfig
-	var respObj apiobjects.SubaccountABAPServiceChannel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -357,6 +353,8 @@ func (r *SubaccountABAPServiceChannelResource) Update(ctx context.Context, req r
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -375,31 +373,28 @@ func (r *SubaccountABAPServiceChannelResource) Update(ctx context.Context, req r
 	// Update Service Channel
 	var serviceChannelType string
 	if state.SNCEncrypted.ValueBool() {
-		serviceChannelType = "ABAPCloudSNC"
+		serviceChannelType = api.ServiceChannelTypeABAPCloudSNC
 	} else {
-		serviceChannelType = "ABAPCloud"
+		serviceChannelType = api.ServiceChannelTypeABAPCloud
 	}
-	endpoint := endpoints.GetSubaccountServiceChannelEndpoint(regionHost, subaccount, serviceChannelType, id)
-	diags = r.updateSubaccountABAPServiceChannel(plan, respObj, endpoint)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	err := r.Client.ABAPServiceChannels().Update(ctx, regionHost, subaccount, serviceChannelType, id, buildSubaccountABAPServiceChannelBody(plan))
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
 	// Enable/Disable Service Channel
 	if plan.Enabled.ValueBool() != state.Enabled.ValueBool() {
-		diags = r.enableSubaccountABAPServiceChannel(plan, respObj, endpoint+"/state")
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
+		err = r.Client.ABAPServiceChannels().SetEnabled(ctx, regionHost, subaccount, serviceChannelType, id, plan.Enabled.ValueBool())
+		if err != nil {
+			resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 			return
 		}
 	}
 
-	endpoint = endpoints.GetSubaccountServiceChannelEndpoint(regionHost, subaccount, serviceChannelType, id)
-
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.ABAPServiceChannels().Get(ctx, regionHost, subaccount, serviceChannelType, id)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -430,38 +425,37 @@ func (r *SubaccountABAPServiceChannelResource) Update(ctx context.Context, req r
 
 func (r *SubaccountABAPServiceChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state model.SubaccountABAPServiceChannelConfig
-	var respObj apiobjects.SubaccountABAPServiceChannel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
 	regionHost := state.RegionHost.ValueString()
 	subaccount := state.Subaccount.ValueString()
 	id := state.ID.ValueInt64()
 
 	var serviceChannelType string
 	if state.SNCEncrypted.ValueBool() {
-		serviceChannelType = "ABAPCloudSNC"
+		serviceChannelType = api.ServiceChannelTypeABAPCloudSNC
 	} else {
-		serviceChannelType = "ABAPCloud"
+		serviceChannelType = api.ServiceChannelTypeABAPCloud
 	}
 
-	endpoint := endpoints.GetSubaccountServiceChannelEndpoint(regionHost, subaccount, serviceChannelType, id)
-
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "DELETE", endpoint, nil, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	err := r.Client.ABAPServiceChannels().Delete(ctx, regionHost, subaccount, serviceChannelType, id)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
 	resp.State.RemoveResource(ctx)
 }
 
-func (r *SubaccountABAPServiceChannelResource) getSubaccountABAPServiceChannel(serviceChannels apiobjects.SubaccountABAPServiceChannels, targetABAPCloudTenantHost string) (*apiobjects.SubaccountABAPServiceChannel, diag.Diagnostics) {
+func (r *SubaccountABAPServiceChannelResource) getSubaccountABAPServiceChannel(serviceChannels []apiobjects.SubaccountABAPServiceChannel, targetABAPCloudTenantHost string) (*apiobjects.SubaccountABAPServiceChannel, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	for _, channel := range serviceChannels.SubaccountABAPServiceChannels {
+	for _, channel := range serviceChannels {
 		if channel.ABAPCloudTenantHost == targetABAPCloudTenantHost {
 			return &channel, nil
 		}
@@ -470,36 +464,18 @@ func (r *SubaccountABAPServiceChannelResource) getSubaccountABAPServiceChannel(s
 	return nil, diags
 }
 
-func (r *SubaccountABAPServiceChannelResource) enableSubaccountABAPServiceChannel(plan model.SubaccountABAPServiceChannelConfig, respObj apiobjects.SubaccountABAPServiceChannel, endpoint string) diag.Diagnostics {
-	planBody := map[string]any{
-		"enabled": fmt.Sprintf("%t", plan.Enabled.ValueBool()),
-	}
-
-	diags := helpers.RequestAndUnmarshal(r.Client, &respObj, "PUT", endpoint, planBody, false)
-	if diags.HasError() {
-		return diags
-	}
-
-	return diags
-}
-
-func (r *SubaccountABAPServiceChannelResource) updateSubaccountABAPServiceChannel(plan model.SubaccountABAPServiceChannelConfig, respObj apiobjects.SubaccountABAPServiceChannel, endpoint string) diag.Diagnostics {
-	planBody := map[string]any{
-		"abapCloudTenantHost": plan.ABAPCloudTenantHost.ValueString(),
-		"instanceNumber":      fmt.Sprintf("%d", plan.InstanceNumber.ValueInt64()),
-		"connections":         fmt.Sprintf("%d", plan.Connections.ValueInt64()),
-		"comment":             plan.Comment.ValueString(),
+func buildSubaccountABAPServiceChannelBody(plan model.SubaccountABAPServiceChannelConfig) apiobjects.SubaccountABAPServiceChannelRequest {
+	return apiobjects.SubaccountABAPServiceChannelRequest{
+		ABAPCloudTenantHost: plan.ABAPCloudTenantHost.ValueString(),
+		Comment:             plan.Comment.ValueString(),
+		Connections:         plan.Connections.ValueInt64(),
+		InstanceNumber:      plan.InstanceNumber.ValueInt64(),
 	}
-
-	diags := helpers.RequestAndUnmarshal(r.Client, &respObj, "PUT", endpoint, planBody, false)
-	if diags.HasError() {
-		return diags
-	}
-
-	return diags
 }
 
 func (rs *SubaccountABAPServiceChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = helpers.ImportInstanceID(ctx, rs.Client, req.ID, resp)
+
 	if req.ID != "" {
 		idParts := strings.Split(req.ID, ",")
 
@@ -564,5 +540,4 @@ func (rs *SubaccountABAPServiceChannelResource) ImportState(ctx context.Context,
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), identity.Type)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("snc_encrypted"), identity.Type.ValueString() == "ABAPCloudSNC")...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
-
 }
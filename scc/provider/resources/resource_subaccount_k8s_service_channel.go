@@ -3,13 +3,12 @@ package resources
 import (
 	"context"
 	"fmt"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"strconv"
 	"strings"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
-	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -60,6 +59,7 @@ __Operational notes:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/subaccount-service-channels>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"region_host": schema.StringAttribute{
 				MarkdownDescription: "Region Host Name.",
 				Required:            true,
@@ -170,38 +170,30 @@ func (r *SubaccountK8SServiceChannelResource) Configure(ctx context.Context, req
 
 func (r *SubaccountK8SServiceChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan model.SubaccountK8SServiceChannelConfig
-	var respObj apiobjects.SubaccountK8SServiceChannels
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	regionHost := plan.RegionHost.ValueString()
 	subaccount := plan.Subaccount.ValueString()
-	endpoint := endpoints.GetSubaccountServiceChannelBaseEndpoint(regionHost, subaccount, "K8S")
 
-	planBody := map[string]any{
-		"k8sCluster":  plan.K8SClusterHost.ValueString(),
-		"k8sService":  plan.K8SServiceID.ValueString(),
-		"port":        fmt.Sprintf("%d", plan.LocalPort.ValueInt64()),
-		"connections": fmt.Sprintf("%d", plan.Connections.ValueInt64()),
-		"comment":     plan.Description.ValueString(),
-	}
-
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj.SubaccountK8SServiceChannels, "POST", endpoint, planBody, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	err := r.Client.K8SServiceChannels().Create(ctx, regionHost, subaccount, buildSubaccountK8SServiceChannelBody(plan))
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj.SubaccountK8SServiceChannels, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	serviceChannels, err := r.Client.K8SServiceChannels().List(ctx, regionHost, subaccount)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
-	serviceChannelRespObj, diags := r.getSubaccountK8SServiceChannel(respObj, plan.K8SClusterHost.ValueString())
+	serviceChannelRespObj, diags := r.getSubaccountK8SServiceChannel(serviceChannels, plan.K8SClusterHost.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -210,9 +202,8 @@ func (r *SubaccountK8SServiceChannelResource) Create(ctx context.Context, req re
 	id := serviceChannelRespObj.ID
 
 	if !plan.Enabled.IsNull() {
-		endpoint = endpoints.GetSubaccountServiceChannelEndpoint(regionHost, subaccount, "K8S", id)
-		enableDiags := r.enableSubaccountK8SServiceChannel(plan, *serviceChannelRespObj, endpoint+"/state")
-		if enableDiags.HasError() {
+		err := r.Client.K8SServiceChannels().SetEnabled(ctx, regionHost, subaccount, id, plan.Enabled.ValueBool())
+		if err != nil {
 			partialModel, partialDiags := model.SubaccountK8SServiceChannelValueFrom(ctx, plan, *serviceChannelRespObj)
 			if !partialDiags.HasError() {
 				_ = resp.State.Set(ctx, partialModel)
@@ -222,15 +213,16 @@ func (r *SubaccountK8SServiceChannelResource) Create(ctx context.Context, req re
 					ID:         partialModel.ID,
 				})
 			}
-			resp.Diagnostics.Append(enableDiags...)
+			resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 			return
 		}
 
-		diags = helpers.RequestAndUnmarshal(r.Client, &serviceChannelRespObj, "GET", endpoint, nil, true)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
+		serviceChannel, err := r.Client.K8SServiceChannels().Get(ctx, regionHost, subaccount, id)
+		if err != nil {
+			resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 			return
 		}
+		serviceChannelRespObj = &serviceChannel
 	}
 
 	responseModel, diags := model.SubaccountK8SServiceChannelValueFrom(ctx, plan, *serviceChannelRespObj)
@@ -257,21 +249,25 @@ func (r *SubaccountK8SServiceChannelResource) Create(ctx context.Context, req re
 
 func (r *SubaccountK8SServiceChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state model.SubaccountK8SServiceChannelConfig
-	var respObj apiobjects.SubaccountK8SServiceChannel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
 	regionHost := state.RegionHost.ValueString()
 	subaccount := state.Subaccount.ValueString()
 	id := state.ID.ValueInt64()
-	endpoint := endpoints.GetSubaccountServiceChannelEndpoint(regionHost, subaccount, "K8S", id)
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.K8SServiceChannels().Get(ctx, regionHost, subaccount, id)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -299,7 +295,6 @@ func (r *SubaccountK8SServiceChannelResource) Read(ctx context.Context, req reso
 
 func (r *SubaccountK8SServiceChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, state model.SubaccountK8SServiceChannelConfig
-	var respObj apiobjects.SubaccountK8SServiceChannel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -307,6 +302,8 @@ func (r *SubaccountK8SServiceChannelResource) Update(ctx context.Context, req re
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -323,28 +320,24 @@ func (r *SubaccountK8SServiceChannelResource) Update(ctx context.Context, req re
 		return
 	}
 	// Update Service Channel
-	endpoint := endpoints.GetSubaccountServiceChannelEndpoint(regionHost, subaccount, "K8S", id)
-	diags = r.updateSubaccountK8SServiceChannel(plan, respObj, endpoint)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	err := r.Client.K8SServiceChannels().Update(ctx, regionHost, subaccount, id, buildSubaccountK8SServiceChannelBody(plan))
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
 	// Enable/Disable Service Channel
 	if plan.Enabled.ValueBool() != state.Enabled.ValueBool() {
-		diags = r.enableSubaccountK8SServiceChannel(plan, respObj, endpoint+"/state")
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
+		err = r.Client.K8SServiceChannels().SetEnabled(ctx, regionHost, subaccount, id, plan.Enabled.ValueBool())
+		if err != nil {
+			resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 			return
 		}
-
 	}
 
-	endpoint = endpoints.GetSubaccountServiceChannelEndpoint(regionHost, subaccount, "K8S", id)
-
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.K8SServiceChannels().Get(ctx, regionHost, subaccount, id)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -372,41 +365,28 @@ func (r *SubaccountK8SServiceChannelResource) Update(ctx context.Context, req re
 
 func (r *SubaccountK8SServiceChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state model.SubaccountK8SServiceChannelConfig
-	var respObj apiobjects.SubaccountK8SServiceChannel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
 	regionHost := state.RegionHost.ValueString()
 	subaccount := state.Subaccount.ValueString()
 	id := state.ID.ValueInt64()
 
-	endpoint := endpoints.GetSubaccountServiceChannelEndpoint(regionHost, subaccount, "K8S", id)
-
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "DELETE", endpoint, nil, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	responseModel, diags := model.SubaccountK8SServiceChannelValueFrom(ctx, state, respObj)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	diags = resp.State.Set(ctx, responseModel)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	err := r.Client.K8SServiceChannels().Delete(ctx, regionHost, subaccount, id)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 }
 
-func (r *SubaccountK8SServiceChannelResource) getSubaccountK8SServiceChannel(serviceChannels apiobjects.SubaccountK8SServiceChannels, targetK8SCluster string) (*apiobjects.SubaccountK8SServiceChannel, diag.Diagnostics) {
+func (r *SubaccountK8SServiceChannelResource) getSubaccountK8SServiceChannel(serviceChannels []apiobjects.SubaccountK8SServiceChannel, targetK8SCluster string) (*apiobjects.SubaccountK8SServiceChannel, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	for _, channel := range serviceChannels.SubaccountK8SServiceChannels {
+	for _, channel := range serviceChannels {
 		if channel.K8SClusterHost == targetK8SCluster {
 			return &channel, nil
 		}
@@ -415,37 +395,19 @@ func (r *SubaccountK8SServiceChannelResource) getSubaccountK8SServiceChannel(ser
 	return nil, diags
 }
 
-func (r *SubaccountK8SServiceChannelResource) enableSubaccountK8SServiceChannel(plan model.SubaccountK8SServiceChannelConfig, respObj apiobjects.SubaccountK8SServiceChannel, endpoint string) diag.Diagnostics {
-	planBody := map[string]any{
-		"enabled": fmt.Sprintf("%t", plan.Enabled.ValueBool()),
-	}
-
-	diags := helpers.RequestAndUnmarshal(r.Client, &respObj, "PUT", endpoint, planBody, false)
-	if diags.HasError() {
-		return diags
+func buildSubaccountK8SServiceChannelBody(plan model.SubaccountK8SServiceChannelConfig) apiobjects.SubaccountK8SServiceChannelRequest {
+	return apiobjects.SubaccountK8SServiceChannelRequest{
+		Connections:    plan.Connections.ValueInt64(),
+		Description:    plan.Description.ValueString(),
+		K8SClusterHost: plan.K8SClusterHost.ValueString(),
+		K8SServiceID:   plan.K8SServiceID.ValueString(),
+		LocalPort:      plan.LocalPort.ValueInt64(),
 	}
-
-	return diags
-}
-
-func (r *SubaccountK8SServiceChannelResource) updateSubaccountK8SServiceChannel(plan model.SubaccountK8SServiceChannelConfig, respObj apiobjects.SubaccountK8SServiceChannel, endpoint string) diag.Diagnostics {
-	planBody := map[string]any{
-		"k8sCluster":  plan.K8SClusterHost.ValueString(),
-		"k8sService":  plan.K8SServiceID.ValueString(),
-		"port":        fmt.Sprintf("%d", plan.LocalPort.ValueInt64()),
-		"connections": fmt.Sprintf("%d", plan.Connections.ValueInt64()),
-		"comment":     plan.Description.ValueString(),
-	}
-
-	diags := helpers.RequestAndUnmarshal(r.Client, &respObj, "PUT", endpoint, planBody, false)
-	if diags.HasError() {
-		return diags
-	}
-
-	return diags
 }
 
 func (rs *SubaccountK8SServiceChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = helpers.ImportInstanceID(ctx, rs.Client, req.ID, resp)
+
 	if req.ID != "" {
 		idParts := strings.Split(req.ID, ",")
 
@@ -214,6 +214,38 @@ func TestResourceSubaccount(t *testing.T) {
 		})
 	})
 
+	t.Run("happy path - wait for connection", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSubaccountWaitForConnection("scc_sa", regionHost, subaccount, "cloud-user", "cloud-password", "1m"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_subaccount.scc_sa", "tunnel.state", "Connected"),
+						resource.TestCheckResourceAttr("scc_subaccount.scc_sa", "wait_for_connection", "true"),
+						resource.TestCheckResourceAttr("scc_subaccount.scc_sa", "timeouts.create", "1m"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid timeout", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceSubaccountWaitForConnection("scc_sa", regionHost, subaccount, "cloud-user", "cloud-password", "ten minutes"),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Value Time Duration`),
+				},
+			},
+		})
+	})
+
 	t.Run("error path - region host mandatory", func(t *testing.T) {
 		rec, user := tfutils.SetupVCR(t, "fixtures/resource_subaccount_err_wo_region_host")
 
@@ -373,6 +405,23 @@ resource "scc_subaccount" "%s" {
 `, datasourceName, regionHost, subaccount, cloudUser, cloudPassword, description, connected)
 }
 
+func ResourceSubaccountWaitForConnection(datasourceName, regionHost, subaccount, cloudUser, cloudPassword, createTimeout string) string {
+	return fmt.Sprintf(`
+resource "scc_subaccount" "%s" {
+  region_host         = "%s"
+  subaccount          = "%s"
+  cloud_user          = "%s"
+  cloud_password      = "%s"
+  connected           = true
+  wait_for_connection = true
+
+  timeouts {
+    create = "%s"
+  }
+}
+`, datasourceName, regionHost, subaccount, cloudUser, cloudPassword, createTimeout)
+}
+
 func getImportStateForSubaccount(resourceName string) resource.ImportStateIdFunc {
 	return func(state *terraform.State) (string, error) {
 		rs, ok := state.RootModule().Resources[resourceName]
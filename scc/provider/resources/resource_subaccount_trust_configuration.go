@@ -0,0 +1,375 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &SubaccountTrustConfigurationResource{}
+
+func NewSubaccountTrustConfigurationResource() resource.Resource {
+	return &SubaccountTrustConfigurationResource{}
+}
+
+type SubaccountTrustConfigurationResource struct {
+	Client *api.RestApiClient
+}
+
+type subaccountTrustConfigurationResourceIdentityModel struct {
+	Subaccount types.String `tfsdk:"subaccount"`
+	RegionHost types.String `tfsdk:"region_host"`
+}
+
+func (r *SubaccountTrustConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subaccount_trust_configuration"
+}
+
+func (r *SubaccountTrustConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Subaccount Trust Configuration Resource.
+
+Manages which identity providers and applications of a subaccount are trusted for principal propagation. The Cloud Connector synchronizes them from SAP BTP, either once with the ` + "`scc_sync_subaccount_trust_configuration`" + ` action or automatically with ` + "`auto_trust_sync`" + ` of ` + "`scc_subaccount`" + `. Only trusted identity providers and applications may propagate principals to backend systems.
+
+Identity providers and applications that are added by a later synchronization are reported as drift until they are added to the trusted lists or the lists are applied again.
+
+On destroy, all synchronized identity providers and applications are trusted again, which is the default of the Cloud Connector.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Subaccount Administrator
+* Synchronize the trust configuration before you trust an identity provider or application that was added in SAP BTP.
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/set-up-trust-for-principal-propagation>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
+			"region_host": schema.StringAttribute{
+				MarkdownDescription: "Region Host Name. Changing this value forces the resource to be recreated.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subaccount": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subaccount. Changing this value forces the resource to be recreated.",
+				Required:            true,
+				Validators: []validator.String{
+					uuidvalidator.ValidUUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"trusted_identity_providers": schema.SetAttribute{
+				MarkdownDescription: "The names of the synchronized identity providers that are trusted. All other identity providers are not trusted. If not set, the trusted identity providers are left unchanged.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"trusted_applications": schema.SetAttribute{
+				MarkdownDescription: "The names of the synchronized applications that are trusted. All other applications are not trusted. If not set, the trusted applications are left unchanged.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"identity_providers": schema.SetAttribute{
+				MarkdownDescription: "The names of all identity providers synchronized from SAP BTP.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"applications": schema.SetAttribute{
+				MarkdownDescription: "The names of all applications synchronized from SAP BTP.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_trust_sync": schema.BoolAttribute{
+				MarkdownDescription: "Indicates whether the trust configuration is synchronized automatically. Managed by `auto_trust_sync` of `scc_subaccount`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (rs *SubaccountTrustConfigurationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"subaccount": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+			"region_host": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *SubaccountTrustConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = client
+}
+
+func (r *SubaccountTrustConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.createOrUpdate(ctx, req.Plan, &resp.Diagnostics, &resp.State, &resp.Identity)
+}
+
+func (r *SubaccountTrustConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state model.SubaccountTrustConfigurationConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	respObj, err := r.Client.Subaccounts().GetTrust(ctx, state.RegionHost.ValueString(), state.Subaccount.ValueString())
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	r.setState(ctx, state, respObj, &resp.State, &resp.Identity, &resp.Diagnostics)
+}
+
+func (r *SubaccountTrustConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.createOrUpdate(ctx, req.Plan, &resp.Diagnostics, &resp.State, &resp.Identity)
+}
+
+func (r *SubaccountTrustConfigurationResource) createOrUpdate(ctx context.Context, requestPlan tfsdk.Plan, responseDiagnostics *diag.Diagnostics, responseState *tfsdk.State, responseIdentity **tfsdk.ResourceIdentity) {
+	var plan model.SubaccountTrustConfigurationConfig
+	diags := requestPlan.Get(ctx, &plan)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
+	regionHost := plan.RegionHost.ValueString()
+	subaccount := plan.Subaccount.ValueString()
+
+	current, err := r.Client.Subaccounts().GetTrust(ctx, regionHost, subaccount)
+	if err != nil {
+		responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	identityProviders, ok := r.trustedRequest(ctx, plan.TrustedIdentityProviders, current.IdentityProviders, path.Root("trusted_identity_providers"), "Identity Provider", responseDiagnostics)
+	if ok {
+		if err := r.Client.Subaccounts().SetTrustedIdentityProviders(ctx, regionHost, subaccount, identityProviders); err != nil {
+			responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+			return
+		}
+	}
+
+	applications, ok := r.trustedRequest(ctx, plan.TrustedApplications, current.Applications, path.Root("trusted_applications"), "Application", responseDiagnostics)
+	if ok {
+		if err := r.Client.Subaccounts().SetTrustedApplications(ctx, regionHost, subaccount, applications); err != nil {
+			responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+			return
+		}
+	}
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	respObj, err := r.Client.Subaccounts().GetTrust(ctx, regionHost, subaccount)
+	if err != nil {
+		responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	r.setState(ctx, plan, respObj, responseState, responseIdentity, responseDiagnostics)
+}
+
+// trustedRequest returns the synchronized entities with the planned trust and
+// whether they differ from the current trust. Names that are not synchronized
+// are reported as errors.
+func (r *SubaccountTrustConfigurationResource) trustedRequest(ctx context.Context, planned types.Set, current []apiobjects.SubaccountTrusted, attributePath path.Path, kind string, diags *diag.Diagnostics) ([]apiobjects.SubaccountTrusted, bool) {
+	if planned.IsNull() || planned.IsUnknown() || diags.HasError() {
+		return nil, false
+	}
+
+	var trusted []string
+	diags.Append(planned.ElementsAs(ctx, &trusted, false)...)
+	if diags.HasError() {
+		return nil, false
+	}
+
+	request, unknown := model.SubaccountTrustedRequest(current, trusted)
+	if len(unknown) > 0 {
+		diags.AddAttributeError(
+			attributePath,
+			"Unknown "+kind,
+			fmt.Sprintf("The trust configuration of the subaccount contains no %s named %s. Synchronize the trust configuration with the scc_sync_subaccount_trust_configuration action and check the names.", strings.ToLower(kind), strings.Join(unknown, ", ")),
+		)
+		return nil, false
+	}
+
+	return request, !slices.Equal(request, current)
+}
+
+func (r *SubaccountTrustConfigurationResource) setState(ctx context.Context, plan model.SubaccountTrustConfigurationConfig, value apiobjects.SubaccountTrustConfiguration, responseState *tfsdk.State, responseIdentity **tfsdk.ResourceIdentity, responseDiagnostics *diag.Diagnostics) {
+	responseModel, diags := model.SubaccountTrustConfigurationValueFrom(ctx, plan, value)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	diags = responseState.Set(ctx, responseModel)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	identity := subaccountTrustConfigurationResourceIdentityModel{
+		Subaccount: plan.Subaccount,
+		RegionHost: plan.RegionHost,
+	}
+
+	diags = (*responseIdentity).Set(ctx, identity)
+	responseDiagnostics.Append(diags...)
+}
+
+func (r *SubaccountTrustConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state model.SubaccountTrustConfigurationConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	regionHost := state.RegionHost.ValueString()
+	subaccount := state.Subaccount.ValueString()
+
+	current, err := r.Client.Subaccounts().GetTrust(ctx, regionHost, subaccount)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	// Trust all synchronized entities again, which is the default.
+	trustAll := func(entities []apiobjects.SubaccountTrusted) ([]apiobjects.SubaccountTrusted, bool) {
+		request := make([]apiobjects.SubaccountTrusted, 0, len(entities))
+		for _, entity := range entities {
+			entity.Trusted = true
+			request = append(request, entity)
+		}
+		return request, !slices.Equal(request, entities)
+	}
+
+	if identityProviders, changed := trustAll(current.IdentityProviders); changed {
+		if err := r.Client.Subaccounts().SetTrustedIdentityProviders(ctx, regionHost, subaccount, identityProviders); err != nil {
+			resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+			return
+		}
+	}
+
+	if applications, changed := trustAll(current.Applications); changed {
+		if err := r.Client.Subaccounts().SetTrustedApplications(ctx, regionHost, subaccount, applications); err != nil {
+			resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (rs *SubaccountTrustConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = helpers.ImportInstanceID(ctx, rs.Client, req.ID, resp)
+
+	if req.ID != "" {
+		idParts := strings.Split(req.ID, ",")
+
+		if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: region_host, subaccount. Got: %q", req.ID),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region_host"), idParts[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subaccount"), idParts[1])...)
+
+		return
+	}
+
+	var identity subaccountTrustConfigurationResourceIdentityModel
+	diags := resp.Identity.Get(ctx, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subaccount"), identity.Subaccount)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region_host"), identity.RegionHost)...)
+}
@@ -0,0 +1,260 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResourceSubaccountTrustConfiguration(t *testing.T) {
+	regionHost := "cf.eu12.hana.ondemand.com"
+	subaccount := "9f7390c8-f201-4b2d-b751-04c0a63c2671"
+	t.Parallel()
+
+	// setupTrust starts a fake Cloud Connector with a subaccount whose trust
+	// configuration has been synchronized from SAP BTP.
+	setupTrust := func(t *testing.T) (*sccmock.Server, tfutils.User) {
+		srv, user := tfutils.SetupMock(t)
+		srv.Update(func(state *sccmock.State) {
+			state.Subaccounts = append(state.Subaccounts, &sccmock.Subaccount{
+				RegionHost:    regionHost,
+				ID:            subaccount,
+				AutoTrustSync: true,
+				IdentityProviders: []apiobjects.SubaccountTrusted{
+					{Name: "accounts.sap.com", Trusted: true},
+					{Name: "corporate-idp", Description: "Corporate IdP", Trusted: true},
+				},
+				Applications: []apiobjects.SubaccountTrusted{
+					{Name: "app-a", Trusted: true},
+					{Name: "app-b", Trusted: false},
+				},
+			})
+		})
+		return srv, user
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		srv, user := setupTrust(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			// All synchronized entities are trusted again.
+			CheckDestroy: func(_ *terraform.State) error {
+				var err error
+				srv.Update(func(state *sccmock.State) {
+					sa := state.Subaccount(regionHost, subaccount)
+					for _, entity := range slices.Concat(sa.IdentityProviders, sa.Applications) {
+						if !entity.Trusted {
+							err = fmt.Errorf("%s is still not trusted", entity.Name)
+						}
+					}
+				})
+				return err
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSubaccountTrustConfigurationWoApplications("scc_trust", regionHost, subaccount, `["corporate-idp"]`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_subaccount_trust_configuration.scc_trust", "trusted_identity_providers.#", "1"),
+						resource.TestCheckTypeSetElemAttr("scc_subaccount_trust_configuration.scc_trust", "trusted_identity_providers.*", "corporate-idp"),
+						resource.TestCheckResourceAttr("scc_subaccount_trust_configuration.scc_trust", "trusted_applications.#", "1"),
+						resource.TestCheckTypeSetElemAttr("scc_subaccount_trust_configuration.scc_trust", "trusted_applications.*", "app-a"),
+						resource.TestCheckResourceAttr("scc_subaccount_trust_configuration.scc_trust", "identity_providers.#", "2"),
+						resource.TestCheckResourceAttr("scc_subaccount_trust_configuration.scc_trust", "applications.#", "2"),
+						resource.TestCheckResourceAttr("scc_subaccount_trust_configuration.scc_trust", "auto_trust_sync", "true"),
+						checkTrusted(srv, regionHost, subaccount, "accounts.sap.com", false),
+						checkTrusted(srv, regionHost, subaccount, "corporate-idp", true),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity(
+							"scc_subaccount_trust_configuration.scc_trust",
+							map[string]knownvalue.Check{
+								"region_host": knownvalue.StringExact(regionHost),
+								"subaccount":  knownvalue.StringExact(subaccount),
+							},
+						),
+					},
+				},
+				{
+					ResourceName:                         "scc_subaccount_trust_configuration.scc_trust",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        regionHost + "," + subaccount,
+					ImportStateVerifyIdentifierAttribute: "subaccount",
+				},
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSubaccountTrustConfiguration("scc_trust", regionHost, subaccount, `["corporate-idp"]`, `["app-a", "app-b"]`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_subaccount_trust_configuration.scc_trust", "trusted_applications.#", "2"),
+						checkTrusted(srv, regionHost, subaccount, "app-b", true),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - drift is reverted", func(t *testing.T) {
+		srv, user := setupTrust(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSubaccountTrustConfiguration("scc_trust", regionHost, subaccount, `["corporate-idp"]`, `["app-a"]`),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.Subaccount(regionHost, subaccount).IdentityProviders[0].Trusted = true
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceSubaccountTrustConfiguration("scc_trust", regionHost, subaccount, `["corporate-idp"]`, `["app-a"]`),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_subaccount_trust_configuration.scc_trust", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: checkTrusted(srv, regionHost, subaccount, "accounts.sap.com", false),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - synchronized identity provider is not trusted", func(t *testing.T) {
+		srv, user := setupTrust(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSubaccountTrustConfiguration("scc_trust", regionHost, subaccount, `["corporate-idp"]`, `["app-a"]`),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							sa := state.Subaccount(regionHost, subaccount)
+							sa.IdentityProviders = append(sa.IdentityProviders, apiobjects.SubaccountTrusted{Name: "new-idp", Trusted: true})
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceSubaccountTrustConfiguration("scc_trust", regionHost, subaccount, `["corporate-idp"]`, `["app-a"]`),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_subaccount_trust_configuration.scc_trust", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_subaccount_trust_configuration.scc_trust", "identity_providers.#", "3"),
+						checkTrusted(srv, regionHost, subaccount, "new-idp", false),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - unknown identity provider", func(t *testing.T) {
+		srv, user := setupTrust(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config:      tfutils.ProviderConfig(user) + ResourceSubaccountTrustConfiguration("scc_trust", regionHost, subaccount, `["unknown-idp"]`, `["app-b"]`),
+					ExpectError: regexp.MustCompile(`Unknown Identity Provider`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid subaccount", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceSubaccountTrustConfigurationWoApplications("scc_trust", regionHost, "invalid-subaccount", `["corporate-idp"]`),
+					ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid import id", func(t *testing.T) {
+		srv, user := setupTrust(t)
+
+		steps := []resource.TestStep{
+			{
+				Config: tfutils.ProviderConfig(user) + ResourceSubaccountTrustConfigurationWoApplications("scc_trust", regionHost, subaccount, `["corporate-idp"]`),
+			},
+		}
+		for _, id := range []string{regionHost, regionHost + ",", regionHost + "," + subaccount + ",extra"} {
+			steps = append(steps, resource.TestStep{
+				ResourceName:  "scc_subaccount_trust_configuration.scc_trust",
+				ImportState:   true,
+				ImportStateId: id,
+				ExpectError:   regexp.MustCompile(`Expected import identifier with format: region_host, subaccount`),
+			})
+		}
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps:                    steps,
+		})
+	})
+}
+
+// checkTrusted verifies whether the synchronized identity provider or
+// application with the given name is trusted on the fake Cloud Connector.
+func checkTrusted(srv *sccmock.Server, regionHost string, subaccount string, name string, trusted bool) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		var err error
+		srv.Update(func(state *sccmock.State) {
+			sa := state.Subaccount(regionHost, subaccount)
+			for _, entity := range slices.Concat(sa.IdentityProviders, sa.Applications) {
+				if entity.Name == name {
+					if entity.Trusted != trusted {
+						err = fmt.Errorf("expected %s to be trusted: %t", name, trusted)
+					}
+					return
+				}
+			}
+			err = fmt.Errorf("%s not synchronized", name)
+		})
+		return err
+	}
+}
+
+func ResourceSubaccountTrustConfiguration(resourceName string, regionHost string, subaccount string, trustedIdentityProviders string, trustedApplications string) string {
+	return fmt.Sprintf(`
+	resource "scc_subaccount_trust_configuration" "%s" {
+		region_host = "%s"
+		subaccount = "%s"
+		trusted_identity_providers = %s
+		trusted_applications = %s
+	}
+	`, resourceName, regionHost, subaccount, trustedIdentityProviders, trustedApplications)
+}
+
+func ResourceSubaccountTrustConfigurationWoApplications(resourceName string, regionHost string, subaccount string, trustedIdentityProviders string) string {
+	return fmt.Sprintf(`
+	resource "scc_subaccount_trust_configuration" "%s" {
+		region_host = "%s"
+		subaccount = "%s"
+		trusted_identity_providers = %s
+	}
+	`, resourceName, regionHost, subaccount, trustedIdentityProviders)
+}
@@ -7,10 +7,10 @@ import (
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -55,6 +55,7 @@ Automatic renewal requires two steps. Configure it in this resource, and also en
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/subaccount>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"region_host": schema.StringAttribute{
 				MarkdownDescription: "Region Host Name.",
 				Computed:            true,
@@ -111,11 +112,19 @@ The actual tunnel status is reported by the Cloud Connector and may differ:
 
 **Important:**  
 In case of *ConnectFailure*, the provider will issue a warning but will **not reset** the value of connected.  
-To recover, set connected = false, apply, and then set it back to true to retry the connection.`,
+To recover, set connected = false, apply, and then set it back to true to retry the connection.  
+Alternatively, set ` + "`wait_for_connection`" + ` to let the provider wait for the tunnel and retry the connection automatically.`,
 				Optional: true,
 				Computed: true,
 				Default:  booldefault.StaticBool(true),
 			},
+			"wait_for_connection": schema.BoolAttribute{
+				MarkdownDescription: "Whether create and update wait until the tunnel has reached the state requested by `connected`. " +
+					"The tunnel state is polled with increasing intervals, and failed connection attempts are retried automatically until the timeout configured in the `timeouts` block elapses, 10 minutes by default. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"is_managed": schema.BoolAttribute{
 				MarkdownDescription: "Indicates whether the subaccount to be created should be a managed subaccount (as of version 2.19). Cannot be changed after creation.",
 				Optional:            true,
@@ -237,6 +246,9 @@ To recover, set connected = false, apply, and then set it back to true to retry
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true}),
+		},
 	}
 }
 
@@ -274,13 +286,14 @@ func (r *SubaccountUsingAuthResource) Configure(ctx context.Context, req resourc
 
 func (r *SubaccountUsingAuthResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan model.SubaccountUsingAuthConfig
-	var respObj apiobjects.SubaccountUsingAuthResource
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	if plan.AuthenticationData.IsNull() || plan.AuthenticationData.IsUnknown() {
 		resp.Diagnostics.AddError(
 			"Missing required credentials",
@@ -289,26 +302,24 @@ func (r *SubaccountUsingAuthResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	endpoint := endpoints.GetSubaccountBaseEndpoint()
-
-	planBody := map[string]any{
-		"authenticationData": plan.AuthenticationData.ValueString(),
-		"description":        plan.Description.ValueString(),
-		"locationID":         plan.LocationID.ValueString(),
-		"displayName":        plan.DisplayName.ValueString(),
-	}
-
-	if !plan.IsManaged.IsNull() && !plan.IsManaged.IsUnknown() {
-		planBody["isManaged"] = plan.IsManaged.ValueBool()
+	resp.Diagnostics.Append(helpers.RequireVersion(ctx, r.Client, api.VersionAuthenticationData, path.Root("authentication_data"))...)
+	resp.Diagnostics.Append(requireManagedSubaccountVersion(ctx, r.Client, plan.IsManaged, plan.AutoCertificateRenewal)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !plan.AutoCertificateRenewal.IsNull() && !plan.AutoCertificateRenewal.IsUnknown() {
-		planBody["autoCertRenewal"] = plan.AutoCertificateRenewal.ValueBool()
+	planBody := apiobjects.SubaccountCreateWithAuthenticationDataRequest{
+		AuthenticationData:     plan.AuthenticationData.ValueString(),
+		Description:            plan.Description.ValueString(),
+		LocationID:             plan.LocationID.ValueString(),
+		DisplayName:            plan.DisplayName.ValueString(),
+		IsManaged:              helpers.BoolPointerOrNil(plan.IsManaged),
+		AutoCertificateRenewal: helpers.BoolPointerOrNil(plan.AutoCertificateRenewal),
 	}
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "POST", endpoint, planBody, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.Subaccounts().CreateWithAuthenticationData(ctx, planBody)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -317,26 +328,27 @@ func (r *SubaccountUsingAuthResource) Create(ctx context.Context, req resource.C
 	subaccount := respObj.Subaccount
 
 	if !plan.Connected.IsNull() && !plan.Connected.IsUnknown() {
-		endpoint = endpoints.GetSubaccountEndpoint(regionHost, subaccount)
-		diags = r.updateTunnelState(plan, connectedState, endpoint, &respObj)
+		diags = r.updateTunnelState(ctx, plan, connectedState, regionHost, subaccount, &respObj)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
 
-	if respObj.Tunnel.State == "ConnectFailure" {
+	waitDiags := helpers.WaitForPlannedTunnel(ctx, r.Client, regionHost, subaccount, plan.WaitForConnection, plan.Connected, plan.Timeouts, "create", &respObj)
+
+	if respObj.Tunnel.State == "ConnectFailure" && !plan.WaitForConnection.ValueBool() {
 		resp.Diagnostics.AddWarning(
 			"Tunnel connection failed",
 			"The subaccount was created/updated successfully, but the tunnel could not be established (state=ConnectFailure). "+
-				"You can retry by toggling 'connected' from false to true.",
+				"You can retry by toggling 'connected' from false to true, or set 'wait_for_connection' to let the provider retry automatically.",
 		)
 	}
 
 	if respObj.Tunnel.State == "Connected" {
 		// Trigger trust configuration sync for the subaccount without persisting to Terraform state
 		autoTrustSync := plan.AutoTrustSync.ValueBool()
-		diags = r.syncTrustConfiguration(regionHost, subaccount, &respObj, autoTrustSync)
+		diags = r.syncTrustConfiguration(ctx, regionHost, subaccount, autoTrustSync)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -365,29 +377,37 @@ func (r *SubaccountUsingAuthResource) Create(ctx context.Context, req resource.C
 
 	diags = resp.Identity.Set(ctx, identity)
 	resp.Diagnostics.Append(diags...)
+
+	// Waiting errors are reported after the state is stored, so that the
+	// subaccount is not lost if the tunnel does not settle in time.
+	resp.Diagnostics.Append(waitDiags...)
 }
 
 func (r *SubaccountUsingAuthResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state model.SubaccountUsingAuthConfig
-	var respObj apiobjects.SubaccountUsingAuthResource
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
 	regionHost := state.RegionHost.ValueString()
 	subaccount := state.Subaccount.ValueString()
-	endpoint := endpoints.GetSubaccountEndpoint(regionHost, subaccount)
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.Subaccounts().Get(ctx, regionHost, subaccount)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
 	if respObj.Tunnel.State == "Connected" {
-		diags = r.syncTrustConfiguration(regionHost, subaccount, &respObj, state.AutoTrustSync.ValueBool())
+		diags = r.syncTrustConfiguration(ctx, regionHost, subaccount, state.AutoTrustSync.ValueBool())
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -427,12 +447,13 @@ func (r *SubaccountUsingAuthResource) Read(ctx context.Context, req resource.Rea
 
 func (r *SubaccountUsingAuthResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, state model.SubaccountUsingAuthConfig
-	var respObj apiobjects.SubaccountUsingAuthResource
 
 	if diags := req.Plan.Get(ctx, &plan); appendAndCheckErrorsCopy(&resp.Diagnostics, diags) {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	if diags := req.State.Get(ctx, &state); appendAndCheckErrorsCopy(&resp.Diagnostics, diags) {
 		return
 	}
@@ -443,46 +464,51 @@ func (r *SubaccountUsingAuthResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	if !plan.AutoCertificateRenewal.Equal(state.AutoCertificateRenewal) {
+		resp.Diagnostics.Append(requireManagedSubaccountVersion(ctx, r.Client, types.BoolNull(), plan.AutoCertificateRenewal)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	regionHost := state.RegionHost.ValueString()
 	subaccount := state.Subaccount.ValueString()
-	endpoint := endpoints.GetSubaccountEndpoint(regionHost, subaccount)
 
-	updateBody := map[string]any{
-		"locationID":  plan.LocationID.ValueString(),
-		"displayName": plan.DisplayName.ValueString(),
-		"description": plan.Description.ValueString(),
+	updateBody := apiobjects.SubaccountUpdateRequest{
+		LocationID:             plan.LocationID.ValueString(),
+		DisplayName:            plan.DisplayName.ValueString(),
+		Description:            plan.Description.ValueString(),
+		AutoCertificateRenewal: helpers.BoolPointerOrNil(plan.AutoCertificateRenewal),
 	}
 
-	if !plan.AutoCertificateRenewal.IsNull() && !plan.AutoCertificateRenewal.IsUnknown() {
-		updateBody["autoCertRenewal"] = plan.AutoCertificateRenewal.ValueBool()
-	}
-
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "PUT", endpoint, updateBody, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.Subaccounts().Update(ctx, regionHost, subaccount, updateBody)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
 	connectedState := respObj.Tunnel.State == "Connected"
 
 	if !plan.Connected.IsNull() && !plan.Connected.IsUnknown() {
-		diags = r.updateTunnelState(plan, connectedState, endpoint, &respObj)
+		diags = r.updateTunnelState(ctx, plan, connectedState, regionHost, subaccount, &respObj)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
 
-	if respObj.Tunnel.State == "ConnectFailure" {
+	waitDiags := helpers.WaitForPlannedTunnel(ctx, r.Client, regionHost, subaccount, plan.WaitForConnection, plan.Connected, plan.Timeouts, "update", &respObj)
+
+	if respObj.Tunnel.State == "ConnectFailure" && !plan.WaitForConnection.ValueBool() {
 		resp.Diagnostics.AddWarning(
 			"Tunnel connection failed",
 			"The subaccount was created/updated successfully, but the tunnel could not be established (state=ConnectFailure). "+
-				"You can retry by toggling 'connected' from false to true.",
+				"You can retry by toggling 'connected' from false to true, or set 'wait_for_connection' to let the provider retry automatically.",
 		)
 	}
 
 	if respObj.Tunnel.State == "Connected" {
-		diags = r.syncTrustConfiguration(regionHost, subaccount, &respObj, plan.AutoTrustSync.ValueBool())
+		diags = r.syncTrustConfiguration(ctx, regionHost, subaccount, plan.AutoTrustSync.ValueBool())
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -506,6 +532,10 @@ func (r *SubaccountUsingAuthResource) Update(ctx context.Context, req resource.U
 
 	diags = resp.Identity.Set(ctx, identity)
 	resp.Diagnostics.Append(diags...)
+
+	// Waiting errors are reported after the state is stored, so that the
+	// subaccount is not lost if the tunnel does not settle in time.
+	resp.Diagnostics.Append(waitDiags...)
 }
 
 func appendAndCheckErrorsCopy(diags *diag.Diagnostics, newDiags diag.Diagnostics) bool {
@@ -513,12 +543,10 @@ func appendAndCheckErrorsCopy(diags *diag.Diagnostics, newDiags diag.Diagnostics
 	return diags.HasError()
 }
 
-func (r *SubaccountUsingAuthResource) syncTrustConfiguration(regionHost, subaccount string, respObj *apiobjects.SubaccountUsingAuthResource, autoTrustSync bool) diag.Diagnostics {
-	endpoint := endpoints.GetSubaccountEndpoint(regionHost, subaccount) + "/trust"
-
+func (r *SubaccountUsingAuthResource) syncTrustConfiguration(ctx context.Context, regionHost, subaccount string, autoTrustSync bool) diag.Diagnostics {
 	// Manual sync always runs first — required before auto sync can be enabled,
 	// and the only path on older SCC versions.
-	diags := helpers.RequestAndUnmarshal(r.Client, respObj, "POST", endpoint, nil, false)
+	diags := api.ErrorDiagnostics(r.Client.Subaccounts().SyncTrust(ctx, regionHost, subaccount))
 	if diags.HasError() {
 		return diags
 	}
@@ -530,8 +558,7 @@ func (r *SubaccountUsingAuthResource) syncTrustConfiguration(regionHost, subacco
 		return diags
 	}
 
-	putBody := map[string]any{"autoSyncTrustEnabled": true}
-	diags = helpers.RequestAndUnmarshal(r.Client, respObj, "PUT", endpoint, putBody, false)
+	diags = api.ErrorDiagnostics(r.Client.Subaccounts().SetAutoTrustSync(ctx, regionHost, subaccount, true))
 	if diags.HasError() {
 		diags.AddError(
 			"Failed to enable automatic trust synchronization",
@@ -545,25 +572,24 @@ func (r *SubaccountUsingAuthResource) syncTrustConfiguration(regionHost, subacco
 
 func (r *SubaccountUsingAuthResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state model.SubaccountUsingAuthConfig
-	var respObj apiobjects.SubaccountUsingAuthResource
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 	regionHost := state.RegionHost.ValueString()
 	subaccount := state.Subaccount.ValueString()
 
-	endpoint := endpoints.GetSubaccountEndpoint(regionHost, subaccount)
-
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "DELETE", endpoint, nil, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	err := r.Client.Subaccounts().Delete(ctx, regionHost, subaccount)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 }
 
-func (r *SubaccountUsingAuthResource) updateTunnelState(plan model.SubaccountUsingAuthConfig, connectedState bool, endpoint string, respObj *apiobjects.SubaccountUsingAuthResource) diag.Diagnostics {
+func (r *SubaccountUsingAuthResource) updateTunnelState(ctx context.Context, plan model.SubaccountUsingAuthConfig, connectedState bool, regionHost, subaccount string, respObj *apiobjects.Subaccount) diag.Diagnostics {
 	var diags diag.Diagnostics
 	// Check if the desired state is different from the current state
 	desiredState := plan.Connected.ValueBool()
@@ -571,20 +597,14 @@ func (r *SubaccountUsingAuthResource) updateTunnelState(plan model.SubaccountUsi
 		return diags
 	}
 	// Update the tunnel state
-	patch := map[string]any{"connected": desiredState}
-
-	diags = helpers.RequestAndUnmarshal(r.Client, respObj, "PUT", endpoint+"/state", patch, false)
-	if diags.HasError() {
-		return diags
+	err := r.Client.Subaccounts().SetTunnelState(ctx, regionHost, subaccount, desiredState)
+	if err != nil {
+		return api.ErrorDiagnostics(err)
 	}
 
 	// Re-fetch to update tunnel state
-	diags = helpers.RequestAndUnmarshal(r.Client, respObj, "GET", endpoint, nil, true)
-	if diags.HasError() {
-		return diags
-	}
-
-	return diags
+	*respObj, err = r.Client.Subaccounts().Get(ctx, regionHost, subaccount)
+	return api.ErrorDiagnostics(err)
 }
 
 func validateAuthDataInputs(plan, state model.SubaccountUsingAuthConfig) diag.Diagnostics {
@@ -607,6 +627,8 @@ func validateAuthDataInputs(plan, state model.SubaccountUsingAuthConfig) diag.Di
 }
 
 func (rs *SubaccountUsingAuthResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = helpers.ImportInstanceID(ctx, rs.Client, req.ID, resp)
+
 	if req.ID != "" {
 		idParts := strings.Split(req.ID, ",")
 
@@ -9,11 +9,11 @@ import (
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	subjectpatternrules "github.com/SAP/terraform-provider-scc/validation/subjectPatternRules"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
@@ -62,6 +62,7 @@ __Tips:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/subject-pattern-rules>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"index": schema.Int64Attribute{
 				MarkdownDescription: "Index of the subject pattern rule to retrieve.",
 				Optional:            true,
@@ -182,54 +183,30 @@ func (r *SubjectPatternRuleResource) Create(ctx context.Context, req resource.Cr
 	defer subjectPatternRuleMu.Unlock()
 
 	var plan model.SubjectPatternRuleConfig
-	var respObj apiobjects.SubjectPatternRules
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetSubjectPatternRulesBaseEndpoint()
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
 
-	var condition model.SubjectPatternCondition
-	diags = plan.Condition.As(ctx, &condition, basetypes.ObjectAsOptions{})
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	var subjectPattern model.SubjectPattern
-	diags = plan.SubjectPattern.As(ctx, &subjectPattern, basetypes.ObjectAsOptions{})
+	planBody, diags := subjectPatternRuleRequest(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	planBody := map[string]any{
-		"description": plan.Description.ValueString(),
-		"condition":   buildConditionBody(condition),
-		"subjectPattern": apiobjects.SubjectPattern{
-			CommonName:       subjectPattern.CommonName.ValueString(),
-			Email:            subjectPattern.Email.ValueString(),
-			Locality:         subjectPattern.Locality.ValueString(),
-			OrganizationUnit: subjectPattern.OrganizationUnit.ValueString(),
-			Organization:     subjectPattern.Organization.ValueString(),
-			State:            subjectPattern.State.ValueString(),
-			Country:          subjectPattern.Country.ValueString(),
-		},
-	}
-
 	// Create the subject pattern rule
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "POST", endpoint, planBody, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	if err := r.Client.SubjectPatternRules().Create(ctx, planBody); err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
 	// Find all subject pattern rule in the response
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.SubjectPatternRules().List(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -271,7 +248,6 @@ func (r *SubjectPatternRuleResource) Read(ctx context.Context, req resource.Read
 	}
 
 	var state model.SubjectPatternRuleConfig
-	var respObj apiobjects.SubjectPatternRules
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -279,23 +255,19 @@ func (r *SubjectPatternRuleResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	diags = helpers.RequestAndUnmarshal(
-		r.Client,
-		&respObj,
-		"GET",
-		endpoints.GetSubjectPatternRulesBaseEndpoint(),
-		nil,
-		true,
-	)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	respObj, err := r.Client.SubjectPatternRules().List(ctx)
+	// The rule is only gone if the list of rules could be loaded without it, a
+	// failing request, even with status 404, must not drop it from the state.
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
 	var (
 		rule     *apiobjects.SubjectPatternRule
 		newIndex int
-		err      error
 	)
 
 	// Import case: only index is known.
@@ -354,7 +326,6 @@ func (r *SubjectPatternRuleResource) Update(ctx context.Context, req resource.Up
 	defer subjectPatternRuleMu.Unlock()
 
 	var plan, state model.SubjectPatternRuleConfig
-	var respObj apiobjects.SubjectPatternRules
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -362,6 +333,8 @@ func (r *SubjectPatternRuleResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -377,49 +350,23 @@ func (r *SubjectPatternRuleResource) Update(ctx context.Context, req resource.Up
 	}
 
 	index := int(state.Index.ValueInt64())
-	endpoint := endpoints.GetSubjectPatternRuleByIndexEndpoint(index)
 
-	var condition model.SubjectPatternCondition
-	diags = plan.Condition.As(ctx, &condition, basetypes.ObjectAsOptions{})
+	updateBody, diags := subjectPatternRuleRequest(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var subjectPattern model.SubjectPattern
-	diags = plan.SubjectPattern.As(ctx, &subjectPattern, basetypes.ObjectAsOptions{})
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	updateBody := map[string]any{
-		"description": plan.Description.ValueString(),
-		"condition":   buildConditionBody(condition),
-		"subjectPattern": apiobjects.SubjectPattern{
-			CommonName:       subjectPattern.CommonName.ValueString(),
-			Email:            subjectPattern.Email.ValueString(),
-			Locality:         subjectPattern.Locality.ValueString(),
-			OrganizationUnit: subjectPattern.OrganizationUnit.ValueString(),
-			Organization:     subjectPattern.Organization.ValueString(),
-			State:            subjectPattern.State.ValueString(),
-			Country:          subjectPattern.Country.ValueString(),
-		},
-	}
-
 	// Update the subject pattern rule
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "PUT", endpoint, updateBody, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	if err := r.Client.SubjectPatternRules().Update(ctx, index, updateBody); err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
-	endpoint = endpoints.GetSubjectPatternRulesBaseEndpoint()
-
 	// Find all subject pattern rule in the response
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.SubjectPatternRules().List(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -461,7 +408,6 @@ func (r *SubjectPatternRuleResource) Delete(ctx context.Context, req resource.De
 	}
 
 	var state model.SubjectPatternRuleConfig
-	var respObj apiobjects.SubjectPatternRules
 
 	subjectPatternRuleMu.Lock()
 	defer subjectPatternRuleMu.Unlock()
@@ -472,11 +418,13 @@ func (r *SubjectPatternRuleResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
 	// Re-fetch the live list to resolve the current index, since parallel
 	// deletions shift indices of subsequent rules.
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "GET", endpoints.GetSubjectPatternRulesBaseEndpoint(), nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.SubjectPatternRules().List(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -487,11 +435,8 @@ func (r *SubjectPatternRuleResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	endpoint := endpoints.GetSubjectPatternRuleByIndexEndpoint(liveIndex)
-
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "DELETE", endpoint, nil, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	if err := r.Client.SubjectPatternRules().Delete(ctx, liveIndex); err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -499,6 +444,8 @@ func (r *SubjectPatternRuleResource) Delete(ctx context.Context, req resource.De
 }
 
 func (rs *SubjectPatternRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = helpers.ImportInstanceID(ctx, rs.Client, req.ID, resp)
+
 	if req.ID != "" {
 		idParts := strings.Split(req.ID, ",")
 
@@ -534,16 +481,14 @@ func (rs *SubjectPatternRuleResource) ImportState(ctx context.Context, req resou
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("index"), identity.Index)...)
 }
 
-func buildConditionBody(condition model.SubjectPatternCondition) map[string]any {
-	m := map[string]any{
-		"variable": condition.Variable.ValueString(),
-		"operator": condition.Operator.ValueString(),
-	}
-	op := condition.Operator.ValueString()
-	if op != "exist" && op != "exist_not" {
-		m["value"] = condition.Value.ValueString()
-	}
-	return m
+// subjectPatternRuleRequest converts the rule into the request body of the
+// Cloud Connector.
+func subjectPatternRuleRequest(ctx context.Context, rule model.SubjectPatternRuleConfig) (apiobjects.SubjectPatternRuleRequest, diag.Diagnostics) {
+	return model.SubjectPatternRuleRequest(ctx, model.SubjectPatternRulesItem{
+		Description:    rule.Description,
+		Condition:      rule.Condition,
+		SubjectPattern: rule.SubjectPattern,
+	})
 }
 
 func findSubjectPatternRule(rules apiobjects.SubjectPatternRules, plan model.SubjectPatternRuleConfig) (*apiobjects.SubjectPatternRule, int, error) {
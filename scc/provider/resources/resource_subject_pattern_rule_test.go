@@ -6,9 +6,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
 	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -209,6 +211,68 @@ func TestResourceSubjectPatternRule(t *testing.T) {
 		})
 	})
 
+	t.Run("happy path - deleted outside of terraform is recreated", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSubjectPatternRule("scc_spr", "subject pattern rule added via terraform tests", defaultCondition, defaultSubjectPattern),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.SubjectPatternRules = nil
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceSubjectPatternRule("scc_spr", "subject pattern rule added via terraform tests", defaultCondition, defaultSubjectPattern),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_subject_pattern_rule.scc_spr", plancheck.ResourceActionCreate),
+						},
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("error path - rules cannot be listed", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSubjectPatternRule("scc_spr", "subject pattern rule added via terraform tests", defaultCondition, defaultSubjectPattern),
+				},
+				// A failing request must not drop the rule from the state.
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.Version = "2.17.0"
+						})
+					},
+					RefreshState: true,
+					ExpectError:  regexp.MustCompile(`No resource found for GET`),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.Version = sccmock.DefaultVersion
+						})
+					},
+					RefreshState: true,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_subject_pattern_rule.scc_spr", "description", "subject pattern rule added via terraform tests"),
+					),
+				},
+			},
+		})
+	})
+
 	t.Run("error path - description mandatory", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
 			IsUnitTest:               true,
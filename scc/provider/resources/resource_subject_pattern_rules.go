@@ -0,0 +1,587 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	subjectpatternrules "github.com/SAP/terraform-provider-scc/validation/subjectPatternRules"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource               = &SubjectPatternRulesResource{}
+	_ resource.ResourceWithModifyPlan = &SubjectPatternRulesResource{}
+)
+
+func NewSubjectPatternRulesResource() resource.Resource {
+	return &SubjectPatternRulesResource{}
+}
+
+type SubjectPatternRulesResource struct {
+	Client *api.RestApiClient
+}
+
+type subjectPatternRulesResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *SubjectPatternRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subject_pattern_rules"
+}
+
+func (r *SubjectPatternRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Subject Pattern Rules Resource.
+
+Manages all subject pattern rules of the Cloud Connector as one ordered list. The rules are evaluated in the order of the list and the first rule whose condition the principal fulfills determines the subject of the certificate issued for principal propagation.
+
+Each rule is identified by a hash of its content instead of its position, so inserting, removing or reordering rules only changes the rules that differ. Rules that exist on the Cloud Connector but not in the list are removed, and the default rule with the condition "always true", which is evaluated after all other rules, is left untouched.
+
+The resource takes over all rules of the Cloud Connector: on create, rules that already exist but are not in the list are removed and listed in a warning. Add them to the list to keep them.
+
+On destroy, all rules except the default rule are removed.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Subaccount Administrator
+* Do not combine this resource with ` + "`scc_subject_pattern_rule`" + ` on the same Cloud Connector, as both manage the same rules.
+* Use the ` + "`scc_subject_pattern_preview`" + ` data source to check the subject issued for a principal before applying the rules.
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/subject-pattern-rules>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "The subject pattern rules in the order of evaluation. A rule must not occur twice.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the rule, a hash of its description, condition and subject pattern.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the subject pattern rule. Defaults to an empty description.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"condition": schema.SingleNestedAttribute{
+							MarkdownDescription: "Condition of the subject pattern rule.",
+							Required:            true,
+							Validators: []validator.Object{
+								subjectpatternrules.SubjectPatternRuleCondition(),
+							},
+							Attributes: map[string]schema.Attribute{
+								"variable": schema.StringAttribute{
+									MarkdownDescription: "Variable of the condition to be evaluated.",
+									Required:            true,
+								},
+								"operator": schema.StringAttribute{
+									MarkdownDescription: "Operator of the condition. Possible values are `exist`, `exist_not`, `is` and `is_not`.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(
+											"exist",
+											"exist_not",
+											"is",
+											"is_not",
+										),
+									},
+								},
+								"value": schema.StringAttribute{
+									MarkdownDescription: "Value of the condition. Required when operator is \"is\" or \"is_not\".",
+									Optional:            true,
+								},
+							},
+						},
+						"subject_pattern": schema.SingleNestedAttribute{
+							MarkdownDescription: "Subject pattern of the subject pattern rule. Variables are referenced as `${variable}`.",
+							Required:            true,
+							Validators: []validator.Object{
+								subjectpatternrules.SubjectPatternAtLeastOneField(),
+							},
+							Attributes: map[string]schema.Attribute{
+								"cn": schema.StringAttribute{
+									MarkdownDescription: "Common Name (CN) of the subject pattern.",
+									Optional:            true,
+								},
+								"email": schema.StringAttribute{
+									MarkdownDescription: "Email (EMAIL) of the subject pattern.",
+									Optional:            true,
+								},
+								"l": schema.StringAttribute{
+									MarkdownDescription: "Locality (L) of the subject pattern.",
+									Optional:            true,
+								},
+								"ou": schema.StringAttribute{
+									MarkdownDescription: "Organization Unit (OU) of the subject pattern.",
+									Optional:            true,
+								},
+								"o": schema.StringAttribute{
+									MarkdownDescription: "Organization (O) of the subject pattern.",
+									Optional:            true,
+								},
+								"st": schema.StringAttribute{
+									MarkdownDescription: "State (ST) of the subject pattern.",
+									Optional:            true,
+								},
+								"c": schema.StringAttribute{
+									MarkdownDescription: "Country (C) of the subject pattern.",
+									Optional:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subject pattern rules resource. Used for import and identity purposes. The value is always `subject-pattern-rules`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SubjectPatternRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *SubjectPatternRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = client
+}
+
+// ModifyPlan sets the IDs of the planned rules, which only depend on their
+// content, so the plan shows which rules are kept.
+func (r *SubjectPatternRulesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var rules types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rules"), &rules)...)
+	if resp.Diagnostics.HasError() || rules.IsNull() || rules.IsUnknown() {
+		return
+	}
+
+	var items []model.SubjectPatternRulesItem
+	resp.Diagnostics.Append(rules.ElementsAs(ctx, &items, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, item := range items {
+		if !fullyKnown(ctx, item.Description, item.Condition, item.SubjectPattern) {
+			continue
+		}
+
+		request, diags := model.SubjectPatternRuleRequest(ctx, item)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		items[i].ID = types.StringValue(model.SubjectPatternRuleHash(request))
+	}
+
+	rules, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: model.SubjectPatternRulesItemType}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rules"), rules)...)
+}
+
+func (r *SubjectPatternRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan model.SubjectPatternRulesConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	removed := r.createOrUpdate(ctx, plan, &resp.Diagnostics, &resp.State, &resp.Identity)
+	if len(removed) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Existing subject pattern rules removed",
+			"The following subject pattern rules existed on the Cloud Connector but are not part of the configuration and were removed:"+
+				describeSubjectPatternRules(removed),
+		)
+	}
+}
+
+func (r *SubjectPatternRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state model.SubjectPatternRulesConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	respObj, err := r.Client.SubjectPatternRules().List(ctx)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	responseModel, diags := model.SubjectPatternRulesResourceValueFrom(ctx, state, respObj)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identity := subjectPatternRulesResourceIdentityModel{
+		ID: types.StringValue(model.SubjectPatternRulesID),
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *SubjectPatternRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan model.SubjectPatternRulesConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.createOrUpdate(ctx, plan, &resp.Diagnostics, &resp.State, &resp.Identity)
+}
+
+// createOrUpdate applies the planned rules and returns the rules that existed
+// on the Cloud Connector but are not planned.
+func (r *SubjectPatternRulesResource) createOrUpdate(ctx context.Context, plan model.SubjectPatternRulesConfig, responseDiagnostics *diag.Diagnostics, responseState *tfsdk.State, responseIdentity **tfsdk.ResourceIdentity) apiobjects.SubjectPatternRules {
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
+	var items []model.SubjectPatternRulesItem
+	diags := plan.Rules.ElementsAs(ctx, &items, false)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return nil
+	}
+
+	planned := make([]apiobjects.SubjectPatternRuleRequest, 0, len(items))
+	for _, item := range items {
+		request, diags := model.SubjectPatternRuleRequest(ctx, item)
+		responseDiagnostics.Append(diags...)
+		if responseDiagnostics.HasError() {
+			return nil
+		}
+		planned = append(planned, request)
+	}
+
+	respObj, removed, diags := r.applySubjectPatternRules(ctx, planned)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return nil
+	}
+
+	responseModel, diags := model.SubjectPatternRulesResourceValueFrom(ctx, plan, respObj)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return nil
+	}
+
+	diags = responseState.Set(ctx, responseModel)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return nil
+	}
+
+	identity := subjectPatternRulesResourceIdentityModel{
+		ID: types.StringValue(model.SubjectPatternRulesID),
+	}
+
+	diags = (*responseIdentity).Set(ctx, identity)
+	responseDiagnostics.Append(diags...)
+	return removed
+}
+
+func (r *SubjectPatternRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state model.SubjectPatternRulesConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	_, _, diags = r.applySubjectPatternRules(ctx, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *SubjectPatternRulesResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	req.ID = helpers.ImportInstanceID(ctx, r.Client, req.ID, resp)
+
+	if req.ID != model.SubjectPatternRulesID {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf(
+				"Expected import identifier %q. Got: %q",
+				model.SubjectPatternRulesID,
+				req.ID,
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("id"), model.SubjectPatternRulesID)...,
+	)
+}
+
+// applySubjectPatternRules changes the rules of the Cloud Connector, except
+// the default rule, into the planned rules and returns the resulting rules.
+// The rules can only be addressed by index and new rules are always added
+// after the existing ones, so it deletes the rules that are not kept, updates
+// the kept rules that differ at their position and creates the remaining ones.
+// It also returns the rules that existed before but are not planned.
+func (r *SubjectPatternRulesResource) applySubjectPatternRules(ctx context.Context, planned []apiobjects.SubjectPatternRuleRequest) (apiobjects.SubjectPatternRules, apiobjects.SubjectPatternRules, diag.Diagnostics) {
+	subjectPatternRuleMu.Lock()
+	defer subjectPatternRuleMu.Unlock()
+
+	service := r.Client.SubjectPatternRules()
+
+	live, err := service.List(ctx)
+	if err != nil {
+		return nil, nil, api.ErrorDiagnostics(err)
+	}
+
+	liveModel, diags := model.SubjectPatternRulesResourceValueFrom(ctx, model.SubjectPatternRulesConfig{}, live)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+	var items []model.SubjectPatternRulesItem
+	diags = liveModel.Rules.ElementsAs(ctx, &items, false)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	// The index of a rule on the Cloud Connector, which counts the default rule.
+	var indices []int
+	for i, rule := range live {
+		if !model.IsDefaultSubjectPatternRule(rule) {
+			indices = append(indices, i)
+		}
+	}
+
+	current := make([]apiobjects.SubjectPatternRuleRequest, 0, len(items))
+	for _, item := range items {
+		request, diags := model.SubjectPatternRuleRequest(ctx, item)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+		current = append(current, request)
+	}
+
+	var removed apiobjects.SubjectPatternRules
+	for i, rule := range current {
+		if !slices.Contains(planned, rule) {
+			removed = append(removed, live[indices[i]])
+		}
+	}
+
+	keep := keptSubjectPatternRules(current, planned)
+
+	// Delete from the end, so the indices of the preceding rules do not shift.
+	var deleted []int
+	for i := len(current) - 1; i >= 0; i-- {
+		if keep[i] {
+			continue
+		}
+		if err := service.Delete(ctx, indices[i]); err != nil {
+			return nil, nil, api.ErrorDiagnostics(err)
+		}
+		deleted = append(deleted, indices[i])
+	}
+
+	var kept []int
+	for i := range current {
+		if !keep[i] {
+			continue
+		}
+		for _, index := range deleted {
+			if index < indices[i] {
+				indices[i]--
+			}
+		}
+		kept = append(kept, i)
+	}
+
+	updated := 0
+	for position, i := range kept {
+		if current[i] == planned[position] {
+			continue
+		}
+		if err := service.Update(ctx, indices[i], planned[position]); err != nil {
+			return nil, nil, api.ErrorDiagnostics(err)
+		}
+		updated++
+	}
+
+	for _, rule := range planned[len(kept):] {
+		if err := service.Create(ctx, rule); err != nil {
+			return nil, nil, api.ErrorDiagnostics(err)
+		}
+	}
+
+	tflog.Debug(ctx, "Applied subject pattern rules", map[string]any{
+		"deleted": len(deleted),
+		"updated": updated,
+		"created": len(planned) - len(kept),
+	})
+
+	respObj, err := service.List(ctx)
+	if err != nil {
+		return nil, nil, api.ErrorDiagnostics(err)
+	}
+	return respObj, removed, nil
+}
+
+// keptSubjectPatternRules selects the current rules to keep at the start of
+// the planned rules, so that deleting the others, updating the kept rules that
+// differ from the planned rule at their position and creating the remaining
+// planned rules takes the fewest requests.
+func keptSubjectPatternRules(current, planned []apiobjects.SubjectPatternRuleRequest) []bool {
+	n, m := len(current), len(planned)
+
+	// matches[i][j] is the highest number of kept rules equal to the planned
+	// rule at their position if j of the first i current rules are kept, or -1.
+	matches := make([][]int, n+1)
+	for i := range matches {
+		matches[i] = make([]int, m+1)
+		for j := range matches[i] {
+			matches[i][j] = -1
+		}
+	}
+	matches[0][0] = 0
+	for i := 1; i <= n; i++ {
+		for j := 0; j <= min(i, m); j++ {
+			if j < i {
+				matches[i][j] = matches[i-1][j]
+			}
+			if j > 0 && matches[i-1][j-1] >= 0 {
+				matches[i][j] = max(matches[i][j], matches[i-1][j-1]+equalRules(current[i-1], planned[j-1]))
+			}
+		}
+	}
+
+	// Keeping k rules takes n-k deletions, k-matches updates and m-k creations,
+	// so the fewest requests keep the most rules plus matches.
+	k := 0
+	for j := 1; j <= min(n, m); j++ {
+		if j+matches[n][j] >= k+matches[n][k] {
+			k = j
+		}
+	}
+
+	keep := make([]bool, n)
+	for i, j := n, k; i > 0 && j > 0; i-- {
+		if matches[i-1][j-1] >= 0 && matches[i][j] == matches[i-1][j-1]+equalRules(current[i-1], planned[j-1]) {
+			keep[i-1] = true
+			j--
+		}
+	}
+	return keep
+}
+
+func equalRules(a, b apiobjects.SubjectPatternRuleRequest) int {
+	if a == b {
+		return 1
+	}
+	return 0
+}
+
+// describeSubjectPatternRules lists rules for a diagnostic, one per line.
+func describeSubjectPatternRules(rules apiobjects.SubjectPatternRules) string {
+	var b strings.Builder
+	for _, rule := range rules {
+		if rule.Description != "" {
+			fmt.Fprintf(&b, "\n- %q if %s", rule.Description, rule.Condition)
+		} else {
+			fmt.Fprintf(&b, "\n- if %s", rule.Condition)
+		}
+	}
+	return b.String()
+}
+
+func fullyKnown(ctx context.Context, values ...attr.Value) bool {
+	for _, value := range values {
+		tfValue, err := value.ToTerraformValue(ctx)
+		if err != nil || !tfValue.IsFullyKnown() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,264 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	technicalUsersRule = `{
+		description = "technical users"
+		condition = { variable = "user_type", operator = "is", value = "Technical" }
+		subject_pattern = { cn = "$${name}", ou = "Technical Users" }
+	}`
+	emailRule = `{
+		condition = { variable = "email", operator = "exist" }
+		subject_pattern = { cn = "$${name}", email = "$${email}" }
+	}`
+	noEmailRule = `{
+		description = ""
+		condition = { variable = "email", operator = "exist_not" }
+		subject_pattern = { cn = "$${name}", o = "ACME" }
+	}`
+)
+
+func TestResourceSubjectPatternRules(t *testing.T) {
+	t.Parallel()
+
+	// setupRules starts a fake Cloud Connector with the default rule, which is
+	// evaluated after all other rules, and the given rules.
+	setupRules := func(t *testing.T, rules ...*sccmock.SubjectPatternRule) (*sccmock.Server, tfutils.User) {
+		srv, user := tfutils.SetupMock(t)
+		srv.Update(func(state *sccmock.State) {
+			state.SubjectPatternRules = append([]*sccmock.SubjectPatternRule{{
+				Condition:      "always true",
+				SubjectPattern: apiobjects.SubjectPattern{CommonName: "${name}"},
+			}}, rules...)
+		})
+		return srv, user
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		srv, user := setupRules(t, &sccmock.SubjectPatternRule{
+			Description:    "unmanaged",
+			Condition:      "display_name is not Admin",
+			SubjectPattern: apiobjects.SubjectPattern{CommonName: "${display_name}"},
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			// Only the default rule is left.
+			CheckDestroy: checkSubjectPatternRules(srv, "always true"),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSubjectPatternRules("scc_sprs", technicalUsersRule, emailRule),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_subject_pattern_rules.scc_sprs", "id", "subject-pattern-rules"),
+						resource.TestCheckResourceAttr("scc_subject_pattern_rules.scc_sprs", "rules.#", "2"),
+						resource.TestCheckResourceAttrSet("scc_subject_pattern_rules.scc_sprs", "rules.0.id"),
+						resource.TestCheckResourceAttr("scc_subject_pattern_rules.scc_sprs", "rules.0.description", "technical users"),
+						resource.TestCheckResourceAttr("scc_subject_pattern_rules.scc_sprs", "rules.0.condition.variable", "user_type"),
+						resource.TestCheckResourceAttr("scc_subject_pattern_rules.scc_sprs", "rules.0.condition.operator", "is"),
+						resource.TestCheckResourceAttr("scc_subject_pattern_rules.scc_sprs", "rules.0.condition.value", "Technical"),
+						resource.TestCheckResourceAttr("scc_subject_pattern_rules.scc_sprs", "rules.0.subject_pattern.cn", "${name}"),
+						resource.TestCheckResourceAttr("scc_subject_pattern_rules.scc_sprs", "rules.0.subject_pattern.ou", "Technical Users"),
+						resource.TestCheckResourceAttr("scc_subject_pattern_rules.scc_sprs", "rules.1.description", ""),
+						resource.TestCheckResourceAttr("scc_subject_pattern_rules.scc_sprs", "rules.1.condition.operator", "exist"),
+						resource.TestCheckNoResourceAttr("scc_subject_pattern_rules.scc_sprs", "rules.1.condition.value"),
+						resource.TestCheckResourceAttr("scc_subject_pattern_rules.scc_sprs", "rules.1.subject_pattern.email", "${email}"),
+						// The unmanaged rule is taken over and removed.
+						checkSubjectPatternRules(srv, "always true", "user_type is Technical", "email exists"),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity(
+							"scc_subject_pattern_rules.scc_sprs",
+							map[string]knownvalue.Check{
+								"id": knownvalue.StringExact("subject-pattern-rules"),
+							},
+						),
+					},
+				},
+				{
+					ResourceName:                         "scc_subject_pattern_rules.scc_sprs",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "subject-pattern-rules",
+					ImportStateVerifyIdentifierAttribute: "id",
+				},
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSubjectPatternRules("scc_sprs", emailRule, noEmailRule, technicalUsersRule),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_subject_pattern_rules.scc_sprs", "rules.#", "3"),
+						resource.TestCheckResourceAttr("scc_subject_pattern_rules.scc_sprs", "rules.1.description", ""),
+						resource.TestCheckResourceAttr("scc_subject_pattern_rules.scc_sprs", "rules.2.description", "technical users"),
+						checkSubjectPatternRules(srv, "always true", "email exists", "email does not exist", "user_type is Technical"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - unchanged rules are kept", func(t *testing.T) {
+		srv, user := setupRules(t)
+
+		var kept []*sccmock.SubjectPatternRule
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSubjectPatternRules("scc_sprs", technicalUsersRule, emailRule, noEmailRule),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							kept = []*sccmock.SubjectPatternRule{state.SubjectPatternRules[1], state.SubjectPatternRules[3]}
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceSubjectPatternRules("scc_sprs", technicalUsersRule, noEmailRule),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						checkSubjectPatternRules(srv, "always true", "user_type is Technical", "email does not exist"),
+						// Deleting the rule in the middle does not rewrite the others.
+						func(_ *terraform.State) error {
+							var err error
+							srv.Update(func(state *sccmock.State) {
+								if state.SubjectPatternRules[1] != kept[0] || state.SubjectPatternRules[2] != kept[1] {
+									err = fmt.Errorf("expected the remaining rules to be kept")
+								}
+							})
+							return err
+						},
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - drift is reverted", func(t *testing.T) {
+		srv, user := setupRules(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSubjectPatternRules("scc_sprs", technicalUsersRule, emailRule),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.SubjectPatternRules[1].Description = "changed"
+							state.SubjectPatternRules = append(state.SubjectPatternRules[:2], &sccmock.SubjectPatternRule{
+								Condition:      "login_name exists",
+								SubjectPattern: apiobjects.SubjectPattern{CommonName: "${login_name}"},
+							})
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceSubjectPatternRules("scc_sprs", technicalUsersRule, emailRule),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_subject_pattern_rules.scc_sprs", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_subject_pattern_rules.scc_sprs", "rules.0.description", "technical users"),
+						checkSubjectPatternRules(srv, "always true", "user_type is Technical", "email exists"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - empty rules", func(t *testing.T) {
+		srv, user := setupRules(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSubjectPatternRules("scc_sprs"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_subject_pattern_rules.scc_sprs", "rules.#", "0"),
+						checkSubjectPatternRules(srv, "always true"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - duplicate rules", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceSubjectPatternRules("scc_sprs", emailRule, emailRule),
+					ExpectError: regexp.MustCompile(`Duplicate List Value`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid import id", func(t *testing.T) {
+		srv, user := setupRules(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSubjectPatternRules("scc_sprs", emailRule),
+				},
+				{
+					ResourceName:  "scc_subject_pattern_rules.scc_sprs",
+					ImportState:   true,
+					ImportStateId: "0",
+					ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+				},
+			},
+		})
+	})
+}
+
+// checkSubjectPatternRules verifies the conditions of the rules on the fake
+// Cloud Connector, in their order of evaluation.
+func checkSubjectPatternRules(srv *sccmock.Server, conditions ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		var err error
+		srv.Update(func(state *sccmock.State) {
+			actual := []string{}
+			for _, rule := range state.SubjectPatternRules {
+				actual = append(actual, rule.Condition)
+			}
+			if fmt.Sprint(actual) != fmt.Sprint(conditions) {
+				err = fmt.Errorf("expected the rules %q, got %q", conditions, actual)
+			}
+		})
+		return err
+	}
+}
+
+func ResourceSubjectPatternRules(resourceName string, rules ...string) string {
+	rulesHCL := ""
+	for _, rule := range rules {
+		rulesHCL += rule + ",\n"
+	}
+	return fmt.Sprintf(`
+	resource "scc_subject_pattern_rules" "%s" {
+		rules = [
+		%s]
+	}
+	`, resourceName, rulesHCL)
+}
@@ -6,8 +6,6 @@ import (
 	"fmt"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -73,6 +71,7 @@ The PKCS#12 file must be created from a CSR generated in SAP Cloud Connector and
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/system-certificate-apis#upload-a-pkcs#12-certificate-as-system-certificate-(master-only)>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"pkcs12_certificate": schema.StringAttribute{
 				MarkdownDescription: `PKCS#12 (.p12) certificate bundle.
 This value may be provided as:
@@ -205,6 +204,8 @@ func (r *SystemCertificatePKCS12CertificateResource) Create(ctx context.Context,
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	responseModel, d := CreatePKCS12SystemCertificateFunc(r, ctx, plan)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
@@ -225,26 +226,29 @@ func (r *SystemCertificatePKCS12CertificateResource) Read(ctx context.Context, r
 	}
 
 	var state model.PKCS12SystemCertificateResourceConfig
-	var respObj apiobjects.Certificate
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetSystemCertificateEndpoint()
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 
 	// Get Certificate Metadata
-	requestDiags := helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(requestDiags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.SystemCertificate().Get(ctx)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
 	// Generate Binary Certificate
-	certBytes, binaryDiags := helpers.GetCertificateBinaryFunc(r.Client, endpoint)
-	resp.Diagnostics.Append(binaryDiags...)
-	if resp.Diagnostics.HasError() {
+	certBytes, err := r.Client.SystemCertificate().Download(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -265,6 +269,8 @@ func (r *SystemCertificatePKCS12CertificateResource) Read(ctx context.Context, r
 		return
 	}
 
+	responseModel.Instance = state.Instance
+
 	responseModel.PKCS12Certificate = state.PKCS12Certificate
 	responseModel.Password = state.Password
 	responseModel.KeyPassword = state.KeyPassword
@@ -285,6 +291,8 @@ func (r *SystemCertificatePKCS12CertificateResource) Update(ctx context.Context,
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -316,16 +324,15 @@ func (r *SystemCertificatePKCS12CertificateResource) Delete(ctx context.Context,
 	}
 
 	var state model.PKCS12SystemCertificateResourceConfig
-	var respObj apiobjects.Certificate
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetSystemCertificateEndpoint()
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 
-	diags = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "DELETE", endpoint, nil, false)
+	diags = api.ErrorDiagnostics(r.Client.SystemCertificate().Delete(ctx))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -336,7 +343,6 @@ func (r *SystemCertificatePKCS12CertificateResource) Delete(ctx context.Context,
 
 var CreatePKCS12SystemCertificateFunc = func(r *SystemCertificatePKCS12CertificateResource, ctx context.Context, plan model.PKCS12SystemCertificateResourceConfig) (*model.PKCS12SystemCertificateResourceConfig, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var respObj apiobjects.Certificate
 
 	rawCertificate, d := helpers.ValidatePKCS12InputsFunc(plan.PKCS12Certificate, plan.KeyPassword)
 	diags.Append(d...)
@@ -344,31 +350,28 @@ var CreatePKCS12SystemCertificateFunc = func(r *SystemCertificatePKCS12Certifica
 		return nil, diags
 	}
 
-	endpoint := endpoints.GetSystemCertificateEndpoint()
-
 	keyPassword := ""
 	if !plan.KeyPassword.IsNull() && !plan.KeyPassword.IsUnknown() {
 		keyPassword = plan.KeyPassword.ValueString()
 	}
 
 	// Upload PKCS#12 Certificate
-	uploadDiags := helpers.UploadPKCS12CertificateFunc(r.Client, endpoint, rawCertificate, plan.Password.ValueString(), keyPassword)
-	diags.Append(uploadDiags...)
-	if diags.HasError() {
+	if err := r.Client.SystemCertificate().UploadPKCS12(ctx, rawCertificate, plan.Password.ValueString(), keyPassword); err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
 	// Get Certificate Metadata
-	requestDiags := helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "GET", endpoint, nil, true)
-	diags.Append(requestDiags...)
-	if diags.HasError() {
+	respObj, err := r.Client.SystemCertificate().Get(ctx)
+	if err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
 	// Generate Binary Certificate
-	certBytes, binaryDiags := helpers.GetCertificateBinaryFunc(r.Client, endpoint)
-	diags.Append(binaryDiags...)
-	if diags.HasError() {
+	certBytes, err := r.Client.SystemCertificate().Download(ctx)
+	if err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
@@ -389,6 +392,8 @@ var CreatePKCS12SystemCertificateFunc = func(r *SystemCertificatePKCS12Certifica
 		return nil, diags
 	}
 
+	responseModel.Instance = plan.Instance
+
 	responseModel.PKCS12Certificate = plan.PKCS12Certificate
 	responseModel.Password = plan.Password
 	responseModel.KeyPassword = plan.KeyPassword
@@ -3,7 +3,6 @@ package resources_test
 import (
 	"context"
 	"encoding/base64"
-	"encoding/pem"
 	"testing"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
@@ -16,7 +15,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
 )
 
 func TestSystemCertificatePKCS12Certificate_Metadata(t *testing.T) {
@@ -195,16 +193,7 @@ func TestCreateInternal_ValidationFails(t *testing.T) {
 
 func TestCreateInternal_UploadFails(t *testing.T) {
 	r := &resources.SystemCertificatePKCS12CertificateResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldUpload := helpers.UploadPKCS12CertificateFunc
-	defer func() { helpers.UploadPKCS12CertificateFunc = oldUpload }()
-
-	helpers.UploadPKCS12CertificateFunc = func(*api.RestApiClient, string, []byte, string, string) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("upload failed", "fail")
-		return d
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateUpload),
 	}
 
 	plan := model.PKCS12SystemCertificateResourceConfig{
@@ -219,24 +208,7 @@ func TestCreateInternal_UploadFails(t *testing.T) {
 
 func TestCreateInternal_MetadataFails(t *testing.T) {
 	r := &resources.SystemCertificatePKCS12CertificateResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldUpload := helpers.UploadPKCS12CertificateFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	defer func() {
-		helpers.UploadPKCS12CertificateFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-	}()
-
-	helpers.UploadPKCS12CertificateFunc = func(*api.RestApiClient, string, []byte, string, string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("metadata error", "fail")
-		return d
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateGet),
 	}
 
 	plan := model.PKCS12SystemCertificateResourceConfig{
@@ -251,30 +223,7 @@ func TestCreateInternal_MetadataFails(t *testing.T) {
 
 func TestCreateInternal_BinaryFails(t *testing.T) {
 	r := &resources.SystemCertificatePKCS12CertificateResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldUpload := helpers.UploadPKCS12CertificateFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
-	defer func() {
-		helpers.UploadPKCS12CertificateFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
-	}()
-
-	helpers.UploadPKCS12CertificateFunc = func(*api.RestApiClient, string, []byte, string, string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		var d diag.Diagnostics
-		d.AddError("binary error", "fail")
-		return nil, d
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateDownload),
 	}
 
 	plan := model.PKCS12SystemCertificateResourceConfig{
@@ -289,35 +238,15 @@ func TestCreateInternal_BinaryFails(t *testing.T) {
 
 func TestCreateInternal_InvalidPEM(t *testing.T) {
 	r := &resources.SystemCertificatePKCS12CertificateResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateDownload),
 	}
 
-	oldUpload := helpers.UploadPKCS12CertificateFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValue := model.PKCS12SystemCertificateResourceValueFromFunc
 
 	defer func() {
-		helpers.UploadPKCS12CertificateFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		model.PKCS12SystemCertificateResourceValueFromFunc = oldValue
 	}()
 
-	helpers.UploadPKCS12CertificateFunc = func(*api.RestApiClient, string, []byte, string, string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		var d diag.Diagnostics
-		d.AddError("binary error", "fail")
-		return nil, d
-	}
-
 	plan := model.PKCS12SystemCertificateResourceConfig{
 		PKCS12Certificate: types.StringValue("abc"),
 		Password:          types.StringValue("pass"),
@@ -330,36 +259,15 @@ func TestCreateInternal_InvalidPEM(t *testing.T) {
 
 func TestCreateInternal_ModelConversionFails(t *testing.T) {
 	r := &resources.SystemCertificatePKCS12CertificateResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, tfutils.GenerateValidDERCert(t)),
 	}
 
-	oldUpload := helpers.UploadPKCS12CertificateFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValue := model.PKCS12SystemCertificateResourceValueFromFunc
 
 	defer func() {
-		helpers.UploadPKCS12CertificateFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		model.PKCS12SystemCertificateResourceValueFromFunc = oldValue
 	}()
 
-	helpers.UploadPKCS12CertificateFunc = func(*api.RestApiClient, string, []byte, string, string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		cert := tfutils.GenerateTestCert(t)
-		block, _ := pem.Decode([]byte(cert))
-		require.NotNil(t, block)
-		return block.Bytes, nil
-	}
-
 	model.PKCS12SystemCertificateResourceValueFromFunc = func(context.Context, apiobjects.Certificate) (model.PKCS12SystemCertificateResourceConfig, diag.Diagnostics) {
 		var d diag.Diagnostics
 		d.AddError("model error", "fail")
@@ -378,33 +286,15 @@ func TestCreateInternal_ModelConversionFails(t *testing.T) {
 
 func TestCreateInternal_Success(t *testing.T) {
 	r := &resources.SystemCertificatePKCS12CertificateResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, tfutils.GenerateValidDERCert(t)),
 	}
 
-	oldUpload := helpers.UploadPKCS12CertificateFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValue := model.PKCS12SystemCertificateResourceValueFromFunc
 
 	defer func() {
-		helpers.UploadPKCS12CertificateFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		model.PKCS12SystemCertificateResourceValueFromFunc = oldValue
 	}()
 
-	helpers.UploadPKCS12CertificateFunc = func(*api.RestApiClient, string, []byte, string, string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		return tfutils.GenerateValidDERCert(t), nil
-	}
-
 	model.PKCS12SystemCertificateResourceValueFromFunc = func(ctx context.Context, obj apiobjects.Certificate) (model.PKCS12SystemCertificateResourceConfig, diag.Diagnostics) {
 		return model.PKCS12SystemCertificateResourceConfig{
 			PKCS12Certificate: types.StringValue("abc"),
@@ -8,7 +8,6 @@ import (
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -58,6 +57,7 @@ func (r *SystemCertificateSelfSignedResource) Schema(ctx context.Context, req re
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/system-certificate-apis#create-a-self-signed-system-certificate-(master-only)>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"key_size": schema.Int64Attribute{
 				MarkdownDescription: "Key size in bits. Allowed values: 2048 or 4096.",
 				Optional:            true,
@@ -215,6 +215,8 @@ func (r *SystemCertificateSelfSignedResource) Create(ctx context.Context, req re
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	model, diags := CreateSelfSignedSystemCertificateFunc(r, ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -233,14 +235,13 @@ func (r *SystemCertificateSelfSignedResource) Read(ctx context.Context, req reso
 		return
 	}
 	var state model.SelfSignedSystemCertificateResourceConfig
-	var respObj apiobjects.Certificate
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetSystemCertificateEndpoint()
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 
 	dnStruct, diags := helpers.ExpandSubjectDNFunc(ctx, state.SubjectDN)
 	resp.Diagnostics.Append(diags...)
@@ -249,16 +250,20 @@ func (r *SystemCertificateSelfSignedResource) Read(ctx context.Context, req reso
 	}
 
 	// Get Certificate Metadata
-	diags = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.SystemCertificate().Get(ctx)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
 	// Generate Binary Certificate
-	certBytes, diags := helpers.GetCertificateBinaryFunc(r.Client, endpoint)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	certBytes, err := r.Client.SystemCertificate().Download(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -279,6 +284,8 @@ func (r *SystemCertificateSelfSignedResource) Read(ctx context.Context, req reso
 		return
 	}
 
+	responseModel.Instance = state.Instance
+
 	responseModel.KeySize = state.KeySize
 	responseModel.CertificatePEM = types.StringValue(string(pemBytes))
 
@@ -297,6 +304,8 @@ func (r *SystemCertificateSelfSignedResource) Update(ctx context.Context, req re
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -325,16 +334,15 @@ func (r *SystemCertificateSelfSignedResource) Delete(ctx context.Context, req re
 		return
 	}
 	var state model.SelfSignedSystemCertificateResourceConfig
-	var respObj apiobjects.Certificate
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetSystemCertificateEndpoint()
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 
-	diags = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "DELETE", endpoint, nil, false)
+	diags = api.ErrorDiagnostics(r.Client.SystemCertificate().Delete(ctx))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -345,7 +353,6 @@ func (r *SystemCertificateSelfSignedResource) Delete(ctx context.Context, req re
 
 var CreateSelfSignedSystemCertificateFunc = func(r *SystemCertificateSelfSignedResource, ctx context.Context, plan model.SelfSignedSystemCertificateResourceConfig) (*model.SelfSignedSystemCertificateResourceConfig, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var respObj apiobjects.Certificate
 
 	if plan.SubjectDN.IsNull() || plan.SubjectDN.IsUnknown() {
 		diags.AddError(
@@ -362,32 +369,28 @@ var CreateSelfSignedSystemCertificateFunc = func(r *SystemCertificateSelfSignedR
 	}
 
 	subjectDN := helpers.BuildSubjectDNFunc(dnStruct)
-	planBody := map[string]any{
-		"type":      "selfsigned",
-		"keySize":   plan.KeySize.ValueInt64(),
-		"subjectDN": subjectDN,
+	request := apiobjects.CertificateRequest{
+		KeySize:   plan.KeySize.ValueInt64(),
+		SubjectDN: subjectDN,
 	}
 
-	endpoint := endpoints.GetSystemCertificateEndpoint()
-
 	// Create Self-Signed Certificate
-	d = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "POST", endpoint, planBody, false)
-	diags.Append(d...)
-	if diags.HasError() {
+	if err := r.Client.SystemCertificate().CreateSelfSigned(ctx, request); err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
 	// Get Certificate Metadata
-	d = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "GET", endpoint, nil, true)
-	diags.Append(d...)
-	if diags.HasError() {
+	respObj, err := r.Client.SystemCertificate().Get(ctx)
+	if err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
 	// Generate Binary Certificate
-	certBytes, d := helpers.GetCertificateBinaryFunc(r.Client, endpoint)
-	diags.Append(d...)
-	if diags.HasError() {
+	certBytes, err := r.Client.SystemCertificate().Download(ctx)
+	if err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
@@ -408,6 +411,8 @@ var CreateSelfSignedSystemCertificateFunc = func(r *SystemCertificateSelfSignedR
 		return nil, diags
 	}
 
+	responseModel.Instance = plan.Instance
+
 	responseModel.KeySize = plan.KeySize
 	responseModel.CertificatePEM = types.StringValue(string(pemBytes))
 
@@ -82,16 +82,7 @@ func TestSystemCertificateSelfSigned_Create_MissingSubjectDN(t *testing.T) {
 
 func TestSystemCertificateSelfSigned_Create_RequestFails(t *testing.T) {
 	r := &resources.SystemCertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	defer func() { helpers.RequestAndUnmarshalCertificateFunc = oldReq }()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("request failed", "fail")
-		return d
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateUpload),
 	}
 
 	plan := testValidSelfSignedSystemPlan()
@@ -103,24 +94,7 @@ func TestSystemCertificateSelfSigned_Create_RequestFails(t *testing.T) {
 
 func TestSystemCertificateSelfSigned_Create_BinaryFails(t *testing.T) {
 	r := &resources.SystemCertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
-	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
-	}()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		var d diag.Diagnostics
-		d.AddError("binary error", "fail")
-		return nil, d
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateDownload),
 	}
 
 	plan := testValidSelfSignedSystemPlan()
@@ -132,27 +106,15 @@ func TestSystemCertificateSelfSigned_Create_BinaryFails(t *testing.T) {
 
 func TestSystemCertificateSelfSigned_Create_Success(t *testing.T) {
 	r := &resources.SystemCertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, tfutils.GenerateValidDERCert(t)),
 	}
 
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValue := model.SelfSignedSystemCertificateResourceValueFromFunc
 
 	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		model.SelfSignedSystemCertificateResourceValueFromFunc = oldValue
 	}()
 
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		return tfutils.GenerateValidDERCert(t), nil
-	}
-
 	model.SelfSignedSystemCertificateResourceValueFromFunc = func(
 		ctx context.Context,
 		obj apiobjects.Certificate,
@@ -171,22 +133,7 @@ func TestSystemCertificateSelfSigned_Create_Success(t *testing.T) {
 
 func TestSystemCertificateSelfSigned_Create_InvalidPEM(t *testing.T) {
 	r := &resources.SystemCertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
-	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
-	}()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		return []byte("invalid"), nil
+		Client: tfutils.NewCertificateTestClient(t, []byte("invalid")),
 	}
 
 	plan := testValidSelfSignedSystemPlan()
@@ -198,16 +145,7 @@ func TestSystemCertificateSelfSigned_Create_InvalidPEM(t *testing.T) {
 
 func TestSystemCertificateSelfSigned_Read_RequestFails(t *testing.T) {
 	r := &resources.SystemCertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	defer func() { helpers.RequestAndUnmarshalCertificateFunc = oldReq }()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("fail", "fail")
-		return d
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateGet),
 	}
 
 	schemaResp := &resource.SchemaResponse{}
@@ -234,25 +172,7 @@ func TestSystemCertificateSelfSigned_Read_RequestFails(t *testing.T) {
 
 func TestSystemCertificateSelfSigned_Read_BinaryFails(t *testing.T) {
 	r := &resources.SystemCertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
-
-	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
-	}()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		var d diag.Diagnostics
-		d.AddError("fail", "fail")
-		return nil, d
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateDownload),
 	}
 
 	schemaResp := &resource.SchemaResponse{}
@@ -279,16 +199,7 @@ func TestSystemCertificateSelfSigned_Read_BinaryFails(t *testing.T) {
 
 func TestSystemCertificateSelfSigned_Delete_Failure(t *testing.T) {
 	r := &resources.SystemCertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	defer func() { helpers.RequestAndUnmarshalCertificateFunc = oldReq }()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("fail", "fail")
-		return d
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateDelete),
 	}
 
 	state := testValidSelfSignedSystemPlan()
@@ -315,14 +226,7 @@ func TestSystemCertificateSelfSigned_Delete_Failure(t *testing.T) {
 
 func TestSystemCertificateSelfSigned_Delete_Success(t *testing.T) {
 	r := &resources.SystemCertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	defer func() { helpers.RequestAndUnmarshalCertificateFunc = oldReq }()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
+		Client: tfutils.NewCertificateTestClient(t, nil),
 	}
 
 	req := resource.DeleteRequest{}
@@ -335,27 +239,15 @@ func TestSystemCertificateSelfSigned_Delete_Success(t *testing.T) {
 
 func TestSystemCertificateSelfSigned_Read_Success(t *testing.T) {
 	r := &resources.SystemCertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, tfutils.GenerateValidDERCert(t)),
 	}
 
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValue := model.SelfSignedSystemCertificateResourceValueFromFunc
 
 	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		model.SelfSignedSystemCertificateResourceValueFromFunc = oldValue
 	}()
 
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		return tfutils.GenerateValidDERCert(t), nil
-	}
-
 	model.SelfSignedSystemCertificateResourceValueFromFunc = func(
 		ctx context.Context,
 		obj apiobjects.Certificate,
@@ -416,24 +308,14 @@ func testValidSelfSignedSystemPlan() model.SelfSignedSystemCertificateResourceCo
 
 func TestSystemCertificateSelfSigned_Create_ModelFails(t *testing.T) {
 	r := &resources.SystemCertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, tfutils.GenerateValidDERCert(t)),
 	}
 
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValue := model.SelfSignedSystemCertificateResourceValueFromFunc
 	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		model.SelfSignedSystemCertificateResourceValueFromFunc = oldValue
 	}()
 
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		return tfutils.GenerateValidDERCert(t), nil
-	}
 	model.SelfSignedSystemCertificateResourceValueFromFunc = func(ctx context.Context, obj apiobjects.Certificate, dn *helpers.CertificateSubjectDNConfig) (model.SelfSignedSystemCertificateResourceConfig, diag.Diagnostics) {
 		var d diag.Diagnostics
 		d.AddError("model error", "fail")
@@ -455,23 +337,13 @@ func TestSystemCertificateSelfSigned_Read_NullState(t *testing.T) {
 }
 
 func TestSystemCertificateSelfSigned_Read_PEMError(t *testing.T) {
-	r := &resources.SystemCertificateSelfSignedResource{Client: &api.RestApiClient{}}
+	r := &resources.SystemCertificateSelfSignedResource{Client: tfutils.NewCertificateTestClient(t, []byte("notcert"))}
 
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValidate := helpers.ValidatePEMDataFunc
 	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		helpers.ValidatePEMDataFunc = oldValidate
 	}()
 
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		return []byte("notcert"), nil
-	}
 	helpers.ValidatePEMDataFunc = func(string) diag.Diagnostics {
 		var d diag.Diagnostics
 		d.AddError("Invalid PEM", "pem error")
@@ -492,23 +364,13 @@ func TestSystemCertificateSelfSigned_Read_PEMError(t *testing.T) {
 }
 
 func TestSystemCertificateSelfSigned_Read_ModelError(t *testing.T) {
-	r := &resources.SystemCertificateSelfSignedResource{Client: &api.RestApiClient{}}
+	r := &resources.SystemCertificateSelfSignedResource{Client: tfutils.NewCertificateTestClient(t, tfutils.GenerateValidDERCert(t))}
 
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValue := model.SelfSignedSystemCertificateResourceValueFromFunc
 	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		model.SelfSignedSystemCertificateResourceValueFromFunc = oldValue
 	}()
 
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-	helpers.GetCertificateBinaryFunc = func(*api.RestApiClient, string) ([]byte, diag.Diagnostics) {
-		return tfutils.GenerateValidDERCert(t), nil
-	}
 	model.SelfSignedSystemCertificateResourceValueFromFunc = func(ctx context.Context, obj apiobjects.Certificate, dn *helpers.CertificateSubjectDNConfig) (model.SelfSignedSystemCertificateResourceConfig, diag.Diagnostics) {
 		var d diag.Diagnostics
 		d.AddError("model error", "fail")
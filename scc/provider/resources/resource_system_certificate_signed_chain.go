@@ -6,8 +6,6 @@ import (
 	"fmt"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -69,6 +67,7 @@ downloaded from SAP Cloud Connector.
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/system-certificate-apis#upload-a-signed-certificate-chain-as-system-certificate-(master-only)>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"signed_chain": schema.StringAttribute{
 				MarkdownDescription: `PEM-encoded signed certificate chain.
 The chain should be ordered as follows:
@@ -190,12 +189,16 @@ func (r *SystemCertificateSignedChainResource) Create(ctx context.Context, req r
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	model, diags := CreateSignedChainSystemCertificateFunc(r, ctx, plan.SignedChain.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	model.Instance = plan.Instance
+
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -210,26 +213,29 @@ func (r *SystemCertificateSignedChainResource) Read(ctx context.Context, req res
 	}
 
 	var state model.SignedChainSystemCertificateResourceConfig
-	var respObj apiobjects.Certificate
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetSystemCertificateEndpoint()
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 
 	// Get Certificate Metadata
-	diags = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.SystemCertificate().Get(ctx)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
 	// Generate Binary Certificate
-	certBytes, diags := helpers.GetCertificateBinaryFunc(r.Client, endpoint)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	certBytes, err := r.Client.SystemCertificate().Download(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -250,6 +256,8 @@ func (r *SystemCertificateSignedChainResource) Read(ctx context.Context, req res
 		return
 	}
 
+	responseModel.Instance = state.Instance
+
 	responseModel.SignedChain = state.SignedChain
 	responseModel.CertificatePEM = types.StringValue(string(pemBytes))
 
@@ -268,6 +276,8 @@ func (r *SystemCertificateSignedChainResource) Update(ctx context.Context, req r
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -285,6 +295,8 @@ func (r *SystemCertificateSignedChainResource) Update(ctx context.Context, req r
 		return
 	}
 
+	model.Instance = plan.Instance
+
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -299,16 +311,15 @@ func (r *SystemCertificateSignedChainResource) Delete(ctx context.Context, req r
 	}
 
 	var state model.SignedChainSystemCertificateResourceConfig
-	var respObj apiobjects.Certificate
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetSystemCertificateEndpoint()
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 
-	diags = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "DELETE", endpoint, nil, false)
+	diags = api.ErrorDiagnostics(r.Client.SystemCertificate().Delete(ctx))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -319,7 +330,6 @@ func (r *SystemCertificateSignedChainResource) Delete(ctx context.Context, req r
 
 var CreateSignedChainSystemCertificateFunc = func(r *SystemCertificateSignedChainResource, ctx context.Context, signedChain string) (*model.SignedChainSystemCertificateResourceConfig, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var respObj apiobjects.Certificate
 
 	if signedChain != "" {
 		certDiags := helpers.ValidatePEMChainFunc(signedChain)
@@ -329,23 +339,20 @@ var CreateSignedChainSystemCertificateFunc = func(r *SystemCertificateSignedChai
 		}
 	}
 
-	endpoint := endpoints.GetSystemCertificateEndpoint()
-
-	d := helpers.UploadSignedChainFunc(r.Client, endpoint, signedChain)
-	diags.Append(d...)
-	if diags.HasError() {
+	if err := r.Client.SystemCertificate().UploadSignedChain(ctx, signedChain); err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
-	d = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "GET", endpoint, nil, true)
-	diags.Append(d...)
-	if diags.HasError() {
+	respObj, err := r.Client.SystemCertificate().Get(ctx)
+	if err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
-	certBytes, d := helpers.GetCertificateBinaryFunc(r.Client, endpoint)
-	diags.Append(d...)
-	if diags.HasError() {
+	certBytes, err := r.Client.SystemCertificate().Download(ctx)
+	if err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
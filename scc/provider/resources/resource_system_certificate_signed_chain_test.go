@@ -2,11 +2,9 @@ package resources_test
 
 import (
 	"context"
-	"encoding/pem"
 	"testing"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/SAP/terraform-provider-scc/scc/provider/resources"
@@ -16,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
 )
 
 func TestSystemCertificateSignedChain_Metadata(t *testing.T) {
@@ -115,23 +112,9 @@ func TestSystemCertificateSignedChain_Update_NoChange(t *testing.T) {
 
 func TestSystemCertificateSignedChain_Delete(t *testing.T) {
 	r := &resources.SystemCertificateSignedChainResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, nil),
 	}
 
-	// Mock API call
-	old := helpers.RequestAndUnmarshalCertificateFunc
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		return nil
-	}
-	defer func() { helpers.RequestAndUnmarshalCertificateFunc = old }()
-
 	resp := &resource.DeleteResponse{}
 	req := resource.DeleteRequest{}
 
@@ -153,35 +136,9 @@ func TestSystemCertificateSignedChain_Delete_NoState(t *testing.T) {
 
 func TestSystemCertificateSignedChain_Read(t *testing.T) {
 	r := &resources.SystemCertificateSignedChainResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(
-		client *api.RestApiClient,
-		endpoint string,
-	) ([]byte, diag.Diagnostics) {
-		return []byte("fakecert"), nil
+		Client: tfutils.NewCertificateTestClient(t, []byte("fakecert")),
 	}
 
-	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
-	}()
-
 	resp := &resource.ReadResponse{}
 	req := resource.ReadRequest{}
 
@@ -213,23 +170,7 @@ func TestSystemCertificateSignedChain_Delete_APIFailure(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewSystemCertificateSignedChainResource().(*resources.SystemCertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
-
-	old := helpers.RequestAndUnmarshalCertificateFunc
-	defer func() { helpers.RequestAndUnmarshalCertificateFunc = old }()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("delete failed", "fail")
-		return d
-	}
+	r.Client = tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateDelete)
 
 	// get resource schema
 	schemaResp := &resource.SchemaResponse{}
@@ -258,23 +199,7 @@ func TestSystemCertificateSignedChain_Read_APIFailure(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewSystemCertificateSignedChainResource().(*resources.SystemCertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
-
-	old := helpers.RequestAndUnmarshalCertificateFunc
-	defer func() { helpers.RequestAndUnmarshalCertificateFunc = old }()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("api error", "fail")
-		return d
-	}
+	r.Client = tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateGet)
 
 	schemaResp := &resource.SchemaResponse{}
 	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
@@ -296,35 +221,7 @@ func TestSystemCertificateSignedChain_Read_BinaryFailure(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewSystemCertificateSignedChainResource().(*resources.SystemCertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
-
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
-
-	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
-	}()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(
-		client *api.RestApiClient,
-		endpoint string,
-	) ([]byte, diag.Diagnostics) {
-		var d diag.Diagnostics
-		d.AddError("binary error", "fail")
-		return nil, d
-	}
+	r.Client = tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateDownload)
 
 	schemaResp := &resource.SchemaResponse{}
 	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
@@ -346,16 +243,7 @@ func TestSystemCertificateSignedChain_Create_UploadFails(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewSystemCertificateSignedChainResource().(*resources.SystemCertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
-
-	oldUpload := helpers.UploadSignedChainFunc
-	defer func() { helpers.UploadSignedChainFunc = oldUpload }()
-
-	helpers.UploadSignedChainFunc = func(client *api.RestApiClient, endpoint, chain string) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("upload failed", "fail")
-		return d
-	}
+	r.Client = tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateUpload)
 
 	// get resource schema
 	schemaResp := &resource.SchemaResponse{}
@@ -367,10 +255,12 @@ func TestSystemCertificateSignedChain_Create_UploadFails(t *testing.T) {
 		Raw: tftypes.NewValue(
 			tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
+					"instance":     tftypes.String,
 					"signed_chain": tftypes.String,
 				},
 			},
 			map[string]tftypes.Value{
+				"instance":     tftypes.NewValue(tftypes.String, nil),
 				"signed_chain": tftypes.NewValue(tftypes.String, "fake-cert"),
 			},
 		),
@@ -395,32 +285,7 @@ func TestSystemCertificateSignedChain_Create_MetadataFails(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewSystemCertificateSignedChainResource().(*resources.SystemCertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
-
-	oldUpload := helpers.UploadSignedChainFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-
-	defer func() {
-		helpers.UploadSignedChainFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-	}()
-
-	helpers.UploadSignedChainFunc = func(client *api.RestApiClient, endpoint, chain string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("metadata error", "fail")
-		return d
-	}
+	r.Client = tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateGet)
 
 	req := resource.CreateRequest{
 		Plan: buildSystemCertificateSignedChainPlan(ctx, r, "fake-cert"),
@@ -437,38 +302,7 @@ func TestSystemCertificateSignedChain_Create_BinaryFails(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewSystemCertificateSignedChainResource().(*resources.SystemCertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
-
-	oldUpload := helpers.UploadSignedChainFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
-
-	defer func() {
-		helpers.UploadSignedChainFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
-	}()
-
-	helpers.UploadSignedChainFunc = func(client *api.RestApiClient, endpoint, chain string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(client *api.RestApiClient, endpoint string) ([]byte, diag.Diagnostics) {
-		var d diag.Diagnostics
-		d.AddError("binary error", "fail")
-		return nil, d
-	}
+	r.Client = tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateDownload)
 
 	req := resource.CreateRequest{
 		Plan: buildSystemCertificateSignedChainPlan(ctx, r, "fake-cert"),
@@ -485,51 +319,16 @@ func TestSystemCertificateSignedChain_Create_Success(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewSystemCertificateSignedChainResource().(*resources.SystemCertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
+	r.Client = tfutils.NewCertificateTestClient(t, tfutils.GenerateValidDERCert(t))
 
-	oldUpload := helpers.UploadSignedChainFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldModel := model.SignedChainSystemCertificateResourceValueFromFunc
 	oldValidate := helpers.ValidatePEMChainFunc
 
 	defer func() {
-		helpers.UploadSignedChainFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		model.SignedChainSystemCertificateResourceValueFromFunc = oldModel
 		helpers.ValidatePEMChainFunc = oldValidate
 	}()
 
-	helpers.UploadSignedChainFunc = func(client *api.RestApiClient, endpoint, chain string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-
-		respObj.Issuer = "Test CA"
-		respObj.SerialNumber = "123"
-		respObj.NotBeforeTimeStamp = 1700000000000
-		respObj.NotAfterTimeStamp = 1800000000000
-		respObj.SubjectDN = "CN=test.example.com"
-
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(client *api.RestApiClient, endpoint string) ([]byte, diag.Diagnostics) {
-		cert := tfutils.GenerateTestCert(t)
-		block, _ := pem.Decode([]byte(cert))
-		require.NotNil(t, block)
-		return block.Bytes, nil
-	}
-
 	// model.SignedChainSystemCertificateResourceValueFromFunc = model.SignedChainSystemCertificateResourceValueFromFunc
 
 	helpers.ValidatePEMChainFunc = func(data string) diag.Diagnostics {
@@ -8,7 +8,6 @@ import (
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/SAP/terraform-provider-scc/validation/systemMapping"
@@ -23,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
@@ -31,6 +31,7 @@ const (
 )
 
 var _ resource.Resource = &SystemMappingResource{}
+var _ resource.ResourceWithModifyPlan = &SystemMappingResource{}
 
 func NewSystemMappingResource() resource.Resource {
 	return &SystemMappingResource{}
@@ -69,6 +70,7 @@ __Operational notes:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/system-mappings>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"region_host": schema.StringAttribute{
 				MarkdownDescription: "Region Host Name.",
 				Required:            true,
@@ -213,7 +215,7 @@ __Allowed formats:__
 					helpers.GetFormattedValueAsTableRow("NONE_RESTRICTED", "No authentication; system certificate will never be sent") +
 					helpers.GetFormattedValueAsTableRow("X509_GENERAL", "X.509 certificate-based authentication, system certificate may be sent") +
 					helpers.GetFormattedValueAsTableRow("X509_RESTRICTED", "X.509 certificate-based authentication, system certificate never sent") +
-					helpers.GetFormattedValueAsTableRow("KERBEROS", "Kerberos-based authentication") +
+					helpers.GetFormattedValueAsTableRow("KERBEROS", "Kerberos-based authentication; requires `scc_kerberos_settings`") +
 					"The authentication modes NONE_RESTRICTED and X509_RESTRICTED prevent the Cloud Connector from sending the system certificate in any case, whereas NONE and X509_GENERAL will send the system certificate if the circumstances allow it.",
 				Optional: true,
 				Computed: true,
@@ -356,34 +358,111 @@ func (r *SystemMappingResource) Configure(ctx context.Context, req resource.Conf
 	r.Client = client
 }
 
+// ModifyPlan checks at plan time that the Cloud Connector is configured for
+// the SNC partner name and the Kerberos authentication of the mapping. If the
+// configuration cannot be read, the checks are skipped.
+func (r *SystemMappingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.Client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var instance types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("instance"), &instance)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, instance.ValueString())
+
+	r.checkSNCConfigured(ctx, req, resp)
+	r.checkKerberosConfigured(ctx, req, resp)
+}
+
+// checkSNCConfigured warns when a mapping uses an SNC partner name while SNC
+// is not configured on the Cloud Connector, as such connections fail at runtime.
+func (r *SystemMappingResource) checkSNCConfigured(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var sncPartnerName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("snc_partner_name"), &sncPartnerName)...)
+	if resp.Diagnostics.HasError() || sncPartnerName.IsNull() || sncPartnerName.IsUnknown() || sncPartnerName.ValueString() == "" {
+		return
+	}
+
+	settings, err := r.Client.SNC().Get(ctx)
+	if err != nil && !api.IsNotFound(err) {
+		tflog.Debug(ctx, "Reading SNC settings failed, skipping SNC check", map[string]any{"error": err.Error()})
+		return
+	}
+
+	if err != nil || !model.SNCConfigured(settings) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("snc_partner_name"),
+			"SNC Not Configured",
+			"The system mapping uses an SNC partner name, but SNC is not configured on the Cloud Connector. "+
+				"Connections to the backend system will fail until SNC is configured with the scc_snc_settings resource. "+
+				"You can ignore this warning if scc_snc_settings is applied in the same run.",
+		)
+	}
+}
+
+// checkKerberosConfigured rejects mappings that switch to the KERBEROS
+// authentication mode while Kerberos is not configured on the Cloud Connector.
+// Mappings that already use KERBEROS are not checked again.
+func (r *SystemMappingResource) checkKerberosConfigured(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var authenticationMode, previousMode types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("authentication_mode"), &authenticationMode)...)
+	if resp.Diagnostics.HasError() || authenticationMode.ValueString() != "KERBEROS" {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("authentication_mode"), &previousMode)...)
+		if resp.Diagnostics.HasError() || previousMode.Equal(authenticationMode) {
+			return
+		}
+	}
+
+	settings, err := r.Client.Kerberos().Get(ctx)
+	if err != nil && !api.IsNotFound(err) {
+		tflog.Debug(ctx, "Reading Kerberos settings failed, skipping Kerberos check", map[string]any{"error": err.Error()})
+		return
+	}
+
+	if err != nil || !model.KerberosConfigured(settings) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("authentication_mode"),
+			"Kerberos Not Configured",
+			"The system mapping uses the authentication mode KERBEROS, but Kerberos is not configured on the Cloud Connector. "+
+				"Configure Kerberos with the scc_kerberos_settings resource first, e.g. with terraform apply -target=scc_kerberos_settings.<name>.",
+		)
+	}
+}
+
 func (r *SystemMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan model.SystemMappingConfig
-	var respObj apiobjects.SystemMapping
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	regionHost := plan.RegionHost.ValueString()
 	subaccount := plan.Subaccount.ValueString()
 	virtualHost := plan.VirtualHost.ValueString()
 	virtualPort := plan.VirtualPort.ValueString()
-	endpoint := endpoints.GetSystemMappingBaseEndpoint(regionHost, subaccount)
 
 	planBody := buildSystemMappingBody(ctx, actionCreate, plan)
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "POST", endpoint, planBody, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	err := r.Client.SystemMappings().Create(ctx, regionHost, subaccount, planBody)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
-	endpoint = endpoints.GetSystemMappingEndpoint(regionHost, subaccount, virtualHost, virtualPort)
-
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.SystemMappings().Get(ctx, regionHost, subaccount, virtualHost, virtualPort)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -412,22 +491,26 @@ func (r *SystemMappingResource) Create(ctx context.Context, req resource.CreateR
 
 func (r *SystemMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state model.SystemMappingConfig
-	var respObj apiobjects.SystemMapping
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
 	regionHost := state.RegionHost.ValueString()
 	subaccount := state.Subaccount.ValueString()
 	virtualHost := state.VirtualHost.ValueString()
 	virtualPort := state.VirtualPort.ValueString()
-	endpoint := endpoints.GetSystemMappingEndpoint(regionHost, subaccount, virtualHost, virtualPort)
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.SystemMappings().Get(ctx, regionHost, subaccount, virtualHost, virtualPort)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -456,13 +539,14 @@ func (r *SystemMappingResource) Read(ctx context.Context, req resource.ReadReque
 
 func (r *SystemMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, state model.SystemMappingConfig
-	var respObj apiobjects.SystemMapping
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -481,19 +565,18 @@ func (r *SystemMappingResource) Update(ctx context.Context, req resource.UpdateR
 		resp.Diagnostics.AddError("Error updating the cloud connector system mapping", "Failed to update the cloud connector system mapping due to mismatched configuration values.")
 		return
 	}
-	endpoint := endpoints.GetSystemMappingEndpoint(regionHost, subaccount, virtualHost, virtualPort)
 
 	planBody := buildSystemMappingBody(ctx, actionUpdate, plan)
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "PUT", endpoint, planBody, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	err := r.Client.SystemMappings().Update(ctx, regionHost, subaccount, virtualHost, virtualPort, planBody)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.SystemMappings().Get(ctx, regionHost, subaccount, virtualHost, virtualPort)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -522,65 +605,55 @@ func (r *SystemMappingResource) Update(ctx context.Context, req resource.UpdateR
 
 func (r *SystemMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state model.SystemMappingConfig
-	var respObj apiobjects.SystemMapping
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
 	regionHost := state.RegionHost.ValueString()
 	subaccount := state.Subaccount.ValueString()
 	virtualHost := state.VirtualHost.ValueString()
 	virtualPort := state.VirtualPort.ValueString()
-	endpoint := fmt.Sprintf("/api/v1/configuration/subaccounts/%s/%s/systemMappings/%s:%s", regionHost, subaccount, virtualHost, virtualPort)
-
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "DELETE", endpoint, nil, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	responseModel, diags := model.SystemMappingValueFrom(ctx, state, respObj)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
 
-	diags = resp.State.Set(ctx, responseModel)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	err := r.Client.SystemMappings().Delete(ctx, regionHost, subaccount, virtualHost, virtualPort)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 }
 
-func buildSystemMappingBody(ctx context.Context, action string, plan model.SystemMappingConfig) map[string]any {
-	planBody := map[string]any{
-		"virtualHost":        plan.VirtualHost.ValueString(),
-		"virtualPort":        plan.VirtualPort.ValueString(),
-		"localHost":          plan.InternalHost.ValueString(),
-		"localPort":          plan.InternalPort.ValueString(),
-		"protocol":           plan.Protocol.ValueString(),
-		"backendType":        plan.BackendType.ValueString(),
-		"authenticationMode": plan.AuthenticationMode.ValueString(),
+func buildSystemMappingBody(ctx context.Context, action string, plan model.SystemMappingConfig) apiobjects.SystemMappingRequest {
+	planBody := apiobjects.SystemMappingRequest{
+		VirtualHost:        plan.VirtualHost.ValueString(),
+		VirtualPort:        plan.VirtualPort.ValueString(),
+		InternalHost:       plan.InternalHost.ValueString(),
+		InternalPort:       plan.InternalPort.ValueString(),
+		Protocol:           plan.Protocol.ValueString(),
+		BackendType:        plan.BackendType.ValueString(),
+		AuthenticationMode: plan.AuthenticationMode.ValueString(),
 	}
 
 	// Optional fields (only if user provided them)
-	addIfSet := func(attr types.String, key string) {
-		if !attr.IsNull() && !attr.IsUnknown() {
-			planBody[key] = attr.ValueString()
+	valueIfSet := func(attr types.String) *string {
+		if attr.IsNull() || attr.IsUnknown() {
+			return nil
 		}
+		value := attr.ValueString()
+		return &value
 	}
 
-	addIfSet(plan.Description, "description")
-	addIfSet(plan.HostInHeader, "hostInHeader")
-	addIfSet(plan.Sid, "sid")
-	addIfSet(plan.SAPRouter, "sapRouter")
-	addIfSet(plan.SNCPartnerName, "sncPartnerName")
+	planBody.Description = valueIfSet(plan.Description)
+	planBody.Sid = valueIfSet(plan.Sid)
+	planBody.SAPRouter = valueIfSet(plan.SAPRouter)
+	planBody.SNCPartnerName = valueIfSet(plan.SNCPartnerName)
 
 	// Handle case sensitive host in header values
-	if !plan.HostInHeader.IsNull() && !plan.HostInHeader.IsUnknown() {
-		planBody["hostInHeader"] = strings.ToUpper(plan.HostInHeader.ValueString())
+	if hostInHeader := valueIfSet(plan.HostInHeader); hostInHeader != nil {
+		upper := strings.ToUpper(*hostInHeader)
+		planBody.HostInHeader = &upper
 	}
 
 	// Handle allowed clients and blacklisted users (Update only)
@@ -588,27 +661,29 @@ func buildSystemMappingBody(ctx context.Context, action string, plan model.Syste
 		if !plan.AllowedClients.IsNull() && !plan.AllowedClients.IsUnknown() {
 			var allowedClients []string
 			plan.AllowedClients.ElementsAs(ctx, &allowedClients, false)
-			planBody["allowedClients"] = allowedClients
+			planBody.AllowedClients = &allowedClients
 		}
 
 		if !plan.BlacklistedUsers.IsNull() && !plan.BlacklistedUsers.IsUnknown() {
 			var blacklistedUsers []model.SystemMappingBlacklistedUsersData
 			plan.BlacklistedUsers.ElementsAs(ctx, &blacklistedUsers, false)
 
-			users := []map[string]string{}
+			users := []apiobjects.BlacklistedUsers{}
 			for _, u := range blacklistedUsers {
-				users = append(users, map[string]string{
-					"client": u.Client.ValueString(),
-					"user":   u.User.ValueString(),
+				users = append(users, apiobjects.BlacklistedUsers{
+					Client: u.Client.ValueString(),
+					User:   u.User.ValueString(),
 				})
 			}
-			planBody["blacklistedUsers"] = users
+			planBody.BlacklistedUsers = &users
 		}
 	}
 	return planBody
 }
 
 func (rs *SystemMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = helpers.ImportInstanceID(ctx, rs.Client, req.ID, resp)
+
 	if req.ID != "" {
 		idParts := strings.Split(req.ID, ",")
 
@@ -3,12 +3,11 @@ package resources
 import (
 	"context"
 	"fmt"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"strings"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
-	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/SAP/terraform-provider-scc/validation/uuidvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -61,6 +60,7 @@ __Operational notes:__
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/system-mapping-resources>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"region_host": schema.StringAttribute{
 				MarkdownDescription: "Region Host Name.",
 				Required:            true,
@@ -181,39 +181,32 @@ func (r *SystemMappingResourceResource) Configure(ctx context.Context, req resou
 
 func (r *SystemMappingResourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan model.SystemMappingResourceConfig
-	var respObj apiobjects.SystemMappingResource
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	regionHost := plan.RegionHost.ValueString()
 	subaccount := plan.Subaccount.ValueString()
 	virtualHost := plan.VirtualHost.ValueString()
 	virtualPort := plan.VirtualPort.ValueString()
 	resourceID := model.CreateEncodedResourceID(plan.URLPath.ValueString())
-	endpoint := endpoints.GetSystemMappingResourceBaseEndpoint(regionHost, subaccount, virtualHost, virtualPort)
-
-	planBody := map[string]any{
-		"id":                      plan.URLPath.ValueString(),
-		"enabled":                 fmt.Sprintf("%t", plan.Enabled.ValueBool()),
-		"exactMatchOnly":          fmt.Sprintf("%t", plan.PathOnly.ValueBool()),
-		"websocketUpgradeAllowed": fmt.Sprintf("%t", plan.WebsocketUpgradeAllowed.ValueBool()),
-		"description":             plan.Description.ValueString(),
-	}
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "POST", endpoint, planBody, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	body := buildSystemMappingResourceBody(plan)
+	body.URLPath = plan.URLPath.ValueString()
+
+	err := r.Client.SystemMappingResources().Create(ctx, regionHost, subaccount, virtualHost, virtualPort, body)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
-	endpoint = endpoints.GetSystemMappingResourceEndpoint(regionHost, subaccount, virtualHost, virtualPort, resourceID)
-
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "GET", endpoint, planBody, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.SystemMappingResources().Get(ctx, regionHost, subaccount, virtualHost, virtualPort, resourceID)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -243,23 +236,27 @@ func (r *SystemMappingResourceResource) Create(ctx context.Context, req resource
 
 func (r *SystemMappingResourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state model.SystemMappingResourceConfig
-	var respObj apiobjects.SystemMappingResource
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
 	regionHost := state.RegionHost.ValueString()
 	subaccount := state.Subaccount.ValueString()
 	virtualHost := state.VirtualHost.ValueString()
 	virtualPort := state.VirtualPort.ValueString()
 	resourceID := model.CreateEncodedResourceID(state.URLPath.ValueString())
-	endpoint := endpoints.GetSystemMappingResourceEndpoint(regionHost, subaccount, virtualHost, virtualPort, resourceID)
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.SystemMappingResources().Get(ctx, regionHost, subaccount, virtualHost, virtualPort, resourceID)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -289,13 +286,14 @@ func (r *SystemMappingResourceResource) Read(ctx context.Context, req resource.R
 
 func (r *SystemMappingResourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, state model.SystemMappingResourceConfig
-	var respObj apiobjects.SystemMappingResource
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -315,26 +313,16 @@ func (r *SystemMappingResourceResource) Update(ctx context.Context, req resource
 		resp.Diagnostics.AddError("Error updating the cloud connector system mapping resource", "Failed to update the cloud connector system mapping resource due to mismatched configuration values.")
 		return
 	}
-	endpoint := fmt.Sprintf("/api/v1/configuration/subaccounts/%s/%s/systemMappings/%s:%s/resources/%s", regionHost, subaccount, virtualHost, virtualPort, resourceID)
-
-	planBody := map[string]any{
-		"enabled":                 fmt.Sprintf("%t", plan.Enabled.ValueBool()),
-		"exactMatchOnly":          fmt.Sprintf("%t", plan.PathOnly.ValueBool()),
-		"websocketUpgradeAllowed": fmt.Sprintf("%t", plan.WebsocketUpgradeAllowed.ValueBool()),
-		"description":             plan.Description.ValueString(),
-	}
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "PUT", endpoint, planBody, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	err := r.Client.SystemMappingResources().Update(ctx, regionHost, subaccount, virtualHost, virtualPort, resourceID, buildSystemMappingResourceBody(plan))
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
-	endpoint = endpoints.GetSystemMappingResourceEndpoint(regionHost, subaccount, virtualHost, virtualPort, resourceID)
-
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "GET", endpoint, planBody, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.SystemMappingResources().Get(ctx, regionHost, subaccount, virtualHost, virtualPort, resourceID)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -364,40 +352,41 @@ func (r *SystemMappingResourceResource) Update(ctx context.Context, req resource
 
 func (r *SystemMappingResourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state model.SystemMappingResourceConfig
-	var respObj apiobjects.SystemMappingResource
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
 	regionHost := state.RegionHost.ValueString()
 	subaccount := state.Subaccount.ValueString()
 	virtualHost := state.VirtualHost.ValueString()
 	virtualPort := state.VirtualPort.ValueString()
 	resourceID := model.CreateEncodedResourceID(state.URLPath.ValueString())
-	endpoint := endpoints.GetSystemMappingResourceEndpoint(regionHost, subaccount, virtualHost, virtualPort, resourceID)
 
-	diags = helpers.RequestAndUnmarshal(r.Client, &respObj, "DELETE", endpoint, nil, false)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	responseModel, diags := model.SystemMappingResourceValueFrom(ctx, state, respObj)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	err := r.Client.SystemMappingResources().Delete(ctx, regionHost, subaccount, virtualHost, virtualPort, resourceID)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
+}
 
-	diags = resp.State.Set(ctx, responseModel)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+// buildSystemMappingResourceBody builds the request body shared by create and update.
+// The URL path is part of the body only when the resource is created.
+func buildSystemMappingResourceBody(plan model.SystemMappingResourceConfig) apiobjects.SystemMappingResourceRequest {
+	return apiobjects.SystemMappingResourceRequest{
+		Description:             plan.Description.ValueString(),
+		Enabled:                 plan.Enabled.ValueBool(),
+		PathOnly:                plan.PathOnly.ValueBool(),
+		WebsocketUpgradeAllowed: plan.WebsocketUpgradeAllowed.ValueBool(),
 	}
 }
 
 func (rs *SystemMappingResourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = helpers.ImportInstanceID(ctx, rs.Client, req.ID, resp)
+
 	if req.ID != "" {
 		idParts := strings.Split(req.ID, ",")
 
@@ -5,9 +5,12 @@ import (
 	"regexp"
 	"testing"
 
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
 	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -114,6 +117,135 @@ func TestResourceSystemMapping(t *testing.T) {
 		})
 	})
 
+	t.Run("happy path - deleted outside of terraform is recreated", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+		srv.Update(func(state *sccmock.State) {
+			state.Subaccounts = append(state.Subaccounts, &sccmock.Subaccount{RegionHost: regionHost, ID: subaccount})
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSystemMapping("scc_sm", regionHost, subaccount, virtualHost, virtualPort, internalHost, internalPort, "HTTP", "abapSys", "VIRTUAL", "NONE"),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.Subaccount(regionHost, subaccount).SystemMappings = nil
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceSystemMapping("scc_sm", regionHost, subaccount, virtualHost, virtualPort, internalHost, internalPort, "HTTP", "abapSys", "VIRTUAL", "NONE"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_system_mapping.scc_sm", plancheck.ResourceActionCreate),
+						},
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - KERBEROS with Kerberos configured", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+		srv.Update(func(state *sccmock.State) {
+			state.Subaccounts = append(state.Subaccounts, &sccmock.Subaccount{RegionHost: regionHost, ID: subaccount})
+			state.Kerberos = &apiobjects.KerberosSettings{
+				Realm:       "EXAMPLE.COM",
+				KDCs:        []apiobjects.KerberosKDC{{Host: "kdc1.example.com", Port: 88}},
+				ServiceUser: "scc-service",
+				Keytab:      testKeytab,
+			}
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSystemMapping("scc_sm", regionHost, subaccount, virtualHost, virtualPort, internalHost, internalPort, "HTTP", "abapSys", "VIRTUAL", "KERBEROS"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_system_mapping.scc_sm", "authentication_mode", "KERBEROS"),
+					),
+				},
+				// Mappings that already use KERBEROS are not checked again.
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.Kerberos = nil
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceSystemMapping("scc_sm", regionHost, subaccount, virtualHost, virtualPort, internalHost, internalPort, "HTTP", "abapSys", "INTERNAL", "KERBEROS"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_system_mapping.scc_sm", "host_in_header", "INTERNAL"),
+					),
+				},
+			},
+		})
+	})
+
+	// An SNC partner name without SNC settings only results in a warning.
+	t.Run("happy path - SNC partner name without SNC configured", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+		srv.Update(func(state *sccmock.State) {
+			state.Subaccounts = append(state.Subaccounts, &sccmock.Subaccount{RegionHost: regionHost, ID: subaccount})
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSystemMappingWithSNCPartnerName("scc_sm", regionHost, subaccount, virtualHost, "3300", internalHost, "3300", "p:CN=ABC, O=Example"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_system_mapping.scc_sm", "protocol", "RFCS"),
+						resource.TestCheckResourceAttr("scc_system_mapping.scc_sm", "snc_partner_name", "p:CN=ABC, O=Example"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - KERBEROS without Kerberos configured", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+		srv.Update(func(state *sccmock.State) {
+			state.Subaccounts = append(state.Subaccounts, &sccmock.Subaccount{RegionHost: regionHost, ID: subaccount})
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config:      tfutils.ProviderConfig(user) + ResourceSystemMapping("scc_sm", regionHost, subaccount, virtualHost, virtualPort, internalHost, internalPort, "HTTP", "abapSys", "VIRTUAL", "KERBEROS"),
+					ExpectError: regexp.MustCompile(`Kerberos Not Configured`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - switch to KERBEROS without Kerberos configured", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+		srv.Update(func(state *sccmock.State) {
+			state.Subaccounts = append(state.Subaccounts, &sccmock.Subaccount{RegionHost: regionHost, ID: subaccount})
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceSystemMapping("scc_sm", regionHost, subaccount, virtualHost, virtualPort, internalHost, internalPort, "HTTP", "abapSys", "VIRTUAL", "NONE"),
+				},
+				{
+					Config:      tfutils.ProviderConfig(user) + ResourceSystemMapping("scc_sm", regionHost, subaccount, virtualHost, virtualPort, internalHost, internalPort, "HTTP", "abapSys", "VIRTUAL", "KERBEROS"),
+					ExpectError: regexp.MustCompile(`Kerberos Not Configured`),
+				},
+			},
+		})
+	})
+
 	t.Run("error path - region host mandatory", func(t *testing.T) {
 		resource.Test(t, resource.TestCase{
 			IsUnitTest:               true,
@@ -238,6 +370,24 @@ func ResourceSystemMapping(datasourceName string, regionHost string, subaccount
 	`, datasourceName, regionHost, subaccount, virtualHost, virtualPort, internalHost, internalPort, protocol, backendType, hostInHeader, authenticationMode)
 }
 
+func ResourceSystemMappingWithSNCPartnerName(datasourceName string, regionHost string, subaccount string, virtualHost string, virtualPort string,
+	internalHost string, internalPort string, sncPartnerName string) string {
+	return fmt.Sprintf(`
+	resource "scc_system_mapping" "%s" {
+	region_host= "%s"
+	subaccount= "%s"
+	virtual_host= "%s"
+	virtual_port= "%s"
+	internal_host= "%s"
+	internal_port= "%s"
+	protocol= "RFCS"
+	backend_type= "abapSys"
+	authentication_mode= "NONE"
+	snc_partner_name= "%s"
+	}
+	`, datasourceName, regionHost, subaccount, virtualHost, virtualPort, internalHost, internalPort, sncPartnerName)
+}
+
 func ResourceSystemMappingWoRegionHost(datasourceName string, subaccount string, virtualHost string, virtualPort string,
 	internalHost string, internalPort string, protocol string, backendType string, hostInHeader string, authenticationMode string) string {
 	return fmt.Sprintf(`
@@ -0,0 +1,303 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &TraceSettingsResource{}
+
+func NewTraceSettingsResource() resource.Resource {
+	return &TraceSettingsResource{}
+}
+
+type TraceSettingsResource struct {
+	Client *api.RestApiClient
+}
+
+const (
+	defaultTraceLogLevel       = "INFO"
+	defaultCPICTraceLevel      = 0
+	defaultPayloadTraceEnabled = false
+	defaultFourEyesPrinciple   = false
+)
+
+var traceLogLevels = []string{"ALL", "DEBUG", "INFO", "WARN", "ERROR", "OFF"}
+
+type traceSettingsResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func (r *TraceSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trace_settings"
+}
+
+func (r *TraceSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector Trace Settings Resource.
+
+Manages the log and trace levels of the Cloud Connector. Raised trace levels are intended for troubleshooting only, as they slow down the Cloud Connector and produce large trace files.
+
+On destroy, all log and trace levels are reset to their defaults.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+	* Support
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/troubleshooting>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
+			"cloud_connector_log_level": schema.StringAttribute{
+				MarkdownDescription: "The log level of the Cloud Connector loggers. Possible values are `ALL`, `DEBUG`, `INFO`, `WARN`, `ERROR` and `OFF`. Defaults to `INFO`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultTraceLogLevel),
+				Validators: []validator.String{
+					stringvalidator.OneOf(traceLogLevels...),
+				},
+			},
+			"other_log_level": schema.StringAttribute{
+				MarkdownDescription: "The log level of all other loggers, i.e. the third-party libraries used by the Cloud Connector. Possible values are `ALL`, `DEBUG`, `INFO`, `WARN`, `ERROR` and `OFF`. Defaults to `INFO`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultTraceLogLevel),
+				Validators: []validator.String{
+					stringvalidator.OneOf(traceLogLevels...),
+				},
+			},
+			"cpic_trace_level": schema.Int64Attribute{
+				MarkdownDescription: "The trace level of the CPI-C layer used for RFC communication. Possible values are: \n" +
+					helpers.GetFormattedValueAsTableRow("level", "description") +
+					helpers.GetFormattedValueAsTableRow("---", "---") +
+					helpers.GetFormattedValueAsTableRow("`0`", "Off. This is the default.") +
+					helpers.GetFormattedValueAsTableRow("`1`", "Errors only.") +
+					helpers.GetFormattedValueAsTableRow("`2`", "Errors and warnings.") +
+					helpers.GetFormattedValueAsTableRow("`3`", "Full trace including data blocks."),
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(defaultCPICTraceLevel),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 3),
+				},
+			},
+			"payload_trace_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the payload of all requests and responses passing the Cloud Connector is written to the trace. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(defaultPayloadTraceEnabled),
+			},
+			"four_eyes_principle": schema.BoolAttribute{
+				MarkdownDescription: "Whether activating the payload trace requires the approval of a second user (four-eyes principle). Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(defaultFourEyesPrinciple),
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the trace settings resource. Used for import and identity purposes. The value is always `trace-settings`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (rs *TraceSettingsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *TraceSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = client
+}
+
+func (r *TraceSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.createOrUpdate(ctx, req.Plan, &resp.Diagnostics, &resp.State, &resp.Identity)
+}
+
+func (r *TraceSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state model.TraceSettingsResourceConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	respObj, err := r.Client.TraceSettings().Get(ctx)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	responseModel, diags := model.TraceSettingsResourceValueFrom(ctx, respObj)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseModel.Instance = state.Instance
+
+	diags = resp.State.Set(ctx, &responseModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identity := traceSettingsResourceIdentityModel{
+		ID: types.StringValue(model.TraceSettingsID),
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TraceSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.createOrUpdate(ctx, req.Plan, &resp.Diagnostics, &resp.State, &resp.Identity)
+}
+
+func (r *TraceSettingsResource) createOrUpdate(ctx context.Context, requestPlan tfsdk.Plan, responseDiagnostics *diag.Diagnostics, responseState *tfsdk.State, responseIdentity **tfsdk.ResourceIdentity) {
+	var plan model.TraceSettingsResourceConfig
+	diags := requestPlan.Get(ctx, &plan)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
+	planBody := apiobjects.TraceSettings{
+		CloudConnectorLogLevel: plan.CloudConnectorLogLevel.ValueString(),
+		OtherLogLevel:          plan.OtherLogLevel.ValueString(),
+		CPICTraceLevel:         plan.CPICTraceLevel.ValueInt64(),
+		PayloadTraceEnabled:    plan.PayloadTraceEnabled.ValueBool(),
+		FourEyesPrinciple:      plan.FourEyesPrinciple.ValueBool(),
+	}
+
+	if err := r.Client.TraceSettings().Update(ctx, planBody); err != nil {
+		responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	respObj, err := r.Client.TraceSettings().Get(ctx)
+	if err != nil {
+		responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	responseModel, diags := model.TraceSettingsResourceValueFrom(ctx, respObj)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	responseModel.Instance = plan.Instance
+
+	diags = responseState.Set(ctx, responseModel)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	identity := traceSettingsResourceIdentityModel{
+		ID: types.StringValue(model.TraceSettingsID),
+	}
+
+	diags = (*responseIdentity).Set(ctx, identity)
+	responseDiagnostics.Append(diags...)
+}
+
+func (r *TraceSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state model.TraceSettingsResourceConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	// Reset the log levels to INFO, turn the CPIC trace off and disable the payload
+	// trace along with its four-eyes principle.
+	planBody := apiobjects.TraceSettings{
+		CloudConnectorLogLevel: defaultTraceLogLevel,
+		OtherLogLevel:          defaultTraceLogLevel,
+		CPICTraceLevel:         defaultCPICTraceLevel,
+		PayloadTraceEnabled:    defaultPayloadTraceEnabled,
+		FourEyesPrinciple:      defaultFourEyesPrinciple,
+	}
+
+	if err := r.Client.TraceSettings().Update(ctx, planBody); err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (rs *TraceSettingsResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	req.ID = helpers.ImportInstanceID(ctx, rs.Client, req.ID, resp)
+
+	if req.ID != model.TraceSettingsID {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf(
+				"Expected import identifier %q. Got: %q",
+				model.TraceSettingsID,
+				req.ID,
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(ctx, path.Root("id"), model.TraceSettingsID)...,
+	)
+}
@@ -0,0 +1,174 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResourceTraceSettings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			CheckDestroy: func(_ *terraform.State) error {
+				var err error
+				srv.Update(func(state *sccmock.State) {
+					expected := apiobjects.TraceSettings{CloudConnectorLogLevel: "INFO", OtherLogLevel: "INFO"}
+					if state.TraceSettings != expected {
+						err = fmt.Errorf("expected the default trace settings, got %+v", state.TraceSettings)
+					}
+				})
+				return err
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceTraceSettings("scc_ts", "DEBUG", "WARN", 3, true, true),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_trace_settings.scc_ts", "cloud_connector_log_level", "DEBUG"),
+						resource.TestCheckResourceAttr("scc_trace_settings.scc_ts", "other_log_level", "WARN"),
+						resource.TestCheckResourceAttr("scc_trace_settings.scc_ts", "cpic_trace_level", "3"),
+						resource.TestCheckResourceAttr("scc_trace_settings.scc_ts", "payload_trace_enabled", "true"),
+						resource.TestCheckResourceAttr("scc_trace_settings.scc_ts", "four_eyes_principle", "true"),
+						resource.TestCheckResourceAttr("scc_trace_settings.scc_ts", "id", "trace-settings"),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity(
+							"scc_trace_settings.scc_ts",
+							map[string]knownvalue.Check{
+								"id": knownvalue.StringExact("trace-settings"),
+							},
+						),
+					},
+				},
+				{
+					ResourceName:                         "scc_trace_settings.scc_ts",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "trace-settings",
+					ImportStateVerifyIdentifierAttribute: "id",
+				},
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceTraceSettingsDefaults("scc_ts"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_trace_settings.scc_ts", "cloud_connector_log_level", "INFO"),
+						resource.TestCheckResourceAttr("scc_trace_settings.scc_ts", "other_log_level", "INFO"),
+						resource.TestCheckResourceAttr("scc_trace_settings.scc_ts", "cpic_trace_level", "0"),
+						resource.TestCheckResourceAttr("scc_trace_settings.scc_ts", "payload_trace_enabled", "false"),
+						resource.TestCheckResourceAttr("scc_trace_settings.scc_ts", "four_eyes_principle", "false"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - drift is reverted", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceTraceSettingsDefaults("scc_ts"),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.TraceSettings.CloudConnectorLogLevel = "ALL"
+							state.TraceSettings.PayloadTraceEnabled = true
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceTraceSettingsDefaults("scc_ts"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_trace_settings.scc_ts", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_trace_settings.scc_ts", "cloud_connector_log_level", "INFO"),
+						resource.TestCheckResourceAttr("scc_trace_settings.scc_ts", "payload_trace_enabled", "false"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid log level", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceTraceSettings("scc_ts", "VERBOSE", "INFO", 0, false, false),
+					ExpectError: regexp.MustCompile(`Attribute cloud_connector_log_level value must be one of`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid cpic trace level", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceTraceSettings("scc_ts", "INFO", "INFO", 4, false, false),
+					ExpectError: regexp.MustCompile(`Attribute cpic_trace_level value must be between 0 and 3`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid import id", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceTraceSettingsDefaults("scc_ts"),
+				},
+				{
+					ResourceName:  "scc_trace_settings.scc_ts",
+					ImportState:   true,
+					ImportStateId: "invalid",
+					ExpectError:   regexp.MustCompile(`Expected import identifier`),
+				},
+			},
+		})
+	})
+}
+
+func ResourceTraceSettings(resourceName string, cloudConnectorLogLevel string, otherLogLevel string, cpicTraceLevel int, payloadTraceEnabled bool, fourEyesPrinciple bool) string {
+	return fmt.Sprintf(`
+	resource "scc_trace_settings" "%s" {
+		cloud_connector_log_level = "%s"
+		other_log_level = "%s"
+		cpic_trace_level = %d
+		payload_trace_enabled = %t
+		four_eyes_principle = %t
+	}
+	`, resourceName, cloudConnectorLogLevel, otherLogLevel, cpicTraceLevel, payloadTraceEnabled, fourEyesPrinciple)
+}
+
+func ResourceTraceSettingsDefaults(resourceName string) string {
+	return fmt.Sprintf(`
+	resource "scc_trace_settings" "%s" {
+	}
+	`, resourceName)
+}
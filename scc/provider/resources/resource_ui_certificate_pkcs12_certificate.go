@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -71,6 +69,7 @@ The PKCS#12 file must be created from a CSR generated in SAP Cloud Connector and
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/authentication-and-ui-settings#upload-a-pkcs#12-certificate-as-ui-certificate>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"pkcs12_certificate": schema.StringAttribute{
 				MarkdownDescription: `PKCS#12 (.p12) certificate bundle.
 This value may be provided as:
@@ -218,6 +217,8 @@ func (r *UICertificatePKCS12CertificateResource) Create(ctx context.Context, req
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	responseModel, d := CreatePKCS12UICertificateFunc(r, ctx, plan)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
@@ -238,19 +239,22 @@ func (r *UICertificatePKCS12CertificateResource) Read(ctx context.Context, req r
 	}
 
 	var state model.PKCS12UICertificateResourceConfig
-	var respObj apiobjects.Certificate
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetUICertificateEndpoint()
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 
 	// Get Certificate Metadata
-	d := helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(d...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.UICertificate().Get(ctx)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -260,6 +264,8 @@ func (r *UICertificatePKCS12CertificateResource) Read(ctx context.Context, req r
 		return
 	}
 
+	responseModel.Instance = state.Instance
+
 	responseModel.PKCS12Certificate = state.PKCS12Certificate
 	responseModel.Password = state.Password
 	responseModel.KeyPassword = state.KeyPassword
@@ -279,6 +285,8 @@ func (r *UICertificatePKCS12CertificateResource) Update(ctx context.Context, req
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -321,7 +329,6 @@ func (r *UICertificatePKCS12CertificateResource) Delete(ctx context.Context, req
 
 var CreatePKCS12UICertificateFunc = func(r *UICertificatePKCS12CertificateResource, ctx context.Context, plan model.PKCS12UICertificateResourceConfig) (*model.PKCS12UICertificateResourceConfig, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var respObj apiobjects.Certificate
 
 	rawCertificate, d := helpers.ValidatePKCS12InputsFunc(plan.PKCS12Certificate, plan.KeyPassword)
 	diags.Append(d...)
@@ -329,24 +336,21 @@ var CreatePKCS12UICertificateFunc = func(r *UICertificatePKCS12CertificateResour
 		return nil, diags
 	}
 
-	endpoint := endpoints.GetUICertificateEndpoint()
-
 	keyPassword := ""
 	if !plan.KeyPassword.IsNull() && !plan.KeyPassword.IsUnknown() {
 		keyPassword = plan.KeyPassword.ValueString()
 	}
 
 	// Upload PKCS#12 Certificate
-	d = helpers.UploadPKCS12CertificateFunc(r.Client, endpoint, rawCertificate, plan.Password.ValueString(), keyPassword)
-	diags.Append(d...)
-	if diags.HasError() {
+	if err := r.Client.UICertificate().UploadPKCS12(ctx, rawCertificate, plan.Password.ValueString(), keyPassword); err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
 	// Get Certificate Metadata
-	d = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "GET", endpoint, nil, true)
-	diags.Append(d...)
-	if diags.HasError() {
+	respObj, err := r.Client.UICertificate().Get(ctx)
+	if err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
@@ -356,6 +360,8 @@ var CreatePKCS12UICertificateFunc = func(r *UICertificatePKCS12CertificateResour
 		return nil, diags
 	}
 
+	responseModel.Instance = plan.Instance
+
 	responseModel.PKCS12Certificate = plan.PKCS12Certificate
 	responseModel.Password = plan.Password
 	responseModel.KeyPassword = plan.KeyPassword
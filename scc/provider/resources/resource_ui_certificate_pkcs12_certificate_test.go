@@ -10,6 +10,7 @@ import (
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/SAP/terraform-provider-scc/scc/provider/resources"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -192,16 +193,7 @@ func TestUICertificatePKCS12Certificate_Create_ValidationFails(t *testing.T) {
 
 func TestUICertificatePKCS12Certificate_Create_UploadFails(t *testing.T) {
 	r := &resources.UICertificatePKCS12CertificateResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldUpload := helpers.UploadPKCS12CertificateFunc
-	defer func() { helpers.UploadPKCS12CertificateFunc = oldUpload }()
-
-	helpers.UploadPKCS12CertificateFunc = func(*api.RestApiClient, string, []byte, string, string) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("upload failed", "fail")
-		return d
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateUpload),
 	}
 
 	plan := model.PKCS12UICertificateResourceConfig{
@@ -216,24 +208,7 @@ func TestUICertificatePKCS12Certificate_Create_UploadFails(t *testing.T) {
 
 func TestUICertificatePKCS12Certificate_Create_MetadataFails(t *testing.T) {
 	r := &resources.UICertificatePKCS12CertificateResource{
-		Client: &api.RestApiClient{},
-	}
-
-	oldUpload := helpers.UploadPKCS12CertificateFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	defer func() {
-		helpers.UploadPKCS12CertificateFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-	}()
-
-	helpers.UploadPKCS12CertificateFunc = func(*api.RestApiClient, string, []byte, string, string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("metadata error", "fail")
-		return d
+		Client: tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateGet),
 	}
 
 	plan := model.PKCS12UICertificateResourceConfig{
@@ -248,27 +223,15 @@ func TestUICertificatePKCS12Certificate_Create_MetadataFails(t *testing.T) {
 
 func TestUICertificatePKCS12Certificate_Create_ModelConversionFails(t *testing.T) {
 	r := &resources.UICertificatePKCS12CertificateResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, nil),
 	}
 
-	oldUpload := helpers.UploadPKCS12CertificateFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
 	oldValue := model.PKCS12UICertificateResourceValueFromFunc
 
 	defer func() {
-		helpers.UploadPKCS12CertificateFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
 		model.PKCS12UICertificateResourceValueFromFunc = oldValue
 	}()
 
-	helpers.UploadPKCS12CertificateFunc = func(*api.RestApiClient, string, []byte, string, string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
 	model.PKCS12UICertificateResourceValueFromFunc = func(context.Context, apiobjects.Certificate) (model.PKCS12UICertificateResourceConfig, diag.Diagnostics) {
 		var d diag.Diagnostics
 		d.AddError("model error", "fail")
@@ -287,27 +250,15 @@ func TestUICertificatePKCS12Certificate_Create_ModelConversionFails(t *testing.T
 
 func TestUICertificatePKCS12Certificate_Create_Success(t *testing.T) {
 	r := &resources.UICertificatePKCS12CertificateResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, nil),
 	}
 
-	oldUpload := helpers.UploadPKCS12CertificateFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
 	oldValue := model.PKCS12UICertificateResourceValueFromFunc
 
 	defer func() {
-		helpers.UploadPKCS12CertificateFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
 		model.PKCS12UICertificateResourceValueFromFunc = oldValue
 	}()
 
-	helpers.UploadPKCS12CertificateFunc = func(*api.RestApiClient, string, []byte, string, string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
-
 	model.PKCS12UICertificateResourceValueFromFunc = func(ctx context.Context, obj apiobjects.Certificate) (model.PKCS12UICertificateResourceConfig, diag.Diagnostics) {
 		return model.PKCS12UICertificateResourceConfig{
 			PKCS12Certificate: types.StringValue("abc"),
@@ -7,7 +7,6 @@ import (
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
 	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -53,6 +52,7 @@ func (r *UICertificateSelfSignedResource) Schema(ctx context.Context, req resour
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/authentication-and-ui-settings#create-a-self-signed-ui-certificate>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"key_size": schema.Int64Attribute{
 				MarkdownDescription: "Key size in bits. Allowed values: 2048 or 4096.",
 				Optional:            true,
@@ -224,6 +224,8 @@ func (r *UICertificateSelfSignedResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	model, diags := CreateSelfSignedUICertificateFunc(r, ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -244,14 +246,13 @@ func (r *UICertificateSelfSignedResource) Read(ctx context.Context, req resource
 	}
 
 	var state model.SelfSignedUICertificateResourceConfig
-	var respObj apiobjects.Certificate
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetUICertificateEndpoint()
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 
 	dnStruct, diags := helpers.ExpandSubjectDNFunc(ctx, state.SubjectDN)
 	resp.Diagnostics.Append(diags...)
@@ -260,9 +261,13 @@ func (r *UICertificateSelfSignedResource) Read(ctx context.Context, req resource
 	}
 
 	// Get Certificate Metadata
-	diags = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.UICertificate().Get(ctx)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -272,6 +277,8 @@ func (r *UICertificateSelfSignedResource) Read(ctx context.Context, req resource
 		return
 	}
 
+	responseModel.Instance = state.Instance
+
 	responseModel.KeySize = state.KeySize
 
 	diags = resp.State.Set(ctx, responseModel)
@@ -289,6 +296,8 @@ func (r *UICertificateSelfSignedResource) Update(ctx context.Context, req resour
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -330,7 +339,6 @@ func (r *UICertificateSelfSignedResource) Delete(ctx context.Context, req resour
 
 var CreateSelfSignedUICertificateFunc = func(r *UICertificateSelfSignedResource, ctx context.Context, plan model.SelfSignedUICertificateResourceConfig) (*model.SelfSignedUICertificateResourceConfig, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var respObj apiobjects.Certificate
 
 	if plan.SubjectDN.IsNull() || plan.SubjectDN.IsUnknown() {
 		diags.AddError(
@@ -347,10 +355,9 @@ var CreateSelfSignedUICertificateFunc = func(r *UICertificateSelfSignedResource,
 	}
 
 	subjectDN := helpers.BuildSubjectDNFunc(dnStruct)
-	planBody := map[string]any{
-		"type":      "selfsigned",
-		"keySize":   plan.KeySize.ValueInt64(),
-		"subjectDN": subjectDN,
+	request := apiobjects.CertificateRequest{
+		KeySize:   plan.KeySize.ValueInt64(),
+		SubjectDN: subjectDN,
 	}
 
 	if !plan.SubjectAltNames.IsNull() &&
@@ -362,32 +369,24 @@ var CreateSelfSignedUICertificateFunc = func(r *UICertificateSelfSignedResource,
 			return nil, diags
 		}
 
-		if len(sanList) > 0 {
-			sanFields := []map[string]string{}
-			for _, san := range sanList {
-				sanFields = append(sanFields, map[string]string{
-					"type":  san.Type.ValueString(),
-					"value": san.Value.ValueString(),
-				})
-			}
-
-			planBody["subjectAltNames"] = sanFields
+		for _, san := range sanList {
+			request.SubjectAltNames = append(request.SubjectAltNames, apiobjects.SubjectAltNames{
+				Type:  san.Type.ValueString(),
+				Value: san.Value.ValueString(),
+			})
 		}
 	}
 
-	endpoint := endpoints.GetUICertificateEndpoint()
-
 	// Create Self-Signed Certificate
-	d = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "POST", endpoint, planBody, false)
-	diags.Append(d...)
-	if diags.HasError() {
+	if err := r.Client.UICertificate().CreateSelfSigned(ctx, request); err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
 	// Get Certificate Metadata
-	d = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "GET", endpoint, nil, true)
-	diags.Append(d...)
-	if diags.HasError() {
+	respObj, err := r.Client.UICertificate().Get(ctx)
+	if err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
@@ -397,6 +396,8 @@ var CreateSelfSignedUICertificateFunc = func(r *UICertificateSelfSignedResource,
 		return nil, diags
 	}
 
+	responseModel.Instance = plan.Instance
+
 	responseModel.KeySize = plan.KeySize
 
 	return &responseModel, diags
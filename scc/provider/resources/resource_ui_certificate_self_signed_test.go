@@ -9,6 +9,7 @@ import (
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/SAP/terraform-provider-scc/scc/provider/resources"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -108,26 +109,9 @@ func TestUICertificateSelfSigned_Delete(t *testing.T) {
 
 func TestUICertificateSelfSigned_Read(t *testing.T) {
 	r := &resources.UICertificateSelfSignedResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, nil),
 	}
 
-	old := helpers.RequestAndUnmarshalCertificateFunc
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		return nil
-	}
-
-	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = old
-	}()
-
 	resp := &resource.ReadResponse{}
 	req := resource.ReadRequest{}
 
@@ -140,24 +124,7 @@ func TestUICertificateSelfSigned_Read_APIFailure(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewUICertificateSelfSignedResource().(*resources.UICertificateSelfSignedResource)
-	r.Client = &api.RestApiClient{}
-
-	old := helpers.RequestAndUnmarshalCertificateFunc
-	defer func() { helpers.RequestAndUnmarshalCertificateFunc = old }()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-
-		var d diag.Diagnostics
-		d.AddError("api error", "fail")
-		return d
-	}
+	r.Client = tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateGet)
 
 	schemaResp := &resource.SchemaResponse{}
 	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
@@ -189,10 +156,12 @@ func TestUICertificateSelfSigned_Create_MissingSubjectDN(t *testing.T) {
 		Raw: tftypes.NewValue(
 			tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
+					"instance": tftypes.String,
 					"key_size": tftypes.Number,
 				},
 			},
 			map[string]tftypes.Value{
+				"instance": tftypes.NewValue(tftypes.String, nil),
 				"key_size": tftypes.NewValue(tftypes.Number, 2048),
 			},
 		),
@@ -213,24 +182,7 @@ func TestUICertificateSelfSigned_Create_APIFailure(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewUICertificateSelfSignedResource().(*resources.UICertificateSelfSignedResource)
-	r.Client = &api.RestApiClient{}
-
-	old := helpers.RequestAndUnmarshalCertificateFunc
-	defer func() { helpers.RequestAndUnmarshalCertificateFunc = old }()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-
-		var d diag.Diagnostics
-		d.AddError("create failed", "fail")
-		return d
-	}
+	r.Client = tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateUpload)
 
 	req := resource.CreateRequest{
 		Plan: buildSelfSignedPlan(ctx, r),
@@ -247,34 +199,14 @@ func TestUICertificateSelfSigned_Create_Success(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewUICertificateSelfSignedResource().(*resources.UICertificateSelfSignedResource)
-	r.Client = &api.RestApiClient{}
+	r.Client = tfutils.NewCertificateTestClient(t, nil)
 
-	old := helpers.RequestAndUnmarshalCertificateFunc
 	oldModel := model.SelfSignedUICertificateResourceValueFromFunc
 
 	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = old
 		model.SelfSignedUICertificateResourceValueFromFunc = oldModel
 	}()
 
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-
-		respObj.Issuer = "Test CA"
-		respObj.SerialNumber = "123"
-		respObj.NotBeforeTimeStamp = 1700000000000
-		respObj.NotAfterTimeStamp = 1800000000000
-		respObj.SubjectDN = "CN=test"
-
-		return nil
-	}
-
 	// model.SelfSignedUICertificateResourceValueFromFunc = model.SelfSignedUICertificateResourceValueFromFunc
 
 	req := resource.CreateRequest{
@@ -320,6 +252,7 @@ func buildSelfSignedPlan(ctx context.Context, r *resources.UICertificateSelfSign
 	}
 
 	attrTypes := map[string]tftypes.Type{
+		"instance":                  tftypes.String,
 		"key_size":                  tftypes.Number,
 		"subject_dn":                subjectDNType,
 		"valid_to":                  tftypes.String,
@@ -330,6 +263,7 @@ func buildSelfSignedPlan(ctx context.Context, r *resources.UICertificateSelfSign
 	}
 
 	values := map[string]tftypes.Value{
+		"instance": tftypes.NewValue(tftypes.String, nil),
 		"key_size": tftypes.NewValue(tftypes.Number, 2048),
 		"subject_dn": tftypes.NewValue(
 			subjectDNType,
@@ -384,18 +318,13 @@ func testValidSelfSignedUIPlan() model.SelfSignedUICertificateResourceConfig {
 }
 
 func TestUICertificateSelfSigned_Read_ModelError(t *testing.T) {
-	r := &resources.UICertificateSelfSignedResource{Client: &api.RestApiClient{}}
+	r := &resources.UICertificateSelfSignedResource{Client: tfutils.NewCertificateTestClient(t, nil)}
 
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
 	oldValue := model.SelfSignedUICertificateResourceValueFromFunc
 	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
 		model.SelfSignedUICertificateResourceValueFromFunc = oldValue
 	}()
 
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
 	model.SelfSignedUICertificateResourceValueFromFunc = func(ctx context.Context, obj apiobjects.Certificate, dn *helpers.CertificateSubjectDNConfig) (model.SelfSignedUICertificateResourceConfig, diag.Diagnostics) {
 		var d diag.Diagnostics
 		d.AddError("model error", "fail")
@@ -416,18 +345,13 @@ func TestUICertificateSelfSigned_Read_ModelError(t *testing.T) {
 }
 
 func TestUICertificateSelfSigned_Read_Success_WithState(t *testing.T) {
-	r := &resources.UICertificateSelfSignedResource{Client: &api.RestApiClient{}}
+	r := &resources.UICertificateSelfSignedResource{Client: tfutils.NewCertificateTestClient(t, nil)}
 
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
 	oldValue := model.SelfSignedUICertificateResourceValueFromFunc
 	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
 		model.SelfSignedUICertificateResourceValueFromFunc = oldValue
 	}()
 
-	helpers.RequestAndUnmarshalCertificateFunc = func(*api.RestApiClient, *apiobjects.Certificate, string, string, map[string]any, bool) diag.Diagnostics {
-		return nil
-	}
 	model.SelfSignedUICertificateResourceValueFromFunc = func(ctx context.Context, obj apiobjects.Certificate, dn *helpers.CertificateSubjectDNConfig) (model.SelfSignedUICertificateResourceConfig, diag.Diagnostics) {
 		return testValidSelfSignedUIPlan(), nil
 	}
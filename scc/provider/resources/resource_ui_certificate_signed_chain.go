@@ -5,8 +5,6 @@ import (
 	"fmt"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
-	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
-	"github.com/SAP/terraform-provider-scc/internal/api/endpoints"
 	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
 	"github.com/SAP/terraform-provider-scc/scc/provider/model"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -71,6 +69,7 @@ The uploaded certificate chain becomes the **UI certificate** used by the connec
 __Further documentation:__
 <https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/authentication-and-ui-settings#upload-a-signed-certificate-chain-as-ui-certificate>`,
 		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
 			"signed_chain": schema.StringAttribute{
 				MarkdownDescription: `PEM-encoded signed certificate chain for the UI certificate.
 The certificate chain must be ordered as follows:
@@ -206,12 +205,16 @@ func (r *UICertificateSignedChainResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	model, d := CreateSignedChainUICertificateFunc(r, ctx, plan.SignedChain.ValueString())
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	model.Instance = plan.Instance
+
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -226,19 +229,22 @@ func (r *UICertificateSignedChainResource) Read(ctx context.Context, req resourc
 	}
 
 	var state model.SignedChainUICertificateResourceConfig
-	var respObj apiobjects.Certificate
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := endpoints.GetUICertificateEndpoint()
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
 
 	// Get Certificate Metadata
-	diags = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "GET", endpoint, nil, true)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	respObj, err := r.Client.UICertificate().Get(ctx)
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
 		return
 	}
 
@@ -248,6 +254,8 @@ func (r *UICertificateSignedChainResource) Read(ctx context.Context, req resourc
 		return
 	}
 
+	responseModel.Instance = state.Instance
+
 	responseModel.SignedChain = state.SignedChain
 
 	diags = resp.State.Set(ctx, &responseModel)
@@ -265,6 +273,8 @@ func (r *UICertificateSignedChainResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -282,6 +292,8 @@ func (r *UICertificateSignedChainResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	model.Instance = plan.Instance
+
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -306,7 +318,6 @@ func (r *UICertificateSignedChainResource) Delete(ctx context.Context, req resou
 }
 
 var CreateSignedChainUICertificateFunc = func(r *UICertificateSignedChainResource, ctx context.Context, signedChain string) (*model.SignedChainUICertificateResourceConfig, diag.Diagnostics) {
-	var respObj apiobjects.Certificate
 	var diags diag.Diagnostics
 	if signedChain != "" {
 		certDiags := helpers.ValidatePEMChainFunc(signedChain)
@@ -316,19 +327,16 @@ var CreateSignedChainUICertificateFunc = func(r *UICertificateSignedChainResourc
 		}
 	}
 
-	endpoint := endpoints.GetUICertificateEndpoint()
-
 	// Upload Signed Certificate Chain
-	d := helpers.UploadSignedChainFunc(r.Client, endpoint, signedChain)
-	diags.Append(d...)
-	if diags.HasError() {
+	if err := r.Client.UICertificate().UploadSignedChain(ctx, signedChain); err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
 	// Get Certificate Metadata
-	d = helpers.RequestAndUnmarshalCertificateFunc(r.Client, &respObj, "GET", endpoint, nil, true)
-	diags.Append(d...)
-	if diags.HasError() {
+	respObj, err := r.Client.UICertificate().Get(ctx)
+	if err != nil {
+		diags.Append(api.ErrorDiagnostics(err)...)
 		return nil, diags
 	}
 
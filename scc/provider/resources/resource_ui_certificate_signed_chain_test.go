@@ -2,7 +2,6 @@ package resources_test
 
 import (
 	"context"
-	"encoding/pem"
 	"testing"
 
 	"github.com/SAP/terraform-provider-scc/internal/api"
@@ -18,7 +17,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
 )
 
 func TestUICertificateSignedChain_Metadata(t *testing.T) {
@@ -111,44 +109,16 @@ func TestUICertificateSignedChain_Update_WithChange(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewUICertificateSignedChainResource().(*resources.UICertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
+	r.Client = tfutils.NewCertificateTestClient(t, tfutils.GenerateValidDERCert(t))
 
-	oldUpload := helpers.UploadSignedChainFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-	oldBin := helpers.GetCertificateBinaryFunc
 	oldValidate := helpers.ValidatePEMChainFunc
 	oldModel := model.SignedChainUICertificateResourceValueFromFunc
 
 	defer func() {
-		helpers.UploadSignedChainFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-		helpers.GetCertificateBinaryFunc = oldBin
 		helpers.ValidatePEMChainFunc = oldValidate
 		model.SignedChainUICertificateResourceValueFromFunc = oldModel
 	}()
 
-	helpers.UploadSignedChainFunc = func(client *api.RestApiClient, endpoint, chain string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.GetCertificateBinaryFunc = func(client *api.RestApiClient, endpoint string) ([]byte, diag.Diagnostics) {
-		certPEM := tfutils.GenerateTestCert(t)
-		block, _ := pem.Decode([]byte(certPEM))
-		require.NotNil(t, block)
-		return block.Bytes, nil
-	}
-
 	helpers.ValidatePEMChainFunc = func(data string) diag.Diagnostics {
 		return nil
 	}
@@ -157,7 +127,6 @@ func TestUICertificateSignedChain_Update_WithChange(t *testing.T) {
 		ctx context.Context,
 		resp apiobjects.Certificate,
 	) (model.SignedChainUICertificateResourceConfig, diag.Diagnostics) {
-
 		subjectDNValue, _ := types.ObjectValue(
 			map[string]attr.Type{
 				"cn":    types.StringType,
@@ -252,26 +221,9 @@ func TestUICertificateSignedChain_Delete_NoState(t *testing.T) {
 
 func TestUICertificateSignedChain_Read(t *testing.T) {
 	r := &resources.UICertificateSignedChainResource{
-		Client: &api.RestApiClient{},
+		Client: tfutils.NewCertificateTestClient(t, nil),
 	}
 
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		return nil
-	}
-
-	defer func() {
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-	}()
-
 	resp := &resource.ReadResponse{}
 	req := resource.ReadRequest{}
 
@@ -295,23 +247,7 @@ func TestUICertificateSignedChain_Read_APIFailure(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewUICertificateSignedChainResource().(*resources.UICertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
-
-	old := helpers.RequestAndUnmarshalCertificateFunc
-	defer func() { helpers.RequestAndUnmarshalCertificateFunc = old }()
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("api error", "fail")
-		return d
-	}
+	r.Client = tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateGet)
 
 	schemaResp := &resource.SchemaResponse{}
 	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
@@ -333,16 +269,7 @@ func TestUICertificateSignedChain_Create_UploadFails(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewUICertificateSignedChainResource().(*resources.UICertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
-
-	oldUpload := helpers.UploadSignedChainFunc
-	defer func() { helpers.UploadSignedChainFunc = oldUpload }()
-
-	helpers.UploadSignedChainFunc = func(client *api.RestApiClient, endpoint, chain string) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("upload failed", "fail")
-		return d
-	}
+	r.Client = tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateUpload)
 
 	// get resource schema
 	schemaResp := &resource.SchemaResponse{}
@@ -354,10 +281,12 @@ func TestUICertificateSignedChain_Create_UploadFails(t *testing.T) {
 		Raw: tftypes.NewValue(
 			tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
+					"instance":     tftypes.String,
 					"signed_chain": tftypes.String,
 				},
 			},
 			map[string]tftypes.Value{
+				"instance":     tftypes.NewValue(tftypes.String, nil),
 				"signed_chain": tftypes.NewValue(tftypes.String, "fake-cert"),
 			},
 		),
@@ -382,32 +311,7 @@ func TestUICertificateSignedChain_Create_MetadataFails(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewUICertificateSignedChainResource().(*resources.UICertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
-
-	oldUpload := helpers.UploadSignedChainFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
-
-	defer func() {
-		helpers.UploadSignedChainFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
-	}()
-
-	helpers.UploadSignedChainFunc = func(client *api.RestApiClient, endpoint, chain string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-		var d diag.Diagnostics
-		d.AddError("metadata error", "fail")
-		return d
-	}
+	r.Client = tfutils.NewCertificateTestClient(t, nil, tfutils.CertificateGet)
 
 	req := resource.CreateRequest{
 		Plan: buildUICertificateSignedChainPlan(ctx, r, "fake-cert"),
@@ -424,40 +328,14 @@ func TestUICertificateSignedChain_Create_Success(t *testing.T) {
 	ctx := context.Background()
 
 	r := resources.NewUICertificateSignedChainResource().(*resources.UICertificateSignedChainResource)
-	r.Client = &api.RestApiClient{}
+	r.Client = tfutils.NewCertificateTestClient(t, nil)
 
-	oldUpload := helpers.UploadSignedChainFunc
-	oldReq := helpers.RequestAndUnmarshalCertificateFunc
 	oldModel := model.SignedChainUICertificateResourceValueFromFunc
 
 	defer func() {
-		helpers.UploadSignedChainFunc = oldUpload
-		helpers.RequestAndUnmarshalCertificateFunc = oldReq
 		model.SignedChainUICertificateResourceValueFromFunc = oldModel
 	}()
 
-	helpers.UploadSignedChainFunc = func(client *api.RestApiClient, endpoint, chain string) diag.Diagnostics {
-		return nil
-	}
-
-	helpers.RequestAndUnmarshalCertificateFunc = func(
-		client *api.RestApiClient,
-		respObj *apiobjects.Certificate,
-		method string,
-		endpoint string,
-		body map[string]any,
-		expectJSON bool,
-	) diag.Diagnostics {
-
-		respObj.Issuer = "Test CA"
-		respObj.SerialNumber = "123"
-		respObj.NotBeforeTimeStamp = 1700000000000
-		respObj.NotAfterTimeStamp = 1800000000000
-		respObj.SubjectDN = "CN=test.example.com"
-
-		return nil
-	}
-
 	// model.SignedChainUICertificateResourceValueFromFunc = model.SignedChainUICertificateResourceValueFromFunc
 
 	// build schema-backed plan
@@ -497,6 +375,7 @@ func buildUpdatePlanStateUIWithAllAttrs(
 	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
 
 	attrTypes := map[string]tftypes.Type{
+		"instance":      tftypes.String,
 		"signed_chain":  tftypes.String,
 		"issuer":        tftypes.String,
 		"serial_number": tftypes.String,
@@ -520,6 +399,7 @@ func buildUpdatePlanStateUIWithAllAttrs(
 	raw := tftypes.NewValue(
 		tftypes.Object{AttributeTypes: attrTypes},
 		map[string]tftypes.Value{
+			"instance":     tftypes.NewValue(tftypes.String, nil),
 			"signed_chain": tftypes.NewValue(tftypes.String, value),
 
 			"issuer":        tftypes.NewValue(tftypes.String, nil),
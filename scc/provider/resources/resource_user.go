@@ -0,0 +1,339 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	apiobjects "github.com/SAP/terraform-provider-scc/internal/api/apiObjects"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &UserResource{}
+
+// userRolesMu serializes all changes of user roles because scc_user and
+// scc_user_role_assignment read, modify and write the roles of a user as a whole.
+var userRolesMu sync.Mutex
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+type UserResource struct {
+	Client *api.RestApiClient
+}
+
+type userResourceIdentityModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector User Resource.
+
+Manages a local user of the Cloud Connector. The roles of the user can either be managed with the ` + "`roles`" + ` attribute of this resource or with ` + "`scc_user_role_assignment`" + ` resources, but not both. If ` + "`roles`" + ` is not set, the resource leaves the roles of the user unchanged.
+
+The password cannot be read from the Cloud Connector, so it is neither stored in the Terraform state nor compared with the actual password. To change the password, set a new ` + "`password_wo`" + ` and increase ` + "`password_wo_version`" + `.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+* Local users are only used while the Cloud Connector authenticates against its local user store. See ` + "`scc_ldap_authentication`" + ` for LDAP users.
+* Write-only attributes require Terraform 1.11 or later.
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/use-ldap-for-authentication>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name the user logs on with. Changing this value forces the resource to be recreated.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the user, e.g. the full name of the person.",
+				Optional:            true,
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "The password of the user. It is set when the user is created and whenever `password_wo_version` changes. This value is write-only and never stored in the Terraform state.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of `password_wo`. Changes of write-only attributes are not detected, so increase this value to change the password.",
+				Optional:            true,
+			},
+			"roles": schema.SetAttribute{
+				MarkdownDescription: "The roles of the user. If set, the resource manages all roles of the user. Possible values are: \n" +
+					helpers.GetFormattedValueAsTableRow("role", "description") +
+					helpers.GetFormattedValueAsTableRow("---", "---") +
+					helpers.GetFormattedValueAsTableRow("`administrator`", "Full access to the Cloud Connector.") +
+					helpers.GetFormattedValueAsTableRow("`subaccount_administrator`", "Manages subaccounts and their configuration.") +
+					helpers.GetFormattedValueAsTableRow("`display`", "Read-only access to the Cloud Connector.") +
+					helpers.GetFormattedValueAsTableRow("`support`", "Read-only access and the support functions, e.g. traces.") +
+					helpers.GetFormattedValueAsTableRow("`monitoring`", "Access to the monitoring API."),
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(model.Roles...)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = client
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	userRolesMu.Lock()
+	defer userRolesMu.Unlock()
+
+	var plan model.UserConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
+	var password types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("password_wo"), &password)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	body := apiobjects.UserRequest{
+		User:        name,
+		Password:    password.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+
+	if err := r.Client.Users().Create(ctx, body); err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	if !plan.Roles.IsUnknown() {
+		r.setRoles(ctx, name, plan.Roles, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	r.readUser(ctx, plan, &resp.State, &resp.Identity, &resp.Diagnostics)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state model.UserConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	user, err := r.Client.Users().Get(ctx, state.Name.ValueString())
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	r.setState(ctx, state, user, &resp.State, &resp.Identity, &resp.Diagnostics)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	userRolesMu.Lock()
+	defer userRolesMu.Unlock()
+
+	var plan, state model.UserConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
+	name := plan.Name.ValueString()
+	passwordChanged := !plan.PasswordVersion.Equal(state.PasswordVersion)
+
+	if passwordChanged || !plan.Description.Equal(state.Description) {
+		body := apiobjects.UserRequest{
+			User:        name,
+			Description: plan.Description.ValueString(),
+		}
+
+		if passwordChanged {
+			var password types.String
+			diags = req.Config.GetAttribute(ctx, path.Root("password_wo"), &password)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			body.Password = password.ValueString()
+		}
+
+		if err := r.Client.Users().Update(ctx, name, body); err != nil {
+			resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+			return
+		}
+	}
+
+	if !plan.Roles.IsUnknown() && !plan.Roles.Equal(state.Roles) {
+		r.setRoles(ctx, name, plan.Roles, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	r.readUser(ctx, plan, &resp.State, &resp.Identity, &resp.Diagnostics)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	userRolesMu.Lock()
+	defer userRolesMu.Unlock()
+
+	var state model.UserConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	if err := r.Client.Users().Delete(ctx, state.Name.ValueString()); err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = helpers.ImportInstanceID(ctx, r.Client, req.ID, resp)
+
+	if req.ID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+		return
+	}
+
+	var identity userResourceIdentityModel
+	diags := resp.Identity.Get(ctx, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), identity.Name)...)
+}
+
+func (r *UserResource) setRoles(ctx context.Context, user string, roles types.Set, responseDiagnostics *diag.Diagnostics) {
+	names, diags := model.UserRolesRequest(ctx, roles)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	err := r.Client.Users().SetRoles(ctx, user, names)
+	responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+}
+
+// readUser reads the user of plan and stores it in the state and identity.
+func (r *UserResource) readUser(ctx context.Context, plan model.UserConfig, responseState *tfsdk.State, responseIdentity **tfsdk.ResourceIdentity, responseDiagnostics *diag.Diagnostics) {
+	user, err := r.Client.Users().Get(ctx, plan.Name.ValueString())
+	if err != nil {
+		responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	r.setState(ctx, plan, user, responseState, responseIdentity, responseDiagnostics)
+}
+
+func (r *UserResource) setState(ctx context.Context, plan model.UserConfig, user apiobjects.User, responseState *tfsdk.State, responseIdentity **tfsdk.ResourceIdentity, responseDiagnostics *diag.Diagnostics) {
+	responseModel, diags := model.UserResourceValueFrom(ctx, plan, user)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	diags = responseState.Set(ctx, &responseModel)
+	responseDiagnostics.Append(diags...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	identity := userResourceIdentityModel{
+		Name: responseModel.Name,
+	}
+
+	diags = (*responseIdentity).Set(ctx, identity)
+	responseDiagnostics.Append(diags...)
+}
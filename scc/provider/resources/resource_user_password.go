@@ -0,0 +1,200 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &UserPasswordResource{}
+
+func NewUserPasswordResource() resource.Resource {
+	return &UserPasswordResource{}
+}
+
+type UserPasswordResource struct {
+	Client *api.RestApiClient
+}
+
+func (r *UserPasswordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_password"
+}
+
+func (r *UserPasswordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector User Password Resource.
+
+Changes the password of a local Cloud Connector user, such as the *Administrator*, when the resource is created and whenever ` + "`password_wo_version`" + ` changes. If the provider authenticates as this user with Basic Authentication, it uses the new password for all later requests of the run. Update the password of the provider configuration, e.g. ` + "`SCC_PASSWORD`" + `, before the next run.
+
+The password cannot be read from the Cloud Connector, so it is neither stored in the Terraform state nor compared with the actual password. The resource does not detect changes made outside Terraform either: a user deleted on the Cloud Connector stays in the state until the next password change fails. On destroy, the resource is only removed from the Terraform state; the password of the user remains unchanged.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+* Write-only attributes require Terraform 1.11 or later.
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/authentication-and-ui-settings>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The name of the local user whose password is changed, e.g. `Administrator`. Changing this value forces the resource to be recreated.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "The new password of the user. This value is write-only and never stored in the Terraform state.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of `password_wo`. Changes of write-only attributes are not detected, so increase this value to change the password again.",
+				Optional:            true,
+			},
+			"current_password_wo": schema.StringAttribute{
+				MarkdownDescription: "The current password of the user, which the Cloud Connector requires to change it. Defaults to the password the provider authenticates with, if the provider authenticates as `user` with Basic Authentication. This value is write-only and never stored in the Terraform state.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user password resource. It is the name of the user.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserPasswordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = client
+}
+
+func (r *UserPasswordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan model.UserPasswordConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
+	r.changePassword(ctx, req.Config, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setState(ctx, plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *UserPasswordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The password cannot be read from the Cloud Connector, so the state is kept
+	// as it is. The existence of the user is not checked either, a deleted user
+	// is only noticed when its password is changed the next time.
+}
+
+func (r *UserPasswordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state model.UserPasswordConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
+	if !plan.PasswordVersion.Equal(state.PasswordVersion) {
+		r.changePassword(ctx, req.Config, plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	r.setState(ctx, plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *UserPasswordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The password cannot be restored, so destroying the resource only removes
+	// it from the state.
+	resp.State.RemoveResource(ctx)
+}
+
+// changePassword changes the password of the planned user to the write-only
+// password of config.
+func (r *UserPasswordResource) changePassword(ctx context.Context, config tfsdk.Config, plan model.UserPasswordConfig, responseDiagnostics *diag.Diagnostics) {
+	var password, currentPassword types.String
+	responseDiagnostics.Append(config.GetAttribute(ctx, path.Root("password_wo"), &password)...)
+	responseDiagnostics.Append(config.GetAttribute(ctx, path.Root("current_password_wo"), &currentPassword)...)
+	if responseDiagnostics.HasError() {
+		return
+	}
+
+	user := plan.User.ValueString()
+	current := currentPassword.ValueString()
+	if current == "" {
+		var ok bool
+		if current, ok = r.Client.BasicAuthPassword(ctx, user); !ok {
+			responseDiagnostics.AddAttributeError(
+				path.Root("current_password_wo"),
+				"Missing Current Password",
+				fmt.Sprintf("The provider does not authenticate as user %q with Basic Authentication, so the current password of the user must be set in current_password_wo.", user),
+			)
+			return
+		}
+	}
+
+	err := r.Client.Authentication().ChangePassword(ctx, user, current, password.ValueString())
+	responseDiagnostics.Append(api.ErrorDiagnostics(err)...)
+}
+
+func (r *UserPasswordResource) setState(ctx context.Context, plan model.UserPasswordConfig, responseState *tfsdk.State, responseDiagnostics *diag.Diagnostics) {
+	// Write-only values must not be stored in the state.
+	plan.Password = types.StringNull()
+	plan.CurrentPassword = types.StringNull()
+	plan.ID = plan.User
+
+	diags := responseState.Set(ctx, plan)
+	responseDiagnostics.Append(diags...)
+}
@@ -0,0 +1,203 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResourceUserPassword(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path - provider credential is switched", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			// Destroying the resource keeps the password.
+			CheckDestroy: checkUserPassword(srv, sccmock.Username, sccmock.Password),
+			Steps: []resource.TestStep{
+				// The audit log settings are applied after the password change,
+				// so they are only accepted with the switched credential.
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceUserPasswordBeforeAuditLogSettings("scc_up", "new-password", 1),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_user_password.scc_up", "user", sccmock.Username),
+						resource.TestCheckResourceAttr("scc_user_password.scc_up", "password_wo_version", "1"),
+						resource.TestCheckNoResourceAttr("scc_user_password.scc_up", "password_wo"),
+						resource.TestCheckResourceAttr("scc_user_password.scc_up", "id", sccmock.Username),
+						resource.TestCheckResourceAttr("scc_audit_log_settings.scc_als", "level", "all"),
+						checkUserPassword(srv, sccmock.Username, "new-password"),
+						resetUserPassword(srv, sccmock.Username, sccmock.Password),
+					),
+				},
+				// Without a new password_wo_version, the password is kept.
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceUserPasswordBeforeAuditLogSettings("scc_up", "other-password", 1),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectEmptyPlan(),
+						},
+					},
+					Check: checkUserPassword(srv, sccmock.Username, sccmock.Password),
+				},
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceUserPasswordBeforeAuditLogSettings("scc_up", "newer-password", 2),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_user_password.scc_up", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_user_password.scc_up", "password_wo_version", "2"),
+						checkUserPassword(srv, sccmock.Username, "newer-password"),
+						resetUserPassword(srv, sccmock.Username, sccmock.Password),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - other user with current password", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+		srv.Update(func(state *sccmock.State) {
+			state.Users = append(state.Users, &sccmock.User{Name: "operator", Password: "old-password"})
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceUserPasswordWithCurrentPassword("scc_up", "operator", "new-password", "old-password", 1),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_user_password.scc_up", "id", "operator"),
+						resource.TestCheckNoResourceAttr("scc_user_password.scc_up", "current_password_wo"),
+						checkUserPassword(srv, "operator", "new-password"),
+						// The provider keeps authenticating as the Administrator.
+						checkUserPassword(srv, sccmock.Username, sccmock.Password),
+					),
+				},
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceUserPasswordWithCurrentPassword("scc_up", "operator", "newer-password", "new-password", 2),
+					Check:  checkUserPassword(srv, "operator", "newer-password"),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - current password required for other users", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+		srv.Update(func(state *sccmock.State) {
+			state.Users = append(state.Users, &sccmock.User{Name: "operator", Password: "old-password"})
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config:      tfutils.ProviderConfig(user) + ResourceUserPassword("scc_up", "operator", "new-password", 1),
+					ExpectError: regexp.MustCompile(`Missing Current Password`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - wrong current password", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+		srv.Update(func(state *sccmock.State) {
+			state.Users = append(state.Users, &sccmock.User{Name: "operator", Password: "old-password"})
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config:      tfutils.ProviderConfig(user) + ResourceUserPasswordWithCurrentPassword("scc_up", "operator", "new-password", "wrong", 1),
+					ExpectError: regexp.MustCompile(`The current password of user operator is wrong`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - empty password", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceUserPassword("scc_up", sccmock.Username, "", 1),
+					ExpectError: regexp.MustCompile(`Attribute password_wo string length must be at least 1`),
+				},
+			},
+		})
+	})
+}
+
+// checkUserPassword verifies the password stored by the fake Cloud Connector,
+// as the password is never read back.
+func checkUserPassword(srv *sccmock.Server, name string, password string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		var err error
+		srv.Update(func(state *sccmock.State) {
+			switch u := state.User(name); {
+			case u == nil:
+				err = fmt.Errorf("user %s not found", name)
+			case u.Password != password:
+				err = fmt.Errorf("expected the password %s of user %s, got %s", password, name, u.Password)
+			}
+		})
+		return err
+	}
+}
+
+// resetUserPassword resets the password of a user on the fake Cloud
+// Connector, so the provider configuration of the following plans still
+// authenticates. A real configuration is updated before the next run instead.
+func resetUserPassword(srv *sccmock.Server, name string, password string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		srv.Update(func(state *sccmock.State) {
+			state.User(name).Password = password
+		})
+		return nil
+	}
+}
+
+func ResourceUserPassword(resourceName string, user string, password string, passwordVersion int) string {
+	return fmt.Sprintf(`
+	resource "scc_user_password" "%s" {
+		user = "%s"
+		password_wo = "%s"
+		password_wo_version = %d
+	}
+	`, resourceName, user, password, passwordVersion)
+}
+
+func ResourceUserPasswordWithCurrentPassword(resourceName string, user string, password string, currentPassword string, passwordVersion int) string {
+	return fmt.Sprintf(`
+	resource "scc_user_password" "%s" {
+		user = "%s"
+		password_wo = "%s"
+		password_wo_version = %d
+		current_password_wo = "%s"
+	}
+	`, resourceName, user, password, passwordVersion, currentPassword)
+}
+
+func ResourceUserPasswordBeforeAuditLogSettings(resourceName string, password string, passwordVersion int) string {
+	return ResourceUserPassword(resourceName, sccmock.Username, password, passwordVersion) + fmt.Sprintf(`
+	resource "scc_audit_log_settings" "scc_als" {
+		level = "all"
+		depends_on = [scc_user_password.%s]
+	}
+	`, resourceName)
+}
@@ -0,0 +1,275 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/scc/provider/helpers"
+	"github.com/SAP/terraform-provider-scc/scc/provider/model"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &UserRoleAssignmentResource{}
+
+func NewUserRoleAssignmentResource() resource.Resource {
+	return &UserRoleAssignmentResource{}
+}
+
+type UserRoleAssignmentResource struct {
+	Client *api.RestApiClient
+}
+
+type userRoleAssignmentResourceIdentityModel struct {
+	User types.String `tfsdk:"user"`
+	Role types.String `tfsdk:"role"`
+}
+
+func (r *UserRoleAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_role_assignment"
+}
+
+func (r *UserRoleAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cloud Connector User Role Assignment Resource.
+
+Assigns a single role to a local user of the Cloud Connector, leaving the other roles of the user unchanged. Do not combine this resource with the ` + "`roles`" + ` attribute of ` + "`scc_user`" + ` for the same user.
+
+__Tips:__
+* You must be assigned to the following roles:
+	* Administrator
+
+__Further documentation:__
+<https://help.sap.com/docs/connectivity/sap-btp-connectivity-cf/use-ldap-for-authentication>`,
+		Attributes: map[string]schema.Attribute{
+			"instance": helpers.ResourceInstanceAttribute(),
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The name of the local user. Changing this value forces the resource to be recreated.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The role assigned to the user. Changing this value forces the resource to be recreated. Possible values are: \n" +
+					helpers.GetFormattedValueAsTableRow("role", "description") +
+					helpers.GetFormattedValueAsTableRow("---", "---") +
+					helpers.GetFormattedValueAsTableRow("`administrator`", "Full access to the Cloud Connector.") +
+					helpers.GetFormattedValueAsTableRow("`subaccount_administrator`", "Manages subaccounts and their configuration.") +
+					helpers.GetFormattedValueAsTableRow("`display`", "Read-only access to the Cloud Connector.") +
+					helpers.GetFormattedValueAsTableRow("`support`", "Read-only access and the support functions, e.g. traces.") +
+					helpers.GetFormattedValueAsTableRow("`monitoring`", "Access to the monitoring API."),
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.Roles...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserRoleAssignmentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"user": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+			"role": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *UserRoleAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.RestApiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.RestApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = client
+}
+
+func (r *UserRoleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	userRolesMu.Lock()
+	defer userRolesMu.Unlock()
+
+	var plan model.UserRoleAssignmentConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, plan.Instance.ValueString())
+
+	name := plan.User.ValueString()
+	user, err := r.Client.Users().Get(ctx, name)
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	// An assignment that already exists is adopted.
+	if !model.UserHasRole(user, plan.Role.ValueString()) {
+		roles := append(slices.Clone(user.Roles), model.RoleName(plan.Role.ValueString()))
+		if err := r.Client.Users().SetRoles(ctx, name, roles); err != nil {
+			resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identity := userRoleAssignmentResourceIdentityModel{
+		User: plan.User,
+		Role: plan.Role,
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *UserRoleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state model.UserRoleAssignmentConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	user, err := r.Client.Users().Get(ctx, state.User.ValueString())
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	// The role has been removed from the user outside of Terraform.
+	if !model.UserHasRole(user, state.Role.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identity := userRoleAssignmentResourceIdentityModel{
+		User: state.User,
+		Role: state.Role,
+	}
+
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *UserRoleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Updating User Role Assignments is Not Supported",
+		"A user role assignment cannot be changed. Changes of the user or role replace the resource.",
+	)
+}
+
+func (r *UserRoleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	userRolesMu.Lock()
+	defer userRolesMu.Unlock()
+
+	var state model.UserRoleAssignmentConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = api.WithInstance(ctx, state.Instance.ValueString())
+
+	name := state.User.ValueString()
+	user, err := r.Client.Users().Get(ctx, name)
+	if api.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+
+	roleName := model.RoleName(state.Role.ValueString())
+	if !slices.Contains(user.Roles, roleName) {
+		return
+	}
+
+	roles := slices.DeleteFunc(slices.Clone(user.Roles), func(role string) bool { return role == roleName })
+	if err := r.Client.Users().SetRoles(ctx, name, roles); err != nil {
+		resp.Diagnostics.Append(api.ErrorDiagnostics(err)...)
+		return
+	}
+}
+
+func (r *UserRoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	req.ID = helpers.ImportInstanceID(ctx, r.Client, req.ID, resp)
+
+	if req.ID != "" {
+		idParts := strings.Split(req.ID, ",")
+
+		if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: user, role. Got: %q", req.ID),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), idParts[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), idParts[1])...)
+
+		return
+	}
+
+	var identity userRoleAssignmentResourceIdentityModel
+	diags := resp.Identity.Get(ctx, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), identity.User)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), identity.Role)...)
+}
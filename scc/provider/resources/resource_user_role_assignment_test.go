@@ -0,0 +1,188 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+func TestResourceUserRoleAssignment(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+		srv.Update(func(state *sccmock.State) {
+			state.Users = append(state.Users, &sccmock.User{Name: "jdoe", Password: "secret", Roles: []string{"sccdisplay"}})
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			// Only the assigned role is removed.
+			CheckDestroy: checkUserRoles(srv, "jdoe", "sccdisplay"),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceUserRoleAssignment("scc_ura", "jdoe", "support"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_user_role_assignment.scc_ura", "user", "jdoe"),
+						resource.TestCheckResourceAttr("scc_user_role_assignment.scc_ura", "role", "support"),
+						checkUserRoles(srv, "jdoe", "sccdisplay", "sccsupport"),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity(
+							"scc_user_role_assignment.scc_ura",
+							map[string]knownvalue.Check{
+								"user": knownvalue.StringExact("jdoe"),
+								"role": knownvalue.StringExact("support"),
+							},
+						),
+					},
+				},
+				{
+					ResourceName:                         "scc_user_role_assignment.scc_ura",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "jdoe,support",
+					ImportStateVerifyIdentifierAttribute: "user",
+				},
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceUserRoleAssignment("scc_ura", "jdoe", "monitoring"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_user_role_assignment.scc_ura", plancheck.ResourceActionReplace),
+						},
+					},
+					Check: checkUserRoles(srv, "jdoe", "sccdisplay", "sccmonitoring"),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - roles of a managed user", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceUserWoRoles("scc_user", "jdoe", "secret") + `
+					resource "scc_user_role_assignment" "display" {
+						user = scc_user.scc_user.name
+						role = "display"
+					}
+
+					resource "scc_user_role_assignment" "support" {
+						user = scc_user.scc_user.name
+						role = "support"
+						depends_on = [scc_user_role_assignment.display]
+					}
+					`,
+					Check: checkUserRoles(srv, "jdoe", "sccdisplay", "sccsupport"),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - removed outside of terraform is recreated", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+		srv.Update(func(state *sccmock.State) {
+			state.Users = append(state.Users, &sccmock.User{Name: "jdoe", Password: "secret", Roles: []string{}})
+		})
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceUserRoleAssignment("scc_ura", "jdoe", "administrator"),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.User("jdoe").Roles = []string{"sccdisplay"}
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceUserRoleAssignment("scc_ura", "jdoe", "administrator"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_user_role_assignment.scc_ura", plancheck.ResourceActionCreate),
+						},
+					},
+					Check: checkUserRoles(srv, "jdoe", "sccdisplay", "sccadmin"),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - unknown user", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config:      tfutils.ProviderConfig(user) + ResourceUserRoleAssignment("scc_ura", "jdoe", "support"),
+					ExpectError: regexp.MustCompile(`User jdoe not found`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid role", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceUserRoleAssignment("scc_ura", "jdoe", "sccsupport"),
+					ExpectError: regexp.MustCompile(`Attribute role value must be one of`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid import id", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+		srv.Update(func(state *sccmock.State) {
+			state.Users = append(state.Users, &sccmock.User{Name: "jdoe", Password: "secret", Roles: []string{}})
+		})
+
+		steps := []resource.TestStep{
+			{
+				Config: tfutils.ProviderConfig(user) + ResourceUserRoleAssignment("scc_ura", "jdoe", "support"),
+			},
+		}
+		for _, id := range []string{"jdoe", "jdoe,", "jdoe,support,extra"} {
+			steps = append(steps, resource.TestStep{
+				ResourceName:  "scc_user_role_assignment.scc_ura",
+				ImportState:   true,
+				ImportStateId: id,
+				ExpectError:   regexp.MustCompile(`Expected import identifier with format: user, role`),
+			})
+		}
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps:                    steps,
+		})
+	})
+}
+
+func ResourceUserRoleAssignment(resourceName string, user string, role string) string {
+	return fmt.Sprintf(`
+	resource "scc_user_role_assignment" "%s" {
+		user = "%s"
+		role = "%s"
+	}
+	`, resourceName, user, role)
+}
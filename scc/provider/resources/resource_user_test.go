@@ -0,0 +1,253 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
+	"github.com/SAP/terraform-provider-scc/scc/provider/tfutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestResourceUser(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			CheckDestroy: func(_ *terraform.State) error {
+				var err error
+				srv.Update(func(state *sccmock.State) {
+					if state.User("jdoe") != nil {
+						err = fmt.Errorf("user jdoe still exists")
+					}
+				})
+				return err
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceUser("scc_user", "jdoe", "John Doe", "secret", 1, `["display", "administrator"]`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_user.scc_user", "name", "jdoe"),
+						resource.TestCheckResourceAttr("scc_user.scc_user", "description", "John Doe"),
+						resource.TestCheckNoResourceAttr("scc_user.scc_user", "password_wo"),
+						resource.TestCheckResourceAttr("scc_user.scc_user", "password_wo_version", "1"),
+						resource.TestCheckResourceAttr("scc_user.scc_user", "roles.#", "2"),
+						resource.TestCheckTypeSetElemAttr("scc_user.scc_user", "roles.*", "administrator"),
+						resource.TestCheckTypeSetElemAttr("scc_user.scc_user", "roles.*", "display"),
+						checkUserPassword(srv, "jdoe", "secret"),
+						checkUserRoles(srv, "jdoe", "sccadmin", "sccdisplay"),
+					),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectIdentity(
+							"scc_user.scc_user",
+							map[string]knownvalue.Check{
+								"name": knownvalue.StringExact("jdoe"),
+							},
+						),
+					},
+				},
+				{
+					ResourceName:                         "scc_user.scc_user",
+					ImportState:                          true,
+					ImportStateVerify:                    true,
+					ImportStateId:                        "jdoe",
+					ImportStateVerifyIdentifierAttribute: "name",
+					ImportStateVerifyIgnore: []string{
+						"password_wo_version",
+					},
+				},
+				// Without a new password_wo_version, the password is kept.
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceUser("scc_user", "jdoe", "Jane Doe", "changed", 1, `["support"]`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_user.scc_user", "description", "Jane Doe"),
+						resource.TestCheckResourceAttr("scc_user.scc_user", "roles.#", "1"),
+						resource.TestCheckTypeSetElemAttr("scc_user.scc_user", "roles.*", "support"),
+						checkUserPassword(srv, "jdoe", "secret"),
+						checkUserRoles(srv, "jdoe", "sccsupport"),
+					),
+				},
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceUser("scc_user", "jdoe", "Jane Doe", "changed", 2, `["support"]`),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_user.scc_user", "password_wo_version", "2"),
+						checkUserPassword(srv, "jdoe", "changed"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - roles not managed", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceUserWoRoles("scc_user", "jdoe", "secret"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckNoResourceAttr("scc_user.scc_user", "description"),
+						resource.TestCheckResourceAttr("scc_user.scc_user", "roles.#", "0"),
+						checkUserRoles(srv, "jdoe"),
+					),
+				},
+				// Roles assigned outside of Terraform are kept.
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.User("jdoe").Roles = []string{"sccdisplay"}
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceUserWoRoles("scc_user", "jdoe", "secret"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectEmptyPlan(),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_user.scc_user", "roles.#", "1"),
+						resource.TestCheckTypeSetElemAttr("scc_user.scc_user", "roles.*", "display"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - drift is reverted", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceUser("scc_user", "jdoe", "John Doe", "secret", 1, `["display"]`),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.User("jdoe").Description = "Someone else"
+							state.User("jdoe").Roles = []string{"sccadmin", "sccmonitoring"}
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceUser("scc_user", "jdoe", "John Doe", "secret", 1, `["display"]`),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_user.scc_user", plancheck.ResourceActionUpdate),
+						},
+					},
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("scc_user.scc_user", "description", "John Doe"),
+						checkUserRoles(srv, "jdoe", "sccdisplay"),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("happy path - removed outside of terraform is recreated", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config: tfutils.ProviderConfig(user) + ResourceUser("scc_user", "jdoe", "John Doe", "secret", 1, `["display"]`),
+				},
+				{
+					PreConfig: func() {
+						srv.Update(func(state *sccmock.State) {
+							state.Users = slices.DeleteFunc(state.Users, func(u *sccmock.User) bool { return u.Name == "jdoe" })
+						})
+					},
+					Config: tfutils.ProviderConfig(user) + ResourceUser("scc_user", "jdoe", "John Doe", "secret", 1, `["display"]`),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("scc_user.scc_user", plancheck.ResourceActionCreate),
+						},
+					},
+					Check: checkUserRoles(srv, "jdoe", "sccdisplay"),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - user exists", func(t *testing.T) {
+		srv, user := tfutils.SetupMock(t)
+
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+			Steps: []resource.TestStep{
+				{
+					Config:      tfutils.ProviderConfig(user) + ResourceUserWoRoles("scc_user", sccmock.Username, "secret"),
+					ExpectError: regexp.MustCompile(`User Administrator already exists`),
+				},
+			},
+		})
+	})
+
+	t.Run("error path - invalid role", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: tfutils.GetTestProviders(nil),
+			Steps: []resource.TestStep{
+				{
+					Config:      ResourceUser("scc_user", "jdoe", "John Doe", "secret", 1, `["sccadmin"]`),
+					ExpectError: regexp.MustCompile(`value must be one of`),
+				},
+			},
+		})
+	})
+}
+
+// checkUserRoles verifies the roles of a user on the fake Cloud Connector, in
+// their order in the Cloud Connector API.
+func checkUserRoles(srv *sccmock.Server, name string, roles ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		var err error
+		srv.Update(func(state *sccmock.State) {
+			switch u := state.User(name); {
+			case u == nil:
+				err = fmt.Errorf("user %s not found", name)
+			case fmt.Sprint(u.Roles) != fmt.Sprint(roles):
+				err = fmt.Errorf("expected the roles %v of user %s, got %v", roles, name, u.Roles)
+			}
+		})
+		return err
+	}
+}
+
+func ResourceUser(resourceName string, name string, description string, password string, passwordVersion int, roles string) string {
+	return fmt.Sprintf(`
+	resource "scc_user" "%s" {
+		name = "%s"
+		description = "%s"
+		password_wo = "%s"
+		password_wo_version = %d
+		roles = %s
+	}
+	`, resourceName, name, description, password, passwordVersion, roles)
+}
+
+func ResourceUserWoRoles(resourceName string, name string, password string) string {
+	return fmt.Sprintf(`
+	resource "scc_user" "%s" {
+		name = "%s"
+		password_wo = "%s"
+	}
+	`, resourceName, name, password)
+}
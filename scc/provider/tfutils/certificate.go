@@ -9,8 +9,10 @@ import (
 	"crypto/x509/pkix"
 	"encoding/pem"
 	"math/big"
+	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"slices"
 	"testing"
 	"time"
 
@@ -32,6 +34,57 @@ func NewTestClient(t *testing.T, server *httptest.Server) *api.RestApiClient {
 	}
 }
 
+// CertificateRequest is a kind of request to a certificate endpoint, see
+// NewCertificateTestClient.
+type CertificateRequest int
+
+const (
+	// CertificateUpload creates, uploads or replaces the certificate.
+	CertificateUpload CertificateRequest = iota
+	// CertificateGet reads the certificate metadata.
+	CertificateGet
+	// CertificateDownload reads the DER encoded certificate.
+	CertificateDownload
+	// CertificateDelete deletes the certificate.
+	CertificateDelete
+)
+
+// NewCertificateTestClient returns a client for a Cloud Connector holding the
+// DER encoded certificate der. Requests of the kinds in fail are answered with
+// status 500.
+func NewCertificateTestClient(t *testing.T, der []byte, fail ...CertificateRequest) *api.RestApiClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		kind := CertificateUpload
+		switch {
+		case r.Method == http.MethodGet && r.Header.Get("Accept") == "application/pkix-cert":
+			kind = CertificateDownload
+		case r.Method == http.MethodGet:
+			kind = CertificateGet
+		case r.Method == http.MethodDelete:
+			kind = CertificateDelete
+		}
+
+		if slices.Contains(fail, kind) {
+			http.Error(w, "certificate request failed", http.StatusInternalServerError)
+			return
+		}
+
+		switch kind {
+		case CertificateGet:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte("{}"))
+		case CertificateDownload:
+			w.Header().Set("Content-Type", "application/pkix-cert")
+			_, _ = w.Write(der)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return NewTestClient(t, server)
+}
+
 func GenerateTestCert(t *testing.T) string {
 	priv, err := rsa.GenerateKey(rand.Reader, 2048)
 	require.NoError(t, err)
@@ -93,6 +146,7 @@ func BuildSignedChainPlan(
 	}
 
 	attrTypes := map[string]tftypes.Type{
+		"instance":      tftypes.String,
 		"signed_chain":  tftypes.String,
 		"subject_dn":    subjectDNType,
 		"valid_to":      tftypes.String,
@@ -102,6 +156,7 @@ func BuildSignedChainPlan(
 	}
 
 	values := map[string]tftypes.Value{
+		"instance":      tftypes.NewValue(tftypes.String, nil),
 		"signed_chain":  tftypes.NewValue(tftypes.String, chain),
 		"subject_dn":    tftypes.NewValue(subjectDNType, nil),
 		"valid_to":      tftypes.NewValue(tftypes.String, nil),
@@ -163,6 +218,7 @@ func BuildSignedChainState(ctx context.Context, r *resources.SystemCertificateSi
 		Raw: tftypes.NewValue(
 			tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
+					"instance":        tftypes.String,
 					"signed_chain":    tftypes.String,
 					"certificate_pem": tftypes.String,
 					"issuer":          tftypes.String,
@@ -173,6 +229,7 @@ func BuildSignedChainState(ctx context.Context, r *resources.SystemCertificateSi
 				},
 			},
 			map[string]tftypes.Value{
+				"instance":        tftypes.NewValue(tftypes.String, nil),
 				"signed_chain":    tftypes.NewValue(tftypes.String, chain),
 				"certificate_pem": tftypes.NewValue(tftypes.String, ""),
 				"issuer":          tftypes.NewValue(tftypes.String, ""),
@@ -0,0 +1,42 @@
+package tfutils
+
+import (
+	"testing"
+
+	"github.com/SAP/terraform-provider-scc/internal/api"
+	"github.com/SAP/terraform-provider-scc/internal/sccmock"
+)
+
+// SetupMock starts a fake Cloud Connector and returns it together with the
+// test user for the provider configuration. Unlike SetupVCR it needs neither a
+// live instance nor recorded fixtures:
+//
+//	srv, user := tfutils.SetupMock(t)
+//	resource.Test(t, resource.TestCase{
+//		ProtoV6ProviderFactories: tfutils.GetTestProviders(srv.Client()),
+//		Steps: []resource.TestStep{{Config: tfutils.ProviderConfig(user) + ...}},
+//	})
+func SetupMock(t *testing.T) (*sccmock.Server, User) {
+	t.Helper()
+
+	srv := sccmock.NewServer(t)
+
+	user := redactedTestUser
+	user.InstanceURL = srv.URL
+	user.InstanceUsername = sccmock.Username
+	user.InstancePassword = sccmock.Password
+
+	return srv, user
+}
+
+// NewMockClient returns an API client authenticated against the fake Cloud
+// Connector, for unit tests calling resource methods directly.
+func NewMockClient(t *testing.T, srv *sccmock.Server) *api.RestApiClient {
+	t.Helper()
+
+	client := NewTestClient(t, srv.Server)
+	client.Username = sccmock.Username
+	client.Password = sccmock.Password
+
+	return client
+}
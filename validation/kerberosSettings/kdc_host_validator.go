@@ -0,0 +1,57 @@
+package kerberosSettings
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// --- Wrapper ---
+type KDCHostValidator struct{}
+
+func (v KDCHostValidator) Description(_ context.Context) string {
+	return "Validates a KDC host in the format host[:port]"
+}
+
+func (v KDCHostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v KDCHostValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	host := req.ConfigValue.ValueString()
+	if !ValidateKDCHost(host) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid KDC Host",
+			fmt.Sprintf("Value %q is not a valid KDC host. Allowed: host[:port] with a port between 1 and 65535.", host),
+		)
+	}
+}
+
+var hostPattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9.-]*[A-Za-z0-9])?$`)
+
+// ValidateKDCHost reports whether value is a host name or IPv4 address with an
+// optional port.
+func ValidateKDCHost(value string) bool {
+	host, port, err := net.SplitHostPort(value)
+	if err != nil {
+		return hostPattern.MatchString(value)
+	}
+	if !hostPattern.MatchString(host) {
+		return false
+	}
+	n, err := strconv.Atoi(port)
+	return err == nil && n >= 1 && n <= 65535
+}
+
+func ValidateHost() validator.String {
+	return KDCHostValidator{}
+}
@@ -0,0 +1,96 @@
+package kerberosSettings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateKDCHost(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected bool
+	}{
+		{"host", "kdc.example.com", true},
+		{"host with port", "kdc.example.com:88", true},
+		{"ip address", "10.0.0.1:750", true},
+		{"short host", "kdc", true},
+
+		{"empty", "", false},
+		{"scheme", "kerberos://kdc.example.com", false},
+		{"port zero", "kdc.example.com:0", false},
+		{"port too high", "kdc.example.com:65536", false},
+		{"empty port", "kdc.example.com:", false},
+		{"non numeric port", "kdc.example.com:kdc", false},
+		{"invalid character", "kdc_example.com", false},
+		{"leading dot", ".example.com", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidateKDCHost(tt.value); got != tt.expected {
+				t.Errorf("ValidateKDCHost(%q) = %v, want %v", tt.value, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateBase64Keytab(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected bool
+	}{
+		{"base64", "BQIAAAA3AAEAC0VYQU1QTEUuQ09N", true},
+		{"empty", "", false},
+		{"not base64", "not a keytab", false},
+		{"url encoding", "BQIA-_A", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidateBase64Keytab(tt.value); got != tt.expected {
+				t.Errorf("ValidateBase64Keytab(%q) = %v, want %v", tt.value, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.String
+		value     types.String
+		wantError string
+	}{
+		{name: "host null skipped", validator: ValidateHost(), value: types.StringNull()},
+		{name: "host unknown skipped", validator: ValidateHost(), value: types.StringUnknown()},
+		{name: "valid host", validator: ValidateHost(), value: types.StringValue("kdc.example.com:88")},
+		{name: "invalid host", validator: ValidateHost(), value: types.StringValue("kdc.example.com:0"), wantError: "Invalid KDC Host"},
+		{name: "keytab null skipped", validator: ValidateKeytab(), value: types.StringNull()},
+		{name: "valid keytab", validator: ValidateKeytab(), value: types.StringValue("BQIAAAA3")},
+		{name: "invalid keytab", validator: ValidateKeytab(), value: types.StringValue("secret"), wantError: "Invalid Keytab"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("attribute"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+			tt.validator.ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != (tt.wantError != "") {
+				t.Fatalf("expected error %q, got %v", tt.wantError, resp.Diagnostics)
+			}
+			if tt.wantError != "" && resp.Diagnostics.Errors()[0].Summary() != tt.wantError {
+				t.Errorf("unexpected error %v", resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package kerberosSettings
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// --- Wrapper ---
+type KeytabValidator struct{}
+
+func (v KeytabValidator) Description(_ context.Context) string {
+	return "Validates that the keytab is Base64-encoded"
+}
+
+func (v KeytabValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v KeytabValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// The value is sensitive, so it is not part of the error message.
+	if !ValidateBase64Keytab(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Keytab",
+			"The keytab must be the Base64-encoded content of a keytab file, e.g. filebase64(\"scc.keytab\").",
+		)
+	}
+}
+
+// ValidateBase64Keytab reports whether value is non-empty, standard Base64.
+func ValidateBase64Keytab(value string) bool {
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	return err == nil && len(decoded) > 0
+}
+
+func ValidateKeytab() validator.String {
+	return KeytabValidator{}
+}
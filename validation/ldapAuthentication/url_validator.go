@@ -0,0 +1,115 @@
+package ldapAuthentication
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// --- Wrapper ---
+type URLValidator struct{}
+
+func (v URLValidator) Description(_ context.Context) string {
+	return "Validates an LDAP server URL based on secure"
+}
+
+func (v URLValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v URLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	urlValue := req.ConfigValue.ValueString()
+	if !ValidateLDAPURL(urlValue) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid LDAP URL",
+			fmt.Sprintf("Value %q is not a valid LDAP URL. Allowed: ldap://host[:port] or ldaps://host[:port] with a port between 1 and 65535.", urlValue),
+		)
+		return
+	}
+
+	var secure types.Bool
+	diags := req.Config.GetAttribute(ctx, path.Root("secure"), &secure)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || secure.IsUnknown() {
+		return
+	}
+
+	// An omitted secure attribute defaults to false, so ValueBool of null
+	// matches the planned value.
+	if IsSecureLDAPURL(urlValue) != secure.ValueBool() {
+		scheme := "ldap"
+		if secure.ValueBool() {
+			scheme = "ldaps"
+		}
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid LDAP URL Scheme",
+			fmt.Sprintf("Value %q is not valid for secure = %t. Must use the %s scheme.", urlValue, secure.ValueBool(), scheme),
+		)
+	}
+}
+
+var hostPattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9.-]*[A-Za-z0-9])?$`)
+
+// ValidateLDAPURL reports whether value is an ldap:// or ldaps:// URL of a host
+// with an optional port, and without credentials, path or query.
+func ValidateLDAPURL(value string) bool {
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "ldap" && u.Scheme != "ldaps") {
+		return false
+	}
+	if u.User != nil || u.RawQuery != "" || u.Fragment != "" || (u.Path != "" && u.Path != "/") {
+		return false
+	}
+	if !isValidHost(u) {
+		return false
+	}
+	if port := u.Port(); port != "" || strings.HasSuffix(u.Host, ":") {
+		return isValidPort(port)
+	}
+	return true
+}
+
+// IsSecureLDAPURL reports whether value uses the ldaps scheme.
+func IsSecureLDAPURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && u.Scheme == "ldaps"
+}
+
+// isValidHost reports whether the host of u is a host name, an IPv4 address or
+// an IPv6 address in brackets.
+func isValidHost(u *url.URL) bool {
+	if strings.HasPrefix(u.Host, "[") {
+		ip := net.ParseIP(u.Hostname())
+		return ip != nil && ip.To4() == nil
+	}
+	return hostPattern.MatchString(u.Hostname())
+}
+
+func isValidPort(s string) bool {
+	if !regexp.MustCompile(`^[0-9]{1,5}$`).MatchString(s) {
+		return false
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return false
+	}
+	return n >= 1 && n <= 65535
+}
+
+func ValidateURL() validator.String {
+	return URLValidator{}
+}
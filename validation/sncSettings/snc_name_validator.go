@@ -0,0 +1,59 @@
+package sncSettings
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// --- Wrapper ---
+type NameValidator struct{}
+
+func (v NameValidator) Description(_ context.Context) string {
+	return "Validates an SNC name in the format p:<Distinguished_Name>"
+}
+
+func (v NameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v NameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	name := req.ConfigValue.ValueString()
+	if !ValidateSNCName(name) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid SNC Name",
+			fmt.Sprintf("Value %q is not a valid SNC name. Allowed: p:<Distinguished_Name>, e.g. p:CN=SCC, O=Example, C=DE.", name),
+		)
+	}
+}
+
+var relativeNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9.]*=[^=]+$`)
+
+// ValidateSNCName reports whether value is the SNC name of an X.509
+// certificate: the prefix "p:" followed by a distinguished name of
+// comma-separated attribute=value pairs.
+func ValidateSNCName(value string) bool {
+	dn, ok := strings.CutPrefix(value, "p:")
+	if !ok || strings.TrimSpace(dn) == "" {
+		return false
+	}
+
+	for _, rdn := range strings.Split(dn, ",") {
+		if !relativeNamePattern.MatchString(strings.TrimSpace(rdn)) {
+			return false
+		}
+	}
+	return true
+}
+
+func ValidateName() validator.String {
+	return NameValidator{}
+}
@@ -0,0 +1,81 @@
+package sncSettings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateSNCName(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected bool
+	}{
+		{"single attribute", "p:CN=SCC", true},
+		{"full name", "p:CN=SCC, OU=Integration, O=Example, C=DE", true},
+		{"without spaces", "p:CN=SCC,O=Example,C=DE", true},
+		{"value with spaces", "p:CN=Cloud Connector, O=Example Corp", true},
+		{"email attribute", "p:E=scc@example.com, CN=SCC", true},
+
+		{"missing prefix", "CN=SCC, O=Example", false},
+		{"wrong prefix", "s:CN=SCC", false},
+		{"empty name", "p:", false},
+		{"blank name", "p:   ", false},
+		{"missing value", "p:CN=", false},
+		{"missing attribute", "p:=SCC", false},
+		{"empty part", "p:CN=SCC,,O=Example", false},
+		{"trailing comma", "p:CN=SCC,", false},
+		{"no attribute", "p:SCC", false},
+		{"double equals", "p:CN=S=CC", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidateSNCName(tt.value); got != tt.expected {
+				t.Errorf("ValidateSNCName(%q) = %v, want %v", tt.value, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNameValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     types.String
+		wantError bool
+	}{
+		{name: "null skipped", value: types.StringNull()},
+		{name: "unknown skipped", value: types.StringUnknown()},
+		{name: "valid name", value: types.StringValue("p:CN=SCC, O=Example, C=DE")},
+		{name: "invalid name", value: types.StringValue("CN=SCC"), wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("own_name"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+			ValidateName().ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("expected error %v, got %v", tt.wantError, resp.Diagnostics)
+			}
+			if tt.wantError && resp.Diagnostics.Errors()[0].Summary() != "Invalid SNC Name" {
+				t.Errorf("unexpected error %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestNameValidator_Description(t *testing.T) {
+	v := NameValidator{}
+	if v.Description(context.Background()) == "" || v.MarkdownDescription(context.Background()) == "" {
+		t.Error("expected non-empty description")
+	}
+}
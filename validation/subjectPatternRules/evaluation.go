@@ -0,0 +1,46 @@
+package subjectpatternrules
+
+import (
+	"regexp"
+)
+
+// MatchesCondition reports whether a principal with the given variables
+// fulfills the condition of a subject pattern rule. Variables that are not set
+// or empty do not exist.
+func MatchesCondition(variable, operator, value string, variables map[string]string) bool {
+	actual, exists := variables[variable]
+	exists = exists && actual != ""
+
+	switch operator {
+	case "always_true":
+		return true
+	case "exist":
+		return exists
+	case "exist_not":
+		return !exists
+	case "is":
+		return exists && actual == value
+	case "is_not":
+		return !exists || actual != value
+	default:
+		return false
+	}
+}
+
+var variablePattern = regexp.MustCompile(`\$\{([A-Za-z0-9_.-]+)\}`)
+
+// ExpandSubjectPattern replaces the ${variable} placeholders of a subject
+// pattern field with the variables of the principal. It also returns the
+// placeholders without a value, which are replaced by an empty string.
+func ExpandSubjectPattern(pattern string, variables map[string]string) (string, []string) {
+	var missing []string
+	expanded := variablePattern.ReplaceAllStringFunc(pattern, func(placeholder string) string {
+		name := variablePattern.FindStringSubmatch(placeholder)[1]
+		value, ok := variables[name]
+		if !ok || value == "" {
+			missing = append(missing, name)
+		}
+		return value
+	})
+	return expanded, missing
+}
@@ -0,0 +1,74 @@
+package subjectpatternrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchesCondition(t *testing.T) {
+	variables := map[string]string{
+		"name":      "jdoe",
+		"email":     "john.doe@example.com",
+		"user_type": "Business",
+		"empty":     "",
+	}
+
+	tests := []struct {
+		name     string
+		variable string
+		operator string
+		value    string
+		expected bool
+	}{
+		{"always true", "", "always_true", "", true},
+		{"exist", "email", "exist", "", true},
+		{"exist missing", "display_name", "exist", "", false},
+		{"exist empty", "empty", "exist", "", false},
+		{"exist_not", "display_name", "exist_not", "", true},
+		{"exist_not existing", "email", "exist_not", "", false},
+		{"is", "name", "is", "jdoe", true},
+		{"is other value", "name", "is", "JDOE", false},
+		{"is missing", "display_name", "is", "jdoe", false},
+		{"is_not", "user_type", "is_not", "Technical", true},
+		{"is_not same value", "user_type", "is_not", "Business", false},
+		{"is_not missing", "display_name", "is_not", "jdoe", true},
+		{"unknown operator", "name", "contains", "jdoe", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, MatchesCondition(tt.variable, tt.operator, tt.value, variables))
+		})
+	}
+}
+
+func TestExpandSubjectPattern(t *testing.T) {
+	variables := map[string]string{
+		"name":         "jdoe",
+		"display_name": "John Doe",
+		"empty":        "",
+	}
+
+	tests := []struct {
+		name        string
+		pattern     string
+		expected    string
+		wantMissing []string
+	}{
+		{name: "literal", pattern: "ACME Corp", expected: "ACME Corp"},
+		{name: "variable", pattern: "${name}", expected: "jdoe"},
+		{name: "variables and text", pattern: "${display_name} (${name})", expected: "John Doe (jdoe)"},
+		{name: "missing variable", pattern: "${mail}", expected: "", wantMissing: []string{"mail"}},
+		{name: "empty variable", pattern: "x${empty}", expected: "x", wantMissing: []string{"empty"}},
+		{name: "no placeholder syntax", pattern: "$name", expected: "$name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expanded, missing := ExpandSubjectPattern(tt.pattern, variables)
+			assert.Equal(t, tt.expected, expanded)
+			assert.Equal(t, tt.wantMissing, missing)
+		})
+	}
+}